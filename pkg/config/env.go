@@ -30,6 +30,90 @@ var Config = struct {
 	EvalCacheRefreshTimeout time.Duration `env:"FLAGR_EVALCACHE_REFRESHTIMEOUT" envDefault:"59s"`
 	// EvalCacheRefreshInterval - time interval of getting the flags data from DB into the in-memory evaluation cache
 	EvalCacheRefreshInterval time.Duration `env:"FLAGR_EVALCACHE_REFRESHINTERVAL" envDefault:"3s"`
+	// EvalCacheControlMaxAge - max-age of the Cache-Control header of the GET evaluation results that
+	// don't depend on the entity context
+	EvalCacheControlMaxAge time.Duration `env:"FLAGR_EVAL_CACHE_CONTROL_MAX_AGE" envDefault:"3s"`
+
+	// GRPCEnabled - to enable the gRPC evaluation server of pkg/flagrpb, which shares the evaluation cache
+	// with the REST evaluation endpoints
+	GRPCEnabled bool `env:"FLAGR_GRPC_ENABLED" envDefault:"false"`
+	// GRPCPort - the port of the gRPC evaluation server
+	GRPCPort int `env:"FLAGR_GRPC_PORT" envDefault:"18001"`
+
+	// FlagStreamKeepaliveInterval - time interval of the keepalive comments of the flags SSE stream
+	FlagStreamKeepaliveInterval time.Duration `env:"FLAGR_FLAG_STREAM_KEEPALIVE_INTERVAL" envDefault:"15s"`
+	// FlagStreamMaxDuration - the flags SSE stream is closed after it, and the clients reconnect with the
+	// Last-Event-ID to resume. Keep it below the write timeout of the server (--write-timeout, 60s by
+	// default), which would cut the stream in the middle of an event otherwise
+	FlagStreamMaxDuration time.Duration `env:"FLAGR_FLAG_STREAM_MAX_DURATION" envDefault:"50s"`
+
+	// SRMEnabled - enable the sample ratio mismatch (SRM) detection. Variant assignments of evaluations
+	// are counted per segment and compared against the distribution percents with a chi-squared test
+	SRMEnabled bool `env:"FLAGR_SRM_ENABLED" envDefault:"false"`
+	// SRMFlushInterval - time interval of flushing the in-memory assignment counts into DB
+	SRMFlushInterval time.Duration `env:"FLAGR_SRM_FLUSH_INTERVAL" envDefault:"10s"`
+	// SRMCheckInterval - time interval of checking all the flags for SRM and sending the webhook
+	SRMCheckInterval time.Duration `env:"FLAGR_SRM_CHECK_INTERVAL" envDefault:"1m"`
+	// SRMPValueThreshold - a segment has SRM if the p-value of the chi-squared test is below the threshold
+	SRMPValueThreshold float64 `env:"FLAGR_SRM_PVALUE_THRESHOLD" envDefault:"0.001"`
+	// SRMMinSampleSize - minimum number of assignments in a segment before it can be flagged as SRM
+	SRMMinSampleSize int `env:"FLAGR_SRM_MIN_SAMPLE_SIZE" envDefault:"100"`
+	// SRMWebhookURL - if set, a JSON payload is POSTed to the URL when a segment is newly detected with SRM
+	SRMWebhookURL     string        `env:"FLAGR_SRM_WEBHOOK_URL" envDefault:""`
+	SRMWebhookTimeout time.Duration `env:"FLAGR_SRM_WEBHOOK_TIMEOUT" envDefault:"5s"`
+
+	// AnalysisEnabled - enable the experiment analysis. The first variant assignment of every entityID
+	// is recorded in DB for the flags with data records enabled, and joined with the conversion events
+	AnalysisEnabled bool `env:"FLAGR_ANALYSIS_ENABLED" envDefault:"false"`
+	// AnalysisFlushInterval - time interval of flushing the in-memory assignment records into DB
+	AnalysisFlushInterval time.Duration `env:"FLAGR_ANALYSIS_FLUSH_INTERVAL" envDefault:"5s"`
+	// AnalysisConfidenceLevel - confidence level of the conversion rate intervals and the significance
+	AnalysisConfidenceLevel float64 `env:"FLAGR_ANALYSIS_CONFIDENCE_LEVEL" envDefault:"0.95"`
+
+	// WebhookEnabled - enable the webhooks of the flag changes. Every flag change writes one delivery per
+	// subscribed webhook into the outbox table in the same transaction as the flag snapshot
+	WebhookEnabled bool `env:"FLAGR_WEBHOOK_ENABLED" envDefault:"false"`
+	// WebhookDispatchInterval - time interval of polling the outbox table for the deliveries to send
+	WebhookDispatchInterval time.Duration `env:"FLAGR_WEBHOOK_DISPATCH_INTERVAL" envDefault:"5s"`
+	// WebhookTimeout - timeout of a single delivery request
+	WebhookTimeout time.Duration `env:"FLAGR_WEBHOOK_TIMEOUT" envDefault:"5s"`
+	// WebhookMaxAttempts - a delivery is marked as failed after the max attempts
+	WebhookMaxAttempts int `env:"FLAGR_WEBHOOK_MAX_ATTEMPTS" envDefault:"8"`
+	// WebhookBackoffBase and WebhookBackoffMax - the retry delay doubles from the base after
+	// every failed attempt, and it's capped at the max
+	WebhookBackoffBase time.Duration `env:"FLAGR_WEBHOOK_BACKOFF_BASE" envDefault:"10s"`
+	WebhookBackoffMax  time.Duration `env:"FLAGR_WEBHOOK_BACKOFF_MAX" envDefault:"1h"`
+
+	// StickyAssignmentStore - the store of the sticky assignments of the flags that enable them.
+	// sql stores them in the DB, memory keeps them in the process and loses them on restart
+	StickyAssignmentStore string `env:"FLAGR_STICKY_ASSIGNMENT_STORE" envDefault:"sql"`
+	// StickyAssignmentCacheSize and StickyAssignmentCacheTTL - the sql store is fronted by an in-memory cache
+	// of at most the size, whose entries are read again from the DB after the TTL, so that the cleared
+	// assignments are picked up from the other Flagr instances
+	StickyAssignmentCacheSize int           `env:"FLAGR_STICKY_ASSIGNMENT_CACHE_SIZE" envDefault:"100000"`
+	StickyAssignmentCacheTTL  time.Duration `env:"FLAGR_STICKY_ASSIGNMENT_CACHE_TTL" envDefault:"1m"`
+	// StickyAssignmentFlushInterval - time interval of flushing the new assignments of the sql store into DB
+	StickyAssignmentFlushInterval time.Duration `env:"FLAGR_STICKY_ASSIGNMENT_FLUSH_INTERVAL" envDefault:"1s"`
+
+	// GeoIPDBPath - the path of a MaxMind DB file, e.g. GeoLite2-City.mmdb. If it's set, the entityContext
+	// is enriched with the $geo.country, $geo.region, $geo.city and $geo.continent of the entity's IP
+	// before the evaluation
+	GeoIPDBPath string `env:"FLAGR_GEOIP_DB_PATH" envDefault:""`
+	// GeoIPIPProperty - the property of the entityContext holding the entity's IP. The client IP of the
+	// evaluation request is used if it's missing, see TrustedProxies
+	GeoIPIPProperty string `env:"FLAGR_GEOIP_IP_PROPERTY" envDefault:"ip"`
+	// TrustedProxies - the IPs or CIDRs of the proxies in front of Flagr, e.g. 10.0.0.0/8. The client IP of
+	// a request from them is the rightmost address of X-Forwarded-For that isn't one of them, since the
+	// addresses on its left are set by the client and can be forged. X-Forwarded-For is ignored for the
+	// other requests, whose client IP is the remote address
+	TrustedProxies []string `env:"FLAGR_TRUSTED_PROXIES" envDefault:"" envSeparator:","`
+
+	// UserAgentEnrichmentEnabled - enriches the entityContext with the $ua.browser, $ua.version, $ua.os and
+	// $ua.device of the entity's User-Agent before the evaluation
+	UserAgentEnrichmentEnabled bool `env:"FLAGR_USER_AGENT_ENRICHMENT_ENABLED" envDefault:"false"`
+	// UserAgentProperty - the property of the entityContext holding the entity's User-Agent. The User-Agent
+	// header of the evaluation request is used if it's missing
+	UserAgentProperty string `env:"FLAGR_USER_AGENT_PROPERTY" envDefault:"user_agent"`
 
 	// DBDriver - Flagr supports sqlite3, mysql, postgres
 	DBDriver string `env:"FLAGR_DB_DBDRIVER" envDefault:"sqlite3"`
@@ -42,6 +126,11 @@ var Config = struct {
 	// DBConnectionDebug controls whether to show the database connection debugging logs
 	// warning: it may log the credentials to the stdout
 	DBConnectionDebug bool `env:"FLAGR_DB_DBCONNECTION_DEBUG" envDefault:"true"`
+	// DBDataMigrationVersion - applies the data migrations up to the version at startup, e.g.
+	// 1_constraint_value_types. They rewrite the stored data the way only this version of Flagr reads it,
+	// so set it once all the instances run this version and rolling back isn't needed. Each migration is
+	// applied once, and a failed one stops the startup
+	DBDataMigrationVersion string `env:"FLAGR_DB_DATA_MIGRATION_VERSION" envDefault:""`
 
 	// CORSEnabled - enable CORS
 	CORSEnabled bool `env:"FLAGR_CORS_ENABLED" envDefault:"true"`
@@ -26,7 +26,7 @@ func SetupGlobalMiddleware(handler http.Handler) http.Handler {
 	n.Use(negroni.NewRecovery())
 
 	if Config.MiddlewareGzipEnabled {
-		n.Use(gzip.Gzip(gzip.DefaultCompression))
+		n.Use(&skipEventStream{Handler: gzip.Gzip(gzip.DefaultCompression)})
 	}
 
 	if Config.CORSEnabled {
@@ -181,3 +181,22 @@ func (s *statsdMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Request, nex
 
 	next(w, r)
 }
+
+// eventStreamPaths are the routes of the Server-Sent Events streams
+var eventStreamPaths = map[string]bool{
+	"/api/v1/flags/stream": true,
+}
+
+// skipEventStream skips the wrapped middleware for the Server-Sent Events routes,
+// e.g. gzip buffers the response which breaks the streaming
+type skipEventStream struct {
+	Handler negroni.Handler
+}
+
+func (s *skipEventStream) ServeHTTP(w http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+	if eventStreamPaths[r.URL.Path] {
+		next(w, r)
+		return
+	}
+	s.Handler.ServeHTTP(w, r, next)
+}
@@ -318,3 +318,33 @@ func TestStatsMiddleware(t *testing.T) {
 		assert.True(t, incrCalled)
 	})
 }
+
+func TestSkipEventStream(t *testing.T) {
+	hh := SetupGlobalMiddleware(&okHandler{})
+
+	t.Run("gzip the normal requests", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "http://localhost:18000/api/v1/flags", nil)
+		req.Header.Set("Accept-Encoding", "gzip")
+		res := httptest.NewRecorder()
+		hh.ServeHTTP(res, req)
+		assert.Equal(t, "gzip", res.Header().Get("Content-Encoding"))
+	})
+
+	t.Run("skip gzip for the event streams", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "http://localhost:18000/api/v1/flags/stream", nil)
+		req.Header.Set("Accept-Encoding", "gzip")
+		res := httptest.NewRecorder()
+		hh.ServeHTTP(res, req)
+		assert.Equal(t, "", res.Header().Get("Content-Encoding"))
+		assert.Equal(t, "OK", res.Body.String())
+	})
+
+	t.Run("gzip the other routes even if they accept event streams", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "http://localhost:18000/api/v1/flags", nil)
+		req.Header.Set("Accept-Encoding", "gzip")
+		req.Header.Set("Accept", "text/event-stream")
+		res := httptest.NewRecorder()
+		hh.ServeHTTP(res, req)
+		assert.Equal(t, "gzip", res.Header().Get("Content-Encoding"))
+	})
+}
@@ -0,0 +1,48 @@
+//go:generate goqueryset -in assignment_count.go
+
+package entity
+
+import (
+	"github.com/jinzhu/gorm"
+)
+
+// AssignmentCount is the number of evaluations of a segment that got assigned
+// to a variant. It's used to detect the sample ratio mismatch (SRM).
+// gen:qs
+type AssignmentCount struct {
+	gorm.Model
+	FlagID    uint `gorm:"index:idx_assignmentcount_flagid"`
+	SegmentID uint `gorm:"index:idx_assignmentcount_segmentid"`
+	VariantID uint
+	Count     uint
+}
+
+// IncrAssignmentCount increments the assignment count of the variant in the segment by n
+func IncrAssignmentCount(db *gorm.DB, flagID uint, segmentID uint, variantID uint, n uint) error {
+	tx := db.Begin()
+
+	q := tx.Model(&AssignmentCount{}).
+		Where("flag_id = ? AND segment_id = ? AND variant_id = ?", flagID, segmentID, variantID).
+		UpdateColumn("count", gorm.Expr("count + ?", n))
+	if err := q.Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if q.RowsAffected == 0 {
+		ac := &AssignmentCount{FlagID: flagID, SegmentID: segmentID, VariantID: variantID, Count: n}
+		if err := ac.Create(tx); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	return tx.Commit().Error
+}
+
+// ResetAssignmentCounts deletes the assignment counts of the segment, e.g.
+// the distribution of the segment has changed and the old counts no longer
+// reflect the expected ratio
+func ResetAssignmentCounts(db *gorm.DB, segmentID uint) error {
+	return NewAssignmentCountQuerySet(db.Unscoped()).SegmentIDEq(segmentID).Delete()
+}
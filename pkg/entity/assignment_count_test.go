@@ -0,0 +1,33 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIncrAssignmentCount(t *testing.T) {
+	db := NewTestDB()
+	defer db.Close()
+
+	t.Run("create and increment", func(t *testing.T) {
+		assert.NoError(t, IncrAssignmentCount(db, 100, 200, 300, 3))
+		assert.NoError(t, IncrAssignmentCount(db, 100, 200, 300, 4))
+		assert.NoError(t, IncrAssignmentCount(db, 100, 200, 301, 5))
+
+		acs := []AssignmentCount{}
+		err := NewAssignmentCountQuerySet(db).FlagIDEq(100).OrderAscByVariantID().All(&acs)
+		assert.NoError(t, err)
+		assert.Len(t, acs, 2)
+		assert.Equal(t, uint(7), acs[0].Count)
+		assert.Equal(t, uint(5), acs[1].Count)
+	})
+
+	t.Run("reset", func(t *testing.T) {
+		assert.NoError(t, ResetAssignmentCounts(db, 200))
+
+		cnt, err := NewAssignmentCountQuerySet(db.Unscoped()).SegmentIDEq(200).Count()
+		assert.NoError(t, err)
+		assert.Zero(t, cnt)
+	})
+}
@@ -0,0 +1,41 @@
+//go:generate goqueryset -in assignment_record.go
+
+package entity
+
+import (
+	"database/sql"
+
+	"github.com/jinzhu/gorm"
+)
+
+// AssignmentRecord is the first variant assignment of an entity for a flag.
+// It's joined with the ConversionEvents for the experiment analysis.
+// gen:qs
+type AssignmentRecord struct {
+	gorm.Model
+	FlagID    uint   `gorm:"unique_index:idx_assignmentrecord_flagid_entityid"`
+	EntityID  string `gorm:"type:varchar(255);unique_index:idx_assignmentrecord_flagid_entityid"`
+	SegmentID uint
+	VariantID uint
+}
+
+// SaveAssignmentRecord saves the AssignmentRecord if the entity has not been assigned for the flag yet.
+// It's a single insert that the unique index of (flag_id, entity_id) turns into a no-op for an
+// existing record, so that the flush doesn't query the record of every entity first
+func SaveAssignmentRecord(db *gorm.DB, ar *AssignmentRecord) error {
+	err := db.Set("gorm:insert_option", insertIgnoreOption(db)).Create(ar).Error
+	if err == sql.ErrNoRows {
+		// postgres returns no id when the insert is ignored
+		return nil
+	}
+	return err
+}
+
+// insertIgnoreOption returns the suffix of an INSERT that ignores the conflicts of the unique indexes
+func insertIgnoreOption(db *gorm.DB) string {
+	if db.Dialect().GetName() == "mysql" {
+		return "ON DUPLICATE KEY UPDATE id = id"
+	}
+	// postgres and sqlite 3.24+
+	return "ON CONFLICT DO NOTHING"
+}
@@ -0,0 +1,22 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSaveAssignmentRecord(t *testing.T) {
+	db := NewTestDB()
+	defer db.Close()
+
+	assert.NoError(t, SaveAssignmentRecord(db, &AssignmentRecord{FlagID: 100, EntityID: "e1", SegmentID: 200, VariantID: 300}))
+	assert.NoError(t, SaveAssignmentRecord(db, &AssignmentRecord{FlagID: 100, EntityID: "e1", SegmentID: 200, VariantID: 301}))
+	assert.NoError(t, SaveAssignmentRecord(db, &AssignmentRecord{FlagID: 101, EntityID: "e1", SegmentID: 201, VariantID: 302}))
+
+	ars := []AssignmentRecord{}
+	assert.NoError(t, NewAssignmentRecordQuerySet(db).OrderAscByID().All(&ars))
+	assert.Len(t, ars, 2)
+	assert.Equal(t, uint(300), ars[0].VariantID, "the first assignment is kept")
+	assert.Equal(t, uint(101), ars[1].FlagID)
+}
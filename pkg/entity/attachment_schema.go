@@ -0,0 +1,181 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/go-openapi/jsonpointer"
+	"github.com/go-openapi/spec"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+	"github.com/spf13/cast"
+)
+
+// AttachmentSchema is the JSON Schema (draft 4) of the variant attachments of a flag,
+// an empty AttachmentSchema accepts any attachment
+type AttachmentSchema map[string]interface{}
+
+// jsonSchemaTypes are the primitive types of JSON Schema draft 4
+var jsonSchemaTypes = map[string]bool{
+	"array":   true,
+	"boolean": true,
+	"integer": true,
+	"null":    true,
+	"number":  true,
+	"object":  true,
+	"string":  true,
+}
+
+// Scan implements scanner interface
+func (a *AttachmentSchema) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+	s := cast.ToString(value)
+	if err := json.Unmarshal([]byte(s), a); err != nil {
+		return fmt.Errorf("cannot scan %v into AttachmentSchema type. err: %v", value, err)
+	}
+	return nil
+}
+
+// Value implements valuer interface
+func (a AttachmentSchema) Value() (driver.Value, error) {
+	bytes, err := json.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+	return string(bytes), nil
+}
+
+// Validate validates the AttachmentSchema itself
+func (a AttachmentSchema) Validate() error {
+	if len(a) == 0 {
+		return nil
+	}
+	if err := checkSchemaKeywords(map[string]interface{}(a), map[string]interface{}(a)); err != nil {
+		return fmt.Errorf("invalid attachment schema. %s", err)
+	}
+	if _, err := a.validator(); err != nil {
+		return fmt.Errorf("invalid attachment schema. %s", err)
+	}
+	return nil
+}
+
+// ValidateAttachment validates the attachment against the AttachmentSchema
+func (a AttachmentSchema) ValidateAttachment(attachment Attachment) error {
+	if len(a) == 0 {
+		return nil
+	}
+	v, err := a.validator()
+	if err != nil {
+		return fmt.Errorf("invalid attachment schema. %s", err)
+	}
+
+	// round trip through JSON so that the values have the types the validator expects
+	b, err := json.Marshal(attachment)
+	if err != nil {
+		return err
+	}
+	var data interface{}
+	if err := json.Unmarshal(b, &data); err != nil {
+		return err
+	}
+	if data == nil {
+		data = map[string]interface{}{}
+	}
+
+	res := v.Validate(data)
+	if res.HasErrors() {
+		msgs := make([]string, len(res.Errors))
+		for i, e := range res.Errors {
+			msgs[i] = strings.TrimSpace(e.Error())
+		}
+		return fmt.Errorf("attachment does not match the attachment schema of the flag: %s", strings.Join(msgs, "; "))
+	}
+	return nil
+}
+
+func (a AttachmentSchema) validator() (v *validate.SchemaValidator, err error) {
+	b, err := json.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+	s := &spec.Schema{}
+	if err := json.Unmarshal(b, s); err != nil {
+		return nil, err
+	}
+
+	// the validator panics when it cannot expand the refs of the schema
+	defer func() {
+		if r := recover(); r != nil {
+			v, err = nil, fmt.Errorf("%v", r)
+		}
+	}()
+	return validate.NewSchemaValidator(s, nil, "", strfmt.Default), nil
+}
+
+// checkSchemaKeywords checks the keywords the validator doesn't check by itself,
+// and only allows refs within the schema so that nothing is fetched remotely
+func checkSchemaKeywords(root map[string]interface{}, m map[string]interface{}) error {
+	for k, v := range m {
+		switch k {
+		case "$ref":
+			ref, ok := v.(string)
+			if !ok || !strings.HasPrefix(ref, "#") {
+				return fmt.Errorf("only local $ref is supported, got %v", v)
+			}
+			p, err := jsonpointer.New(strings.TrimPrefix(ref, "#"))
+			if err != nil {
+				return fmt.Errorf("invalid $ref %s. %s", ref, err)
+			}
+			if _, _, err := p.Get(root); err != nil {
+				return fmt.Errorf("cannot resolve $ref %s", ref)
+			}
+		case "type":
+			ts, ok := v.([]interface{})
+			if !ok {
+				ts = []interface{}{v}
+			}
+			for _, t := range ts {
+				if !jsonSchemaTypes[cast.ToString(t)] {
+					return fmt.Errorf("unknown type %v", t)
+				}
+			}
+		case "properties", "patternProperties", "definitions":
+			// the keys are names, and the values are schemas
+			ps, ok := v.(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("%s should be an object", k)
+			}
+			for _, p := range ps {
+				if err := checkSubSchema(root, p); err != nil {
+					return err
+				}
+			}
+			continue
+		case "enum", "default":
+			// the values are data, not schemas
+			continue
+		}
+		if err := checkSubSchema(root, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func checkSubSchema(root map[string]interface{}, v interface{}) error {
+	switch s := v.(type) {
+	case map[string]interface{}:
+		return checkSchemaKeywords(root, s)
+	case []interface{}:
+		for _, i := range s {
+			if err := checkSubSchema(root, i); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
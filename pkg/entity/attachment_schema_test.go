@@ -0,0 +1,85 @@
+package entity
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func genAttachmentSchema(s string) AttachmentSchema {
+	a := AttachmentSchema{}
+	if err := json.Unmarshal([]byte(s), &a); err != nil {
+		panic(err)
+	}
+	return a
+}
+
+func TestAttachmentSchemaValidate(t *testing.T) {
+	for _, s := range []string{
+		`{}`,
+		`{"type": "object", "properties": {"type": {"type": ["string", "null"]}}}`,
+		`{"type": "object", "properties": {"n": {"$ref": "#/definitions/n"}}, "definitions": {"n": {"type": "integer"}}}`,
+		`{"enum": [{"type": "not_a_type"}]}`,
+	} {
+		assert.NoError(t, genAttachmentSchema(s).Validate(), s)
+	}
+
+	for _, s := range []string{
+		`{"type": "foo"}`,
+		`{"properties": {"n": {"type": ["string", "foo"]}}}`,
+		`{"properties": {"n": {"$ref": "http://example.com/schema.json"}}}`,
+		`{"properties": {"n": {"$ref": "#/definitions/missing"}}}`,
+		`{"properties": []}`,
+		`{"required": "n"}`,
+	} {
+		assert.Error(t, genAttachmentSchema(s).Validate(), s)
+	}
+}
+
+func TestAttachmentSchemaValidateAttachment(t *testing.T) {
+	s := genAttachmentSchema(`{
+		"type": "object",
+		"required": ["limit"],
+		"additionalProperties": false,
+		"properties": {
+			"limit": {"type": "integer", "minimum": 1},
+			"enabled": {"type": "boolean"},
+			"config": {"type": "object", "properties": {"tags": {"type": "array", "items": {"type": "string"}}}}
+		}
+	}`)
+
+	assert.NoError(t, s.ValidateAttachment(Attachment{"limit": 1}))
+	assert.NoError(t, s.ValidateAttachment(Attachment{
+		"limit":   10.0,
+		"enabled": true,
+		"config":  map[string]interface{}{"tags": []interface{}{"a", "b"}},
+	}))
+
+	err := s.ValidateAttachment(nil)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "limit in body is required")
+
+	err = s.ValidateAttachment(Attachment{"limit": 0, "other": "x", "config": map[string]interface{}{"tags": []interface{}{1}}})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "limit in body should be greater than or equal to 1")
+	assert.Contains(t, err.Error(), "other in body is a forbidden property")
+	assert.Contains(t, err.Error(), "config.tags in body must be of type string")
+
+	assert.NoError(t, AttachmentSchema{}.ValidateAttachment(Attachment{"any": []interface{}{1, "a"}}))
+	assert.NoError(t, AttachmentSchema(nil).ValidateAttachment(nil))
+}
+
+func TestAttachmentSchemaScan(t *testing.T) {
+	a := AttachmentSchema{}
+	assert.NoError(t, a.Scan([]byte(`{"type": "object"}`)))
+	assert.Equal(t, AttachmentSchema{"type": "object"}, a)
+
+	a = AttachmentSchema{}
+	assert.NoError(t, a.Scan(nil))
+	assert.Error(t, a.Scan([]byte(`{`)))
+
+	v, err := AttachmentSchema{"type": "object"}.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, `{"type":"object"}`, v)
+}
@@ -0,0 +1,28 @@
+//go:generate goqueryset -in audience.go
+
+package entity
+
+import (
+	"github.com/jinzhu/gorm"
+)
+
+// Audience is a named set of constraints, e.g. "internal employees", that the segments
+// of many flags can reference instead of copying the constraints around
+// gen:qs
+type Audience struct {
+	gorm.Model
+
+	Key         string `gorm:"type:varchar(64);unique_index:idx_audience_key"`
+	Description string `sql:"type:text"`
+	Constraints ConstraintArray
+}
+
+// Preload preloads the constraints of the audience
+func (a *Audience) Preload(db *gorm.DB) error {
+	cs := []Constraint{}
+	if err := NewConstraintQuerySet(db).AudienceIDEq(a.ID).OrderAscByCreatedAt().All(&cs); err != nil {
+		return err
+	}
+	a.Constraints = cs
+	return nil
+}
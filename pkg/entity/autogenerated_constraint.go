@@ -36,6 +36,62 @@ func (qs ConstraintQuerySet) All(ret *[]Constraint) error {
 	return qs.db.Find(ret).Error
 }
 
+// AudienceIDEq is an autogenerated method
+// nolint: dupl
+func (qs ConstraintQuerySet) AudienceIDEq(audienceID uint) ConstraintQuerySet {
+	return qs.w(qs.db.Where("audience_id = ?", audienceID))
+}
+
+// AudienceIDGt is an autogenerated method
+// nolint: dupl
+func (qs ConstraintQuerySet) AudienceIDGt(audienceID uint) ConstraintQuerySet {
+	return qs.w(qs.db.Where("audience_id > ?", audienceID))
+}
+
+// AudienceIDGte is an autogenerated method
+// nolint: dupl
+func (qs ConstraintQuerySet) AudienceIDGte(audienceID uint) ConstraintQuerySet {
+	return qs.w(qs.db.Where("audience_id >= ?", audienceID))
+}
+
+// AudienceIDIn is an autogenerated method
+// nolint: dupl
+func (qs ConstraintQuerySet) AudienceIDIn(audienceID ...uint) ConstraintQuerySet {
+	if len(audienceID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one audienceID in AudienceIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("audience_id IN (?)", audienceID))
+}
+
+// AudienceIDLt is an autogenerated method
+// nolint: dupl
+func (qs ConstraintQuerySet) AudienceIDLt(audienceID uint) ConstraintQuerySet {
+	return qs.w(qs.db.Where("audience_id < ?", audienceID))
+}
+
+// AudienceIDLte is an autogenerated method
+// nolint: dupl
+func (qs ConstraintQuerySet) AudienceIDLte(audienceID uint) ConstraintQuerySet {
+	return qs.w(qs.db.Where("audience_id <= ?", audienceID))
+}
+
+// AudienceIDNe is an autogenerated method
+// nolint: dupl
+func (qs ConstraintQuerySet) AudienceIDNe(audienceID uint) ConstraintQuerySet {
+	return qs.w(qs.db.Where("audience_id != ?", audienceID))
+}
+
+// AudienceIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs ConstraintQuerySet) AudienceIDNotIn(audienceID ...uint) ConstraintQuerySet {
+	if len(audienceID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one audienceID in AudienceIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("audience_id NOT IN (?)", audienceID))
+}
+
 // Count is an autogenerated method
 // nolint: dupl
 func (qs ConstraintQuerySet) Count() (int, error) {
@@ -258,6 +314,12 @@ func (qs ConstraintQuerySet) OperatorNotIn(operator ...string) ConstraintQuerySe
 	return qs.w(qs.db.Where("operator NOT IN (?)", operator))
 }
 
+// OrderAscByAudienceID is an autogenerated method
+// nolint: dupl
+func (qs ConstraintQuerySet) OrderAscByAudienceID() ConstraintQuerySet {
+	return qs.w(qs.db.Order("audience_id ASC"))
+}
+
 // OrderAscByCreatedAt is an autogenerated method
 // nolint: dupl
 func (qs ConstraintQuerySet) OrderAscByCreatedAt() ConstraintQuerySet {
@@ -288,6 +350,12 @@ func (qs ConstraintQuerySet) OrderAscByUpdatedAt() ConstraintQuerySet {
 	return qs.w(qs.db.Order("updated_at ASC"))
 }
 
+// OrderDescByAudienceID is an autogenerated method
+// nolint: dupl
+func (qs ConstraintQuerySet) OrderDescByAudienceID() ConstraintQuerySet {
+	return qs.w(qs.db.Order("audience_id DESC"))
+}
+
 // OrderDescByCreatedAt is an autogenerated method
 // nolint: dupl
 func (qs ConstraintQuerySet) OrderDescByCreatedAt() ConstraintQuerySet {
@@ -406,6 +474,13 @@ func (qs ConstraintQuerySet) SegmentIDNotIn(segmentID ...uint) ConstraintQuerySe
 	return qs.w(qs.db.Where("segment_id NOT IN (?)", segmentID))
 }
 
+// SetAudienceID is an autogenerated method
+// nolint: dupl
+func (u ConstraintUpdater) SetAudienceID(audienceID uint) ConstraintUpdater {
+	u.fields[string(ConstraintDBSchema.AudienceID)] = audienceID
+	return u
+}
+
 // SetCreatedAt is an autogenerated method
 // nolint: dupl
 func (u ConstraintUpdater) SetCreatedAt(createdAt time.Time) ConstraintUpdater {
@@ -462,6 +537,13 @@ func (u ConstraintUpdater) SetValue(value string) ConstraintUpdater {
 	return u
 }
 
+// SetValueType is an autogenerated method
+// nolint: dupl
+func (u ConstraintUpdater) SetValueType(valueType string) ConstraintUpdater {
+	u.fields[string(ConstraintDBSchema.ValueType)] = valueType
+	return u
+}
+
 // Update is an autogenerated method
 // nolint: dupl
 func (u ConstraintUpdater) Update() error {
@@ -543,6 +625,38 @@ func (qs ConstraintQuerySet) ValueNotIn(value ...string) ConstraintQuerySet {
 	return qs.w(qs.db.Where("value NOT IN (?)", value))
 }
 
+// ValueTypeEq is an autogenerated method
+// nolint: dupl
+func (qs ConstraintQuerySet) ValueTypeEq(valueType string) ConstraintQuerySet {
+	return qs.w(qs.db.Where("value_type = ?", valueType))
+}
+
+// ValueTypeIn is an autogenerated method
+// nolint: dupl
+func (qs ConstraintQuerySet) ValueTypeIn(valueType ...string) ConstraintQuerySet {
+	if len(valueType) == 0 {
+		qs.db.AddError(errors.New("must at least pass one valueType in ValueTypeIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("value_type IN (?)", valueType))
+}
+
+// ValueTypeNe is an autogenerated method
+// nolint: dupl
+func (qs ConstraintQuerySet) ValueTypeNe(valueType string) ConstraintQuerySet {
+	return qs.w(qs.db.Where("value_type != ?", valueType))
+}
+
+// ValueTypeNotIn is an autogenerated method
+// nolint: dupl
+func (qs ConstraintQuerySet) ValueTypeNotIn(valueType ...string) ConstraintQuerySet {
+	if len(valueType) == 0 {
+		qs.db.AddError(errors.New("must at least pass one valueType in ValueTypeNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("value_type NOT IN (?)", valueType))
+}
+
 // ===== END of query set ConstraintQuerySet
 
 // ===== BEGIN of Constraint modifiers
@@ -558,38 +672,44 @@ func (f ConstraintDBSchemaField) String() string {
 
 // ConstraintDBSchema stores db field names of Constraint
 var ConstraintDBSchema = struct {
-	ID        ConstraintDBSchemaField
-	CreatedAt ConstraintDBSchemaField
-	UpdatedAt ConstraintDBSchemaField
-	DeletedAt ConstraintDBSchemaField
-	SegmentID ConstraintDBSchemaField
-	Property  ConstraintDBSchemaField
-	Operator  ConstraintDBSchemaField
-	Value     ConstraintDBSchemaField
+	ID         ConstraintDBSchemaField
+	CreatedAt  ConstraintDBSchemaField
+	UpdatedAt  ConstraintDBSchemaField
+	DeletedAt  ConstraintDBSchemaField
+	SegmentID  ConstraintDBSchemaField
+	AudienceID ConstraintDBSchemaField
+	Property   ConstraintDBSchemaField
+	Operator   ConstraintDBSchemaField
+	Value      ConstraintDBSchemaField
+	ValueType  ConstraintDBSchemaField
 }{
 
-	ID:        ConstraintDBSchemaField("id"),
-	CreatedAt: ConstraintDBSchemaField("created_at"),
-	UpdatedAt: ConstraintDBSchemaField("updated_at"),
-	DeletedAt: ConstraintDBSchemaField("deleted_at"),
-	SegmentID: ConstraintDBSchemaField("segment_id"),
-	Property:  ConstraintDBSchemaField("property"),
-	Operator:  ConstraintDBSchemaField("operator"),
-	Value:     ConstraintDBSchemaField("value"),
+	ID:         ConstraintDBSchemaField("id"),
+	CreatedAt:  ConstraintDBSchemaField("created_at"),
+	UpdatedAt:  ConstraintDBSchemaField("updated_at"),
+	DeletedAt:  ConstraintDBSchemaField("deleted_at"),
+	SegmentID:  ConstraintDBSchemaField("segment_id"),
+	AudienceID: ConstraintDBSchemaField("audience_id"),
+	Property:   ConstraintDBSchemaField("property"),
+	Operator:   ConstraintDBSchemaField("operator"),
+	Value:      ConstraintDBSchemaField("value"),
+	ValueType:  ConstraintDBSchemaField("value_type"),
 }
 
 // Update updates Constraint fields by primary key
 // nolint: dupl
 func (o *Constraint) Update(db *gorm.DB, fields ...ConstraintDBSchemaField) error {
 	dbNameToFieldName := map[string]interface{}{
-		"id":         o.ID,
-		"created_at": o.CreatedAt,
-		"updated_at": o.UpdatedAt,
-		"deleted_at": o.DeletedAt,
-		"segment_id": o.SegmentID,
-		"property":   o.Property,
-		"operator":   o.Operator,
-		"value":      o.Value,
+		"id":          o.ID,
+		"created_at":  o.CreatedAt,
+		"updated_at":  o.UpdatedAt,
+		"deleted_at":  o.DeletedAt,
+		"segment_id":  o.SegmentID,
+		"audience_id": o.AudienceID,
+		"property":    o.Property,
+		"operator":    o.Operator,
+		"value":       o.Value,
+		"value_type":  o.ValueType,
 	}
 	u := map[string]interface{}{}
 	for _, f := range fields {
@@ -36,6 +36,38 @@ func (qs FlagQuerySet) All(ret *[]Flag) error {
 	return qs.db.Find(ret).Error
 }
 
+// BucketByEq is an autogenerated method
+// nolint: dupl
+func (qs FlagQuerySet) BucketByEq(bucketBy string) FlagQuerySet {
+	return qs.w(qs.db.Where("bucket_by = ?", bucketBy))
+}
+
+// BucketByIn is an autogenerated method
+// nolint: dupl
+func (qs FlagQuerySet) BucketByIn(bucketBy ...string) FlagQuerySet {
+	if len(bucketBy) == 0 {
+		qs.db.AddError(errors.New("must at least pass one bucketBy in BucketByIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("bucket_by IN (?)", bucketBy))
+}
+
+// BucketByNe is an autogenerated method
+// nolint: dupl
+func (qs FlagQuerySet) BucketByNe(bucketBy string) FlagQuerySet {
+	return qs.w(qs.db.Where("bucket_by != ?", bucketBy))
+}
+
+// BucketByNotIn is an autogenerated method
+// nolint: dupl
+func (qs FlagQuerySet) BucketByNotIn(bucketBy ...string) FlagQuerySet {
+	if len(bucketBy) == 0 {
+		qs.db.AddError(errors.New("must at least pass one bucketBy in BucketByNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("bucket_by NOT IN (?)", bucketBy))
+}
+
 // Count is an autogenerated method
 // nolint: dupl
 func (qs FlagQuerySet) Count() (int, error) {
@@ -280,6 +312,38 @@ func (qs FlagQuerySet) GetUpdater() FlagUpdater {
 	return NewFlagUpdater(qs.db)
 }
 
+// HashEq is an autogenerated method
+// nolint: dupl
+func (qs FlagQuerySet) HashEq(hash string) FlagQuerySet {
+	return qs.w(qs.db.Where("hash = ?", hash))
+}
+
+// HashIn is an autogenerated method
+// nolint: dupl
+func (qs FlagQuerySet) HashIn(hash ...string) FlagQuerySet {
+	if len(hash) == 0 {
+		qs.db.AddError(errors.New("must at least pass one hash in HashIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("hash IN (?)", hash))
+}
+
+// HashNe is an autogenerated method
+// nolint: dupl
+func (qs FlagQuerySet) HashNe(hash string) FlagQuerySet {
+	return qs.w(qs.db.Where("hash != ?", hash))
+}
+
+// HashNotIn is an autogenerated method
+// nolint: dupl
+func (qs FlagQuerySet) HashNotIn(hash ...string) FlagQuerySet {
+	if len(hash) == 0 {
+		qs.db.AddError(errors.New("must at least pass one hash in HashNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("hash NOT IN (?)", hash))
+}
+
 // IDEq is an autogenerated method
 // nolint: dupl
 func (qs FlagQuerySet) IDEq(ID uint) FlagQuerySet {
@@ -368,6 +432,174 @@ func (qs FlagQuerySet) KeyNotIn(key ...string) FlagQuerySet {
 	return qs.w(qs.db.Where("key NOT IN (?)", key))
 }
 
+// LayerBucketEndEq is an autogenerated method
+// nolint: dupl
+func (qs FlagQuerySet) LayerBucketEndEq(layerBucketEnd uint) FlagQuerySet {
+	return qs.w(qs.db.Where("layer_bucket_end = ?", layerBucketEnd))
+}
+
+// LayerBucketEndGt is an autogenerated method
+// nolint: dupl
+func (qs FlagQuerySet) LayerBucketEndGt(layerBucketEnd uint) FlagQuerySet {
+	return qs.w(qs.db.Where("layer_bucket_end > ?", layerBucketEnd))
+}
+
+// LayerBucketEndGte is an autogenerated method
+// nolint: dupl
+func (qs FlagQuerySet) LayerBucketEndGte(layerBucketEnd uint) FlagQuerySet {
+	return qs.w(qs.db.Where("layer_bucket_end >= ?", layerBucketEnd))
+}
+
+// LayerBucketEndIn is an autogenerated method
+// nolint: dupl
+func (qs FlagQuerySet) LayerBucketEndIn(layerBucketEnd ...uint) FlagQuerySet {
+	if len(layerBucketEnd) == 0 {
+		qs.db.AddError(errors.New("must at least pass one layerBucketEnd in LayerBucketEndIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("layer_bucket_end IN (?)", layerBucketEnd))
+}
+
+// LayerBucketEndLt is an autogenerated method
+// nolint: dupl
+func (qs FlagQuerySet) LayerBucketEndLt(layerBucketEnd uint) FlagQuerySet {
+	return qs.w(qs.db.Where("layer_bucket_end < ?", layerBucketEnd))
+}
+
+// LayerBucketEndLte is an autogenerated method
+// nolint: dupl
+func (qs FlagQuerySet) LayerBucketEndLte(layerBucketEnd uint) FlagQuerySet {
+	return qs.w(qs.db.Where("layer_bucket_end <= ?", layerBucketEnd))
+}
+
+// LayerBucketEndNe is an autogenerated method
+// nolint: dupl
+func (qs FlagQuerySet) LayerBucketEndNe(layerBucketEnd uint) FlagQuerySet {
+	return qs.w(qs.db.Where("layer_bucket_end != ?", layerBucketEnd))
+}
+
+// LayerBucketEndNotIn is an autogenerated method
+// nolint: dupl
+func (qs FlagQuerySet) LayerBucketEndNotIn(layerBucketEnd ...uint) FlagQuerySet {
+	if len(layerBucketEnd) == 0 {
+		qs.db.AddError(errors.New("must at least pass one layerBucketEnd in LayerBucketEndNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("layer_bucket_end NOT IN (?)", layerBucketEnd))
+}
+
+// LayerBucketStartEq is an autogenerated method
+// nolint: dupl
+func (qs FlagQuerySet) LayerBucketStartEq(layerBucketStart uint) FlagQuerySet {
+	return qs.w(qs.db.Where("layer_bucket_start = ?", layerBucketStart))
+}
+
+// LayerBucketStartGt is an autogenerated method
+// nolint: dupl
+func (qs FlagQuerySet) LayerBucketStartGt(layerBucketStart uint) FlagQuerySet {
+	return qs.w(qs.db.Where("layer_bucket_start > ?", layerBucketStart))
+}
+
+// LayerBucketStartGte is an autogenerated method
+// nolint: dupl
+func (qs FlagQuerySet) LayerBucketStartGte(layerBucketStart uint) FlagQuerySet {
+	return qs.w(qs.db.Where("layer_bucket_start >= ?", layerBucketStart))
+}
+
+// LayerBucketStartIn is an autogenerated method
+// nolint: dupl
+func (qs FlagQuerySet) LayerBucketStartIn(layerBucketStart ...uint) FlagQuerySet {
+	if len(layerBucketStart) == 0 {
+		qs.db.AddError(errors.New("must at least pass one layerBucketStart in LayerBucketStartIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("layer_bucket_start IN (?)", layerBucketStart))
+}
+
+// LayerBucketStartLt is an autogenerated method
+// nolint: dupl
+func (qs FlagQuerySet) LayerBucketStartLt(layerBucketStart uint) FlagQuerySet {
+	return qs.w(qs.db.Where("layer_bucket_start < ?", layerBucketStart))
+}
+
+// LayerBucketStartLte is an autogenerated method
+// nolint: dupl
+func (qs FlagQuerySet) LayerBucketStartLte(layerBucketStart uint) FlagQuerySet {
+	return qs.w(qs.db.Where("layer_bucket_start <= ?", layerBucketStart))
+}
+
+// LayerBucketStartNe is an autogenerated method
+// nolint: dupl
+func (qs FlagQuerySet) LayerBucketStartNe(layerBucketStart uint) FlagQuerySet {
+	return qs.w(qs.db.Where("layer_bucket_start != ?", layerBucketStart))
+}
+
+// LayerBucketStartNotIn is an autogenerated method
+// nolint: dupl
+func (qs FlagQuerySet) LayerBucketStartNotIn(layerBucketStart ...uint) FlagQuerySet {
+	if len(layerBucketStart) == 0 {
+		qs.db.AddError(errors.New("must at least pass one layerBucketStart in LayerBucketStartNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("layer_bucket_start NOT IN (?)", layerBucketStart))
+}
+
+// LayerIDEq is an autogenerated method
+// nolint: dupl
+func (qs FlagQuerySet) LayerIDEq(layerID uint) FlagQuerySet {
+	return qs.w(qs.db.Where("layer_id = ?", layerID))
+}
+
+// LayerIDGt is an autogenerated method
+// nolint: dupl
+func (qs FlagQuerySet) LayerIDGt(layerID uint) FlagQuerySet {
+	return qs.w(qs.db.Where("layer_id > ?", layerID))
+}
+
+// LayerIDGte is an autogenerated method
+// nolint: dupl
+func (qs FlagQuerySet) LayerIDGte(layerID uint) FlagQuerySet {
+	return qs.w(qs.db.Where("layer_id >= ?", layerID))
+}
+
+// LayerIDIn is an autogenerated method
+// nolint: dupl
+func (qs FlagQuerySet) LayerIDIn(layerID ...uint) FlagQuerySet {
+	if len(layerID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one layerID in LayerIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("layer_id IN (?)", layerID))
+}
+
+// LayerIDLt is an autogenerated method
+// nolint: dupl
+func (qs FlagQuerySet) LayerIDLt(layerID uint) FlagQuerySet {
+	return qs.w(qs.db.Where("layer_id < ?", layerID))
+}
+
+// LayerIDLte is an autogenerated method
+// nolint: dupl
+func (qs FlagQuerySet) LayerIDLte(layerID uint) FlagQuerySet {
+	return qs.w(qs.db.Where("layer_id <= ?", layerID))
+}
+
+// LayerIDNe is an autogenerated method
+// nolint: dupl
+func (qs FlagQuerySet) LayerIDNe(layerID uint) FlagQuerySet {
+	return qs.w(qs.db.Where("layer_id != ?", layerID))
+}
+
+// LayerIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs FlagQuerySet) LayerIDNotIn(layerID ...uint) FlagQuerySet {
+	if len(layerID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one layerID in LayerIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("layer_id NOT IN (?)", layerID))
+}
+
 // Limit is an autogenerated method
 // nolint: dupl
 func (qs FlagQuerySet) Limit(limit int) FlagQuerySet {
@@ -404,6 +636,24 @@ func (qs FlagQuerySet) OrderAscByID() FlagQuerySet {
 	return qs.w(qs.db.Order("id ASC"))
 }
 
+// OrderAscByLayerBucketEnd is an autogenerated method
+// nolint: dupl
+func (qs FlagQuerySet) OrderAscByLayerBucketEnd() FlagQuerySet {
+	return qs.w(qs.db.Order("layer_bucket_end ASC"))
+}
+
+// OrderAscByLayerBucketStart is an autogenerated method
+// nolint: dupl
+func (qs FlagQuerySet) OrderAscByLayerBucketStart() FlagQuerySet {
+	return qs.w(qs.db.Order("layer_bucket_start ASC"))
+}
+
+// OrderAscByLayerID is an autogenerated method
+// nolint: dupl
+func (qs FlagQuerySet) OrderAscByLayerID() FlagQuerySet {
+	return qs.w(qs.db.Order("layer_id ASC"))
+}
+
 // OrderAscBySnapshotID is an autogenerated method
 // nolint: dupl
 func (qs FlagQuerySet) OrderAscBySnapshotID() FlagQuerySet {
@@ -434,6 +684,24 @@ func (qs FlagQuerySet) OrderDescByID() FlagQuerySet {
 	return qs.w(qs.db.Order("id DESC"))
 }
 
+// OrderDescByLayerBucketEnd is an autogenerated method
+// nolint: dupl
+func (qs FlagQuerySet) OrderDescByLayerBucketEnd() FlagQuerySet {
+	return qs.w(qs.db.Order("layer_bucket_end DESC"))
+}
+
+// OrderDescByLayerBucketStart is an autogenerated method
+// nolint: dupl
+func (qs FlagQuerySet) OrderDescByLayerBucketStart() FlagQuerySet {
+	return qs.w(qs.db.Order("layer_bucket_start DESC"))
+}
+
+// OrderDescByLayerID is an autogenerated method
+// nolint: dupl
+func (qs FlagQuerySet) OrderDescByLayerID() FlagQuerySet {
+	return qs.w(qs.db.Order("layer_id DESC"))
+}
+
 // OrderDescBySnapshotID is an autogenerated method
 // nolint: dupl
 func (qs FlagQuerySet) OrderDescBySnapshotID() FlagQuerySet {
@@ -446,6 +714,45 @@ func (qs FlagQuerySet) OrderDescByUpdatedAt() FlagQuerySet {
 	return qs.w(qs.db.Order("updated_at DESC"))
 }
 
+// SaltEq is an autogenerated method
+// nolint: dupl
+func (qs FlagQuerySet) SaltEq(salt string) FlagQuerySet {
+	return qs.w(qs.db.Where("salt = ?", salt))
+}
+
+// SaltIn is an autogenerated method
+// nolint: dupl
+func (qs FlagQuerySet) SaltIn(salt ...string) FlagQuerySet {
+	if len(salt) == 0 {
+		qs.db.AddError(errors.New("must at least pass one salt in SaltIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("salt IN (?)", salt))
+}
+
+// SaltNe is an autogenerated method
+// nolint: dupl
+func (qs FlagQuerySet) SaltNe(salt string) FlagQuerySet {
+	return qs.w(qs.db.Where("salt != ?", salt))
+}
+
+// SaltNotIn is an autogenerated method
+// nolint: dupl
+func (qs FlagQuerySet) SaltNotIn(salt ...string) FlagQuerySet {
+	if len(salt) == 0 {
+		qs.db.AddError(errors.New("must at least pass one salt in SaltNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("salt NOT IN (?)", salt))
+}
+
+// SetBucketBy is an autogenerated method
+// nolint: dupl
+func (u FlagUpdater) SetBucketBy(bucketBy string) FlagUpdater {
+	u.fields[string(FlagDBSchema.BucketBy)] = bucketBy
+	return u
+}
+
 // SetCreatedAt is an autogenerated method
 // nolint: dupl
 func (u FlagUpdater) SetCreatedAt(createdAt time.Time) FlagUpdater {
@@ -488,6 +795,13 @@ func (u FlagUpdater) SetEnabled(enabled bool) FlagUpdater {
 	return u
 }
 
+// SetHash is an autogenerated method
+// nolint: dupl
+func (u FlagUpdater) SetHash(hash string) FlagUpdater {
+	u.fields[string(FlagDBSchema.Hash)] = hash
+	return u
+}
+
 // SetID is an autogenerated method
 // nolint: dupl
 func (u FlagUpdater) SetID(ID uint) FlagUpdater {
@@ -502,6 +816,34 @@ func (u FlagUpdater) SetKey(key string) FlagUpdater {
 	return u
 }
 
+// SetLayerBucketEnd is an autogenerated method
+// nolint: dupl
+func (u FlagUpdater) SetLayerBucketEnd(layerBucketEnd uint) FlagUpdater {
+	u.fields[string(FlagDBSchema.LayerBucketEnd)] = layerBucketEnd
+	return u
+}
+
+// SetLayerBucketStart is an autogenerated method
+// nolint: dupl
+func (u FlagUpdater) SetLayerBucketStart(layerBucketStart uint) FlagUpdater {
+	u.fields[string(FlagDBSchema.LayerBucketStart)] = layerBucketStart
+	return u
+}
+
+// SetLayerID is an autogenerated method
+// nolint: dupl
+func (u FlagUpdater) SetLayerID(layerID uint) FlagUpdater {
+	u.fields[string(FlagDBSchema.LayerID)] = layerID
+	return u
+}
+
+// SetSalt is an autogenerated method
+// nolint: dupl
+func (u FlagUpdater) SetSalt(salt string) FlagUpdater {
+	u.fields[string(FlagDBSchema.Salt)] = salt
+	return u
+}
+
 // SetSnapshotID is an autogenerated method
 // nolint: dupl
 func (u FlagUpdater) SetSnapshotID(snapshotID uint) FlagUpdater {
@@ -509,6 +851,13 @@ func (u FlagUpdater) SetSnapshotID(snapshotID uint) FlagUpdater {
 	return u
 }
 
+// SetStickyAssignments is an autogenerated method
+// nolint: dupl
+func (u FlagUpdater) SetStickyAssignments(stickyAssignments bool) FlagUpdater {
+	u.fields[string(FlagDBSchema.StickyAssignments)] = stickyAssignments
+	return u
+}
+
 // SetUpdatedAt is an autogenerated method
 // nolint: dupl
 func (u FlagUpdater) SetUpdatedAt(updatedAt time.Time) FlagUpdater {
@@ -579,6 +928,38 @@ func (qs FlagQuerySet) SnapshotIDNotIn(snapshotID ...uint) FlagQuerySet {
 	return qs.w(qs.db.Where("snapshot_id NOT IN (?)", snapshotID))
 }
 
+// StickyAssignmentsEq is an autogenerated method
+// nolint: dupl
+func (qs FlagQuerySet) StickyAssignmentsEq(stickyAssignments bool) FlagQuerySet {
+	return qs.w(qs.db.Where("sticky_assignments = ?", stickyAssignments))
+}
+
+// StickyAssignmentsIn is an autogenerated method
+// nolint: dupl
+func (qs FlagQuerySet) StickyAssignmentsIn(stickyAssignments ...bool) FlagQuerySet {
+	if len(stickyAssignments) == 0 {
+		qs.db.AddError(errors.New("must at least pass one stickyAssignments in StickyAssignmentsIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("sticky_assignments IN (?)", stickyAssignments))
+}
+
+// StickyAssignmentsNe is an autogenerated method
+// nolint: dupl
+func (qs FlagQuerySet) StickyAssignmentsNe(stickyAssignments bool) FlagQuerySet {
+	return qs.w(qs.db.Where("sticky_assignments != ?", stickyAssignments))
+}
+
+// StickyAssignmentsNotIn is an autogenerated method
+// nolint: dupl
+func (qs FlagQuerySet) StickyAssignmentsNotIn(stickyAssignments ...bool) FlagQuerySet {
+	if len(stickyAssignments) == 0 {
+		qs.db.AddError(errors.New("must at least pass one stickyAssignments in StickyAssignmentsNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("sticky_assignments NOT IN (?)", stickyAssignments))
+}
+
 // Update is an autogenerated method
 // nolint: dupl
 func (u FlagUpdater) Update() error {
@@ -686,6 +1067,13 @@ var FlagDBSchema = struct {
 	Enabled            FlagDBSchemaField
 	DataRecordsEnabled FlagDBSchemaField
 	SnapshotID         FlagDBSchemaField
+	Salt               FlagDBSchemaField
+	Hash               FlagDBSchemaField
+	BucketBy           FlagDBSchemaField
+	StickyAssignments  FlagDBSchemaField
+	LayerID            FlagDBSchemaField
+	LayerBucketStart   FlagDBSchemaField
+	LayerBucketEnd     FlagDBSchemaField
 }{
 
 	ID:                 FlagDBSchemaField("id"),
@@ -699,6 +1087,13 @@ var FlagDBSchema = struct {
 	Enabled:            FlagDBSchemaField("enabled"),
 	DataRecordsEnabled: FlagDBSchemaField("data_records_enabled"),
 	SnapshotID:         FlagDBSchemaField("snapshot_id"),
+	Salt:               FlagDBSchemaField("salt"),
+	Hash:               FlagDBSchemaField("hash"),
+	BucketBy:           FlagDBSchemaField("bucket_by"),
+	StickyAssignments:  FlagDBSchemaField("sticky_assignments"),
+	LayerID:            FlagDBSchemaField("layer_id"),
+	LayerBucketStart:   FlagDBSchemaField("layer_bucket_start"),
+	LayerBucketEnd:     FlagDBSchemaField("layer_bucket_end"),
 }
 
 // Update updates Flag fields by primary key
@@ -716,6 +1111,13 @@ func (o *Flag) Update(db *gorm.DB, fields ...FlagDBSchemaField) error {
 		"enabled":              o.Enabled,
 		"data_records_enabled": o.DataRecordsEnabled,
 		"snapshot_id":          o.SnapshotID,
+		"salt":                 o.Salt,
+		"hash":                 o.Hash,
+		"bucket_by":            o.BucketBy,
+		"sticky_assignments":   o.StickyAssignments,
+		"layer_id":             o.LayerID,
+		"layer_bucket_start":   o.LayerBucketStart,
+		"layer_bucket_end":     o.LayerBucketEnd,
 	}
 	u := map[string]interface{}{}
 	for _, f := range fields {
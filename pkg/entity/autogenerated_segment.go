@@ -36,6 +36,62 @@ func (qs SegmentQuerySet) All(ret *[]Segment) error {
 	return qs.db.Find(ret).Error
 }
 
+// AudienceIDEq is an autogenerated method
+// nolint: dupl
+func (qs SegmentQuerySet) AudienceIDEq(audienceID uint) SegmentQuerySet {
+	return qs.w(qs.db.Where("audience_id = ?", audienceID))
+}
+
+// AudienceIDGt is an autogenerated method
+// nolint: dupl
+func (qs SegmentQuerySet) AudienceIDGt(audienceID uint) SegmentQuerySet {
+	return qs.w(qs.db.Where("audience_id > ?", audienceID))
+}
+
+// AudienceIDGte is an autogenerated method
+// nolint: dupl
+func (qs SegmentQuerySet) AudienceIDGte(audienceID uint) SegmentQuerySet {
+	return qs.w(qs.db.Where("audience_id >= ?", audienceID))
+}
+
+// AudienceIDIn is an autogenerated method
+// nolint: dupl
+func (qs SegmentQuerySet) AudienceIDIn(audienceID ...uint) SegmentQuerySet {
+	if len(audienceID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one audienceID in AudienceIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("audience_id IN (?)", audienceID))
+}
+
+// AudienceIDLt is an autogenerated method
+// nolint: dupl
+func (qs SegmentQuerySet) AudienceIDLt(audienceID uint) SegmentQuerySet {
+	return qs.w(qs.db.Where("audience_id < ?", audienceID))
+}
+
+// AudienceIDLte is an autogenerated method
+// nolint: dupl
+func (qs SegmentQuerySet) AudienceIDLte(audienceID uint) SegmentQuerySet {
+	return qs.w(qs.db.Where("audience_id <= ?", audienceID))
+}
+
+// AudienceIDNe is an autogenerated method
+// nolint: dupl
+func (qs SegmentQuerySet) AudienceIDNe(audienceID uint) SegmentQuerySet {
+	return qs.w(qs.db.Where("audience_id != ?", audienceID))
+}
+
+// AudienceIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs SegmentQuerySet) AudienceIDNotIn(audienceID ...uint) SegmentQuerySet {
+	if len(audienceID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one audienceID in AudienceIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("audience_id NOT IN (?)", audienceID))
+}
+
 // Count is an autogenerated method
 // nolint: dupl
 func (qs SegmentQuerySet) Count() (int, error) {
@@ -314,6 +370,12 @@ func (qs SegmentQuerySet) One(ret *Segment) error {
 	return qs.db.First(ret).Error
 }
 
+// OrderAscByAudienceID is an autogenerated method
+// nolint: dupl
+func (qs SegmentQuerySet) OrderAscByAudienceID() SegmentQuerySet {
+	return qs.w(qs.db.Order("audience_id ASC"))
+}
+
 // OrderAscByCreatedAt is an autogenerated method
 // nolint: dupl
 func (qs SegmentQuerySet) OrderAscByCreatedAt() SegmentQuerySet {
@@ -344,6 +406,12 @@ func (qs SegmentQuerySet) OrderAscByRank() SegmentQuerySet {
 	return qs.w(qs.db.Order("rank ASC"))
 }
 
+// OrderAscByRolloutBasisPoints is an autogenerated method
+// nolint: dupl
+func (qs SegmentQuerySet) OrderAscByRolloutBasisPoints() SegmentQuerySet {
+	return qs.w(qs.db.Order("rollout_basis_points ASC"))
+}
+
 // OrderAscByRolloutPercent is an autogenerated method
 // nolint: dupl
 func (qs SegmentQuerySet) OrderAscByRolloutPercent() SegmentQuerySet {
@@ -356,6 +424,12 @@ func (qs SegmentQuerySet) OrderAscByUpdatedAt() SegmentQuerySet {
 	return qs.w(qs.db.Order("updated_at ASC"))
 }
 
+// OrderDescByAudienceID is an autogenerated method
+// nolint: dupl
+func (qs SegmentQuerySet) OrderDescByAudienceID() SegmentQuerySet {
+	return qs.w(qs.db.Order("audience_id DESC"))
+}
+
 // OrderDescByCreatedAt is an autogenerated method
 // nolint: dupl
 func (qs SegmentQuerySet) OrderDescByCreatedAt() SegmentQuerySet {
@@ -386,6 +460,12 @@ func (qs SegmentQuerySet) OrderDescByRank() SegmentQuerySet {
 	return qs.w(qs.db.Order("rank DESC"))
 }
 
+// OrderDescByRolloutBasisPoints is an autogenerated method
+// nolint: dupl
+func (qs SegmentQuerySet) OrderDescByRolloutBasisPoints() SegmentQuerySet {
+	return qs.w(qs.db.Order("rollout_basis_points DESC"))
+}
+
 // OrderDescByRolloutPercent is an autogenerated method
 // nolint: dupl
 func (qs SegmentQuerySet) OrderDescByRolloutPercent() SegmentQuerySet {
@@ -454,6 +534,62 @@ func (qs SegmentQuerySet) RankNotIn(rank ...uint) SegmentQuerySet {
 	return qs.w(qs.db.Where("rank NOT IN (?)", rank))
 }
 
+// RolloutBasisPointsEq is an autogenerated method
+// nolint: dupl
+func (qs SegmentQuerySet) RolloutBasisPointsEq(rolloutBasisPoints uint) SegmentQuerySet {
+	return qs.w(qs.db.Where("rollout_basis_points = ?", rolloutBasisPoints))
+}
+
+// RolloutBasisPointsGt is an autogenerated method
+// nolint: dupl
+func (qs SegmentQuerySet) RolloutBasisPointsGt(rolloutBasisPoints uint) SegmentQuerySet {
+	return qs.w(qs.db.Where("rollout_basis_points > ?", rolloutBasisPoints))
+}
+
+// RolloutBasisPointsGte is an autogenerated method
+// nolint: dupl
+func (qs SegmentQuerySet) RolloutBasisPointsGte(rolloutBasisPoints uint) SegmentQuerySet {
+	return qs.w(qs.db.Where("rollout_basis_points >= ?", rolloutBasisPoints))
+}
+
+// RolloutBasisPointsIn is an autogenerated method
+// nolint: dupl
+func (qs SegmentQuerySet) RolloutBasisPointsIn(rolloutBasisPoints ...uint) SegmentQuerySet {
+	if len(rolloutBasisPoints) == 0 {
+		qs.db.AddError(errors.New("must at least pass one rolloutBasisPoints in RolloutBasisPointsIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("rollout_basis_points IN (?)", rolloutBasisPoints))
+}
+
+// RolloutBasisPointsLt is an autogenerated method
+// nolint: dupl
+func (qs SegmentQuerySet) RolloutBasisPointsLt(rolloutBasisPoints uint) SegmentQuerySet {
+	return qs.w(qs.db.Where("rollout_basis_points < ?", rolloutBasisPoints))
+}
+
+// RolloutBasisPointsLte is an autogenerated method
+// nolint: dupl
+func (qs SegmentQuerySet) RolloutBasisPointsLte(rolloutBasisPoints uint) SegmentQuerySet {
+	return qs.w(qs.db.Where("rollout_basis_points <= ?", rolloutBasisPoints))
+}
+
+// RolloutBasisPointsNe is an autogenerated method
+// nolint: dupl
+func (qs SegmentQuerySet) RolloutBasisPointsNe(rolloutBasisPoints uint) SegmentQuerySet {
+	return qs.w(qs.db.Where("rollout_basis_points != ?", rolloutBasisPoints))
+}
+
+// RolloutBasisPointsNotIn is an autogenerated method
+// nolint: dupl
+func (qs SegmentQuerySet) RolloutBasisPointsNotIn(rolloutBasisPoints ...uint) SegmentQuerySet {
+	if len(rolloutBasisPoints) == 0 {
+		qs.db.AddError(errors.New("must at least pass one rolloutBasisPoints in RolloutBasisPointsNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("rollout_basis_points NOT IN (?)", rolloutBasisPoints))
+}
+
 // RolloutPercentEq is an autogenerated method
 // nolint: dupl
 func (qs SegmentQuerySet) RolloutPercentEq(rolloutPercent uint) SegmentQuerySet {
@@ -510,6 +646,13 @@ func (qs SegmentQuerySet) RolloutPercentNotIn(rolloutPercent ...uint) SegmentQue
 	return qs.w(qs.db.Where("rollout_percent NOT IN (?)", rolloutPercent))
 }
 
+// SetAudienceID is an autogenerated method
+// nolint: dupl
+func (u SegmentUpdater) SetAudienceID(audienceID uint) SegmentUpdater {
+	u.fields[string(SegmentDBSchema.AudienceID)] = audienceID
+	return u
+}
+
 // SetCreatedAt is an autogenerated method
 // nolint: dupl
 func (u SegmentUpdater) SetCreatedAt(createdAt time.Time) SegmentUpdater {
@@ -552,6 +695,13 @@ func (u SegmentUpdater) SetRank(rank uint) SegmentUpdater {
 	return u
 }
 
+// SetRolloutBasisPoints is an autogenerated method
+// nolint: dupl
+func (u SegmentUpdater) SetRolloutBasisPoints(rolloutBasisPoints uint) SegmentUpdater {
+	u.fields[string(SegmentDBSchema.RolloutBasisPoints)] = rolloutBasisPoints
+	return u
+}
+
 // SetRolloutPercent is an autogenerated method
 // nolint: dupl
 func (u SegmentUpdater) SetRolloutPercent(rolloutPercent uint) SegmentUpdater {
@@ -630,38 +780,44 @@ func (f SegmentDBSchemaField) String() string {
 
 // SegmentDBSchema stores db field names of Segment
 var SegmentDBSchema = struct {
-	ID             SegmentDBSchemaField
-	CreatedAt      SegmentDBSchemaField
-	UpdatedAt      SegmentDBSchemaField
-	DeletedAt      SegmentDBSchemaField
-	FlagID         SegmentDBSchemaField
-	Description    SegmentDBSchemaField
-	Rank           SegmentDBSchemaField
-	RolloutPercent SegmentDBSchemaField
+	ID                 SegmentDBSchemaField
+	CreatedAt          SegmentDBSchemaField
+	UpdatedAt          SegmentDBSchemaField
+	DeletedAt          SegmentDBSchemaField
+	FlagID             SegmentDBSchemaField
+	Description        SegmentDBSchemaField
+	Rank               SegmentDBSchemaField
+	RolloutPercent     SegmentDBSchemaField
+	RolloutBasisPoints SegmentDBSchemaField
+	AudienceID         SegmentDBSchemaField
 }{
 
-	ID:             SegmentDBSchemaField("id"),
-	CreatedAt:      SegmentDBSchemaField("created_at"),
-	UpdatedAt:      SegmentDBSchemaField("updated_at"),
-	DeletedAt:      SegmentDBSchemaField("deleted_at"),
-	FlagID:         SegmentDBSchemaField("flag_id"),
-	Description:    SegmentDBSchemaField("description"),
-	Rank:           SegmentDBSchemaField("rank"),
-	RolloutPercent: SegmentDBSchemaField("rollout_percent"),
+	ID:                 SegmentDBSchemaField("id"),
+	CreatedAt:          SegmentDBSchemaField("created_at"),
+	UpdatedAt:          SegmentDBSchemaField("updated_at"),
+	DeletedAt:          SegmentDBSchemaField("deleted_at"),
+	FlagID:             SegmentDBSchemaField("flag_id"),
+	Description:        SegmentDBSchemaField("description"),
+	Rank:               SegmentDBSchemaField("rank"),
+	RolloutPercent:     SegmentDBSchemaField("rollout_percent"),
+	RolloutBasisPoints: SegmentDBSchemaField("rollout_basis_points"),
+	AudienceID:         SegmentDBSchemaField("audience_id"),
 }
 
 // Update updates Segment fields by primary key
 // nolint: dupl
 func (o *Segment) Update(db *gorm.DB, fields ...SegmentDBSchemaField) error {
 	dbNameToFieldName := map[string]interface{}{
-		"id":              o.ID,
-		"created_at":      o.CreatedAt,
-		"updated_at":      o.UpdatedAt,
-		"deleted_at":      o.DeletedAt,
-		"flag_id":         o.FlagID,
-		"description":     o.Description,
-		"rank":            o.Rank,
-		"rollout_percent": o.RolloutPercent,
+		"id":                   o.ID,
+		"created_at":           o.CreatedAt,
+		"updated_at":           o.UpdatedAt,
+		"deleted_at":           o.DeletedAt,
+		"flag_id":              o.FlagID,
+		"description":          o.Description,
+		"rank":                 o.Rank,
+		"rollout_percent":      o.RolloutPercent,
+		"rollout_basis_points": o.RolloutBasisPoints,
+		"audience_id":          o.AudienceID,
 	}
 	u := map[string]interface{}{}
 	for _, f := range fields {
@@ -16,10 +16,16 @@ import (
 type Constraint struct {
 	gorm.Model
 
-	SegmentID uint `gorm:"index:idx_constraint_segmentid"`
-	Property  string
-	Operator  string
-	Value     string `sql:"type:text"`
+	// a constraint belongs to either a segment or an audience
+	SegmentID  uint `gorm:"index:idx_constraint_segmentid"`
+	AudienceID uint `gorm:"index:idx_constraint_audienceid"`
+	Property   string
+	Operator   string
+	Value      string `sql:"type:text"`
+
+	// ValueType is the type of the plain value, e.g. STRING for CA. The value is a literal of
+	// the expr, e.g. "CA", if it's empty
+	ValueType string
 }
 
 // ConstraintArray is an array of Constraint
@@ -64,6 +70,12 @@ func (c *Constraint) toExprStr() (string, error) {
 			c.Value,
 		)
 	}
+	if c.usesMatcher() {
+		if _, err := c.matcher(); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("({%s} == true)", c.matcherVar()), nil
+	}
 	o, ok := OperatorToExprMap[c.Operator]
 	if !ok {
 		return "", fmt.Errorf("not supported operator: %s", c.Operator)
@@ -72,6 +84,25 @@ func (c *Constraint) toExprStr() (string, error) {
 	return fmt.Sprintf("({%s} %s %s)", c.Property, o, c.Value), nil
 }
 
+// describe describes the constraint for the debug logs, with the operator of the
+// constraint instead of the variable standing for it in the expr
+func (c *Constraint) describe() (string, error) {
+	s, err := c.toExprStr()
+	if err != nil {
+		return s, err
+	}
+	if c.isTyped() {
+		return fmt.Sprintf("({%s} %s %s)", c.Property, OperatorToExprMap[c.Operator], c.describeTypedValue()), nil
+	}
+	if !isCustomOperator(c.Operator) {
+		if isDerivedProperty(c.Property) {
+			return fmt.Sprintf("({%s} %s %s)", c.Property, OperatorToExprMap[c.Operator], c.Value), nil
+		}
+		return s, nil
+	}
+	return fmt.Sprintf("({%s} %s %s)", c.Property, customOperators[c.Operator].label, c.Value), nil
+}
+
 // Validate validates Constraint
 func (c *Constraint) Validate() error {
 	_, err := c.ToExpr()
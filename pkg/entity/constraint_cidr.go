@@ -0,0 +1,62 @@
+package entity
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/spf13/cast"
+)
+
+// cidrMatcher matches the property as an IPv4 or IPv6 address against the CIDR ranges, or the
+// negation of it if not is set. A bare address is a range of itself
+func cidrMatcher(not bool) func(value string) (valueMatcher, error) {
+	return func(value string) (valueMatcher, error) {
+		list, err := parseStringList(value)
+		if err != nil {
+			return nil, err
+		}
+		ipNets := make([]*net.IPNet, 0, len(list))
+		for _, s := range list {
+			ipNet, err := parseCIDR(s)
+			if err != nil {
+				return nil, err
+			}
+			ipNets = append(ipNets, ipNet)
+		}
+		return func(v interface{}) (bool, error) {
+			s, err := cast.ToStringE(v)
+			if err != nil {
+				return false, fmt.Errorf("invalid IP address %v", v)
+			}
+			ip := net.ParseIP(strings.TrimSpace(s))
+			if ip == nil {
+				return false, fmt.Errorf("invalid IP address %q", s)
+			}
+			for _, ipNet := range ipNets {
+				if ipNet.Contains(ip) {
+					return !not, nil
+				}
+			}
+			return not, nil
+		}, nil
+	}
+}
+
+func parseCIDR(s string) (*net.IPNet, error) {
+	if !strings.Contains(s, "/") {
+		ip := net.ParseIP(s)
+		if ip == nil {
+			return nil, fmt.Errorf("invalid CIDR range %q", s)
+		}
+		if ip4 := ip.To4(); ip4 != nil {
+			return &net.IPNet{IP: ip4, Mask: net.CIDRMask(32, 32)}, nil
+		}
+		return &net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}, nil
+	}
+	_, ipNet, err := net.ParseCIDR(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid CIDR range %q", s)
+	}
+	return ipNet, nil
+}
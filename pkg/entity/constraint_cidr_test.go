@@ -0,0 +1,50 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/checkr/flagr/swagger_gen/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConstraintCIDROperators(t *testing.T) {
+	s := GenFixtureSegment()
+	s.Constraints = ConstraintArray{{Property: "ip", Operator: models.ConstraintOperatorINCIDR, Value: `["10.0.0.0/8", "192.168.1.7", "fd00::/8"]`}}
+	assert.NoError(t, s.PrepareEvaluation())
+	assert.Len(t, s.SegmentEvaluation.ConditionsMatchers, 1)
+
+	for ip, expected := range map[string]bool{
+		"10.1.2.3":       true,
+		"11.1.2.3":       false,
+		"192.168.1.7":    true,
+		"192.168.1.8":    false,
+		"::ffff:a01:203": true, // 10.1.2.3 mapped to IPv6
+		"fd12::1":        true,
+		"fe80::1":        false,
+	} {
+		m, errs := EvaluateConditions(s.SegmentEvaluation.ConditionsExpr, s.SegmentEvaluation.ConditionsMatchers, map[string]interface{}{"ip": ip})
+		assert.Equal(t, expected, m, ip)
+		assert.Empty(t, errs)
+	}
+
+	m, errs := EvaluateConditions(s.SegmentEvaluation.ConditionsExpr, s.SegmentEvaluation.ConditionsMatchers, map[string]interface{}{"ip": "localhost"})
+	assert.False(t, m)
+	assert.Len(t, errs, 1)
+
+	s.Constraints = ConstraintArray{{Property: "ip", Operator: models.ConstraintOperatorNOTINCIDR, Value: `10.0.0.0/8, 172.16.0.0/12`}}
+	assert.NoError(t, s.PrepareEvaluation())
+	m, _ = EvaluateConditions(s.SegmentEvaluation.ConditionsExpr, s.SegmentEvaluation.ConditionsMatchers, map[string]interface{}{"ip": "172.20.0.1"})
+	assert.False(t, m)
+	m, _ = EvaluateConditions(s.SegmentEvaluation.ConditionsExpr, s.SegmentEvaluation.ConditionsMatchers, map[string]interface{}{"ip": "8.8.8.8"})
+	assert.True(t, m)
+}
+
+func TestConstraintCIDROperatorsValidate(t *testing.T) {
+	for _, c := range []Constraint{
+		{Property: "ip", Operator: models.ConstraintOperatorINCIDR, Value: `10.0.0.0/33`},
+		{Property: "ip", Operator: models.ConstraintOperatorINCIDR, Value: `["10.0.0.0/8", "internal"]`},
+		{Property: "ip", Operator: models.ConstraintOperatorNOTINCIDR, Value: `10.0.0.0/8,`},
+	} {
+		assert.Error(t, c.Validate(), c.Value)
+	}
+}
@@ -0,0 +1,282 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cast"
+	"github.com/zhouzhuojie/conditions"
+)
+
+// The operators of the constraint groups
+const (
+	ConstraintGroupAND = "AND"
+	ConstraintGroupOR  = "OR"
+	ConstraintGroupNOT = "NOT"
+)
+
+// ConstraintGroup is a boolean expression tree over the constraints of a segment. A leaf
+// references a constraint by ID, and a group joins its children with AND or OR, or negates
+// its only child with NOT. The constraints not in the group are joined by AND with it, and
+// an empty ConstraintGroup joins all the constraints by AND
+type ConstraintGroup struct {
+	Operator     string            `json:"operator,omitempty"`
+	ConstraintID uint              `json:"constraintID,omitempty"`
+	Children     []ConstraintGroup `json:"children,omitempty"`
+}
+
+// Scan implements scanner interface
+func (g *ConstraintGroup) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+	s := cast.ToString(value)
+	if s == "" {
+		return nil
+	}
+	if err := json.Unmarshal([]byte(s), g); err != nil {
+		return fmt.Errorf("cannot scan %v into ConstraintGroup type. err: %v", value, err)
+	}
+	return nil
+}
+
+// Value implements valuer interface
+func (g ConstraintGroup) Value() (driver.Value, error) {
+	if g.IsEmpty() {
+		return nil, nil
+	}
+	bytes, err := json.Marshal(g)
+	if err != nil {
+		return nil, err
+	}
+	return string(bytes), nil
+}
+
+// IsEmpty checks whether the group is empty
+func (g ConstraintGroup) IsEmpty() bool {
+	return g.Operator == "" && g.ConstraintID == 0 && len(g.Children) == 0
+}
+
+// Validate validates the group against the constraints of the segment, every
+// constraint can be referenced at most once
+func (g ConstraintGroup) Validate(cs ConstraintArray) error {
+	if g.IsEmpty() {
+		return nil
+	}
+	ids := make(map[uint]bool)
+	for _, c := range cs {
+		ids[c.ID] = false
+	}
+	return g.validate(ids)
+}
+
+func (g ConstraintGroup) validate(ids map[uint]bool) error {
+	if g.ConstraintID != 0 {
+		if g.Operator != "" || len(g.Children) != 0 {
+			return fmt.Errorf("constraintID %v should not have an operator or children", g.ConstraintID)
+		}
+		used, ok := ids[g.ConstraintID]
+		if !ok {
+			return fmt.Errorf("constraintID %v is not a constraint of the segment", g.ConstraintID)
+		}
+		if used {
+			return fmt.Errorf("constraintID %v is referenced more than once", g.ConstraintID)
+		}
+		ids[g.ConstraintID] = true
+		return nil
+	}
+
+	switch g.Operator {
+	case ConstraintGroupAND, ConstraintGroupOR:
+		if len(g.Children) == 0 {
+			return fmt.Errorf("%s group should have children", g.Operator)
+		}
+	case ConstraintGroupNOT:
+		if len(g.Children) != 1 {
+			return fmt.Errorf("NOT group should have exactly one child, got %d", len(g.Children))
+		}
+	default:
+		return fmt.Errorf("not supported constraint group operator: %s", g.Operator)
+	}
+	for _, child := range g.Children {
+		if err := child.validate(ids); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Without removes the constraint from the group, and the groups left without children
+func (g ConstraintGroup) Without(constraintID uint) ConstraintGroup {
+	if g.ConstraintID == constraintID {
+		return ConstraintGroup{}
+	}
+	if len(g.Children) == 0 {
+		return g
+	}
+	children := []ConstraintGroup{}
+	for _, child := range g.Children {
+		if c := child.Without(constraintID); !c.IsEmpty() {
+			children = append(children, c)
+		}
+	}
+	if len(children) == 0 {
+		return ConstraintGroup{}
+	}
+	return ConstraintGroup{Operator: g.Operator, Children: children}
+}
+
+// ToExpr compiles the constraints into a single expr, and describes it for the debug logs.
+// The constraints in the group are combined by the group, and the rest are joined by AND with it
+func (g ConstraintGroup) ToExpr(cs ConstraintArray) (expr conditions.Expr, desc string, err error) {
+	cm := make(map[uint]Constraint)
+	exprStrs := []string{}
+	descs := []string{}
+	for _, c := range cs {
+		cm[c.ID] = c
+		if g.references(c.ID) {
+			continue
+		}
+		s, err := c.toExprStr()
+		if err != nil {
+			return nil, "", err
+		}
+		d, _ := c.describe()
+		exprStrs = append(exprStrs, s)
+		descs = append(descs, d)
+	}
+	if !g.IsEmpty() {
+		exprStr, d, err := g.toExprStr(cm)
+		if err != nil {
+			return nil, "", err
+		}
+		exprStrs = append(exprStrs, exprStr)
+		descs = append(descs, d)
+	}
+
+	p := conditions.NewParser(strings.NewReader(strings.Join(exprStrs, " AND ")))
+	expr, err = p.Parse()
+	if err != nil {
+		return nil, "", fmt.Errorf("%s. Note: if it's string or array of string, wrap it with quotes \"...\"", err)
+	}
+	return expr, strings.Join(descs, " AND "), nil
+}
+
+func (g ConstraintGroup) references(constraintID uint) bool {
+	if g.ConstraintID != 0 && g.ConstraintID == constraintID {
+		return true
+	}
+	for _, child := range g.Children {
+		if child.references(constraintID) {
+			return true
+		}
+	}
+	return false
+}
+
+// toExprStr returns the expression of the group, and its description in which NOT
+// is spelled out instead of the NAND the conditions package supports
+func (g ConstraintGroup) toExprStr(cm map[uint]Constraint) (exprStr string, desc string, err error) {
+	if g.ConstraintID != 0 {
+		c, ok := cm[g.ConstraintID]
+		if !ok {
+			return "", "", fmt.Errorf("constraintID %v is not a constraint of the segment", g.ConstraintID)
+		}
+		s, err := c.toExprStr()
+		if err != nil {
+			return "", "", err
+		}
+		d, _ := c.describe()
+		return s, d, nil
+	}
+
+	exprStrs := make([]string, len(g.Children), len(g.Children))
+	descs := make([]string, len(g.Children), len(g.Children))
+	for i, child := range g.Children {
+		exprStrs[i], descs[i], err = child.toExprStr(cm)
+		if err != nil {
+			return "", "", err
+		}
+	}
+
+	switch g.Operator {
+	case ConstraintGroupAND, ConstraintGroupOR:
+		if len(g.Children) == 0 {
+			return "", "", fmt.Errorf("%s group should have children", g.Operator)
+		}
+		sep := fmt.Sprintf(" %s ", g.Operator)
+		return "(" + strings.Join(exprStrs, sep) + ")", "(" + strings.Join(descs, sep) + ")", nil
+	case ConstraintGroupNOT:
+		if len(g.Children) != 1 {
+			return "", "", fmt.Errorf("NOT group should have exactly one child, got %d", len(g.Children))
+		}
+		return fmt.Sprintf("(%s NAND true)", exprStrs[0]), fmt.Sprintf("(NOT %s)", descs[0]), nil
+	}
+	return "", "", fmt.Errorf("not supported constraint group operator: %s", g.Operator)
+}
+
+// EvaluateConditions evaluates the expr like conditions.Evaluate, except that the operands of
+// AND, OR and NAND are evaluated on their own, so that a comparison that fails to evaluate, e.g.
+// because its property is missing in the entityContext, doesn't fail the whole expr. Such a
+// comparison is unknown rather than false, the same as NULL in SQL: NOT of it is unknown as well,
+// AND and OR are unknown unless the other operand decides them, and an unknown expr doesn't
+// match. E.g. NOT country == "US" doesn't match the entities without a country. The variables
+// standing for the constraints with custom operators are matched by the matchers. The errors of
+// the comparisons are returned for the debug logs
+func EvaluateConditions(expr conditions.Expr, matchers map[string]ConstraintMatcher, m map[string]interface{}) (bool, []error) {
+	match, known, errs := evaluateConditions(expr, matchers, m)
+	return match && known, errs
+}
+
+func evaluateConditions(expr conditions.Expr, matchers map[string]ConstraintMatcher, m map[string]interface{}) (match bool, known bool, errs []error) {
+	switch n := expr.(type) {
+	case *conditions.ParenExpr:
+		return evaluateConditions(n.Expr, matchers, m)
+	case *conditions.BooleanLiteral:
+		return n.Val, true, nil
+	case *conditions.BinaryExpr:
+		switch n.Op {
+		case conditions.AND, conditions.OR, conditions.NAND:
+			l, lknown, lerrs := evaluateConditions(n.LHS, matchers, m)
+			r, rknown, rerrs := evaluateConditions(n.RHS, matchers, m)
+			errs := append(lerrs, rerrs...)
+			switch n.Op {
+			case conditions.AND:
+				match, known = and(l, lknown, r, rknown)
+				return match, known, errs
+			case conditions.OR:
+				if (lknown && l) || (rknown && r) {
+					return true, true, errs
+				}
+				return false, lknown && rknown, errs
+			default:
+				match, known = and(l, lknown, r, rknown)
+				return !match, known, errs
+			}
+		case conditions.EQ:
+			if v, ok := n.LHS.(*conditions.VarRef); ok && matchers[v.Val] != nil {
+				match, err := matchers[v.Val](m)
+				if err != nil {
+					return false, false, []error{err}
+				}
+				return match, true, nil
+			}
+		}
+	}
+
+	match, err := conditions.Evaluate(expr, m)
+	if err != nil {
+		return false, false, []error{err}
+	}
+	return match, true, nil
+}
+
+// and is AND of the operands, which is false if any of them is known to be false
+func and(l bool, lknown bool, r bool, rknown bool) (match bool, known bool) {
+	if (lknown && !l) || (rknown && !r) {
+		return false, true
+	}
+	return lknown && rknown, lknown && rknown
+}
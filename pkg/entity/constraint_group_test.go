@@ -0,0 +1,163 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/checkr/flagr/swagger_gen/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func genGroupConstraints() ConstraintArray {
+	cs := ConstraintArray{
+		{Property: "country", Operator: models.ConstraintOperatorEQ, Value: `"US"`},
+		{Property: "plan", Operator: models.ConstraintOperatorEQ, Value: `"enterprise"`},
+		{Property: "email", Operator: models.ConstraintOperatorEREG, Value: `"@example.com$"`},
+	}
+	for i := range cs {
+		cs[i].ID = uint(i + 1)
+	}
+	return cs
+}
+
+func TestConstraintGroupValidate(t *testing.T) {
+	cs := genGroupConstraints()
+
+	t.Run("empty group", func(t *testing.T) {
+		assert.NoError(t, ConstraintGroup{}.Validate(cs))
+	})
+
+	t.Run("nested group", func(t *testing.T) {
+		g := ConstraintGroup{Operator: ConstraintGroupOR, Children: []ConstraintGroup{
+			{ConstraintID: 1},
+			{Operator: ConstraintGroupNOT, Children: []ConstraintGroup{{ConstraintID: 2}}},
+		}}
+		assert.NoError(t, g.Validate(cs))
+	})
+
+	t.Run("invalid groups", func(t *testing.T) {
+		for _, g := range []ConstraintGroup{
+			{ConstraintID: 4},
+			{Operator: ConstraintGroupOR},
+			{Operator: "XOR", Children: []ConstraintGroup{{ConstraintID: 1}}},
+			{Operator: ConstraintGroupNOT, Children: []ConstraintGroup{{ConstraintID: 1}, {ConstraintID: 2}}},
+			{Operator: ConstraintGroupAND, Children: []ConstraintGroup{{ConstraintID: 1}, {ConstraintID: 1}}},
+			{Operator: ConstraintGroupAND, ConstraintID: 1},
+		} {
+			assert.Error(t, g.Validate(cs))
+		}
+	})
+}
+
+func TestConstraintGroupWithout(t *testing.T) {
+	g := ConstraintGroup{Operator: ConstraintGroupOR, Children: []ConstraintGroup{
+		{ConstraintID: 1},
+		{Operator: ConstraintGroupNOT, Children: []ConstraintGroup{{ConstraintID: 2}}},
+	}}
+
+	assert.Equal(t, g, g.Without(3))
+	assert.Equal(t, ConstraintGroup{Operator: ConstraintGroupOR, Children: []ConstraintGroup{{ConstraintID: 1}}}, g.Without(2))
+	assert.True(t, g.Without(1).Without(2).IsEmpty())
+}
+
+func TestConstraintGroupScanValue(t *testing.T) {
+	g := ConstraintGroup{Operator: ConstraintGroupOR, Children: []ConstraintGroup{{ConstraintID: 1}, {ConstraintID: 2}}}
+	v, err := g.Value()
+	assert.NoError(t, err)
+
+	scanned := ConstraintGroup{}
+	assert.NoError(t, scanned.Scan(v))
+	assert.Equal(t, g, scanned)
+
+	v, err = ConstraintGroup{}.Value()
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+	assert.Error(t, scanned.Scan("{"))
+}
+
+func TestConstraintGroupToExpr(t *testing.T) {
+	cs := genGroupConstraints()
+
+	t.Run("empty group joins all the constraints by AND", func(t *testing.T) {
+		expr, desc, err := ConstraintGroup{}.ToExpr(cs)
+		assert.NoError(t, err)
+		assert.Equal(t, `({country} == "US") AND ({plan} == "enterprise") AND ({email} =~ "@example.com$")`, desc)
+
+		match, errs := EvaluateConditions(expr, nil, map[string]interface{}{"country": "US", "plan": "enterprise", "email": "a@example.com"})
+		assert.True(t, match)
+		assert.Empty(t, errs)
+	})
+
+	t.Run("OR and NOT group", func(t *testing.T) {
+		// (country == US OR plan == enterprise) AND NOT email =~ @example.com$
+		g := ConstraintGroup{Operator: ConstraintGroupAND, Children: []ConstraintGroup{
+			{Operator: ConstraintGroupOR, Children: []ConstraintGroup{{ConstraintID: 1}, {ConstraintID: 2}}},
+			{Operator: ConstraintGroupNOT, Children: []ConstraintGroup{{ConstraintID: 3}}},
+		}}
+		expr, desc, err := g.ToExpr(cs)
+		assert.NoError(t, err)
+		assert.Equal(t, `((({country} == "US") OR ({plan} == "enterprise")) AND (NOT ({email} =~ "@example.com$")))`, desc)
+
+		match, errs := EvaluateConditions(expr, nil, map[string]interface{}{"country": "FR", "plan": "enterprise", "email": "a@b.com"})
+		assert.True(t, match)
+		assert.Empty(t, errs)
+
+		match, _ = EvaluateConditions(expr, nil, map[string]interface{}{"country": "US", "plan": "free", "email": "a@example.com"})
+		assert.False(t, match)
+
+		// a missing property fails its own comparison instead of the whole expr
+		match, errs = EvaluateConditions(expr, nil, map[string]interface{}{"country": "US", "email": "a@b.com"})
+		assert.True(t, match)
+		assert.Len(t, errs, 1)
+	})
+
+	t.Run("constraints not in the group are joined by AND", func(t *testing.T) {
+		g := ConstraintGroup{Operator: ConstraintGroupOR, Children: []ConstraintGroup{{ConstraintID: 1}, {ConstraintID: 2}}}
+		expr, desc, err := g.ToExpr(cs)
+		assert.NoError(t, err)
+		assert.Equal(t, `({email} =~ "@example.com$") AND (({country} == "US") OR ({plan} == "enterprise"))`, desc)
+
+		match, _ := EvaluateConditions(expr, nil, map[string]interface{}{"country": "US", "email": "a@b.com"})
+		assert.False(t, match)
+	})
+
+	t.Run("NOT of a missing property doesn't match", func(t *testing.T) {
+		// NOT country == US
+		g := ConstraintGroup{Operator: ConstraintGroupNOT, Children: []ConstraintGroup{{ConstraintID: 1}}}
+		expr, _, err := g.ToExpr(ConstraintArray{cs[0]})
+		assert.NoError(t, err)
+
+		match, _ := EvaluateConditions(expr, nil, map[string]interface{}{"country": "FR"})
+		assert.True(t, match)
+		match, errs := EvaluateConditions(expr, nil, map[string]interface{}{})
+		assert.False(t, match)
+		assert.Len(t, errs, 1)
+
+		// the other operand can still decide OR and AND
+		g = ConstraintGroup{Operator: ConstraintGroupOR, Children: []ConstraintGroup{
+			{Operator: ConstraintGroupNOT, Children: []ConstraintGroup{{ConstraintID: 1}}},
+			{ConstraintID: 2},
+		}}
+		expr, _, err = g.ToExpr(ConstraintArray{cs[0], cs[1]})
+		assert.NoError(t, err)
+		match, _ = EvaluateConditions(expr, nil, map[string]interface{}{"plan": "enterprise"})
+		assert.True(t, match)
+		match, _ = EvaluateConditions(expr, nil, map[string]interface{}{"plan": "free"})
+		assert.False(t, match)
+
+		// NOT (country == US AND plan == enterprise) is decided by the plan alone
+		g = ConstraintGroup{Operator: ConstraintGroupNOT, Children: []ConstraintGroup{
+			{Operator: ConstraintGroupAND, Children: []ConstraintGroup{{ConstraintID: 1}, {ConstraintID: 2}}},
+		}}
+		expr, _, err = g.ToExpr(ConstraintArray{cs[0], cs[1]})
+		assert.NoError(t, err)
+		match, _ = EvaluateConditions(expr, nil, map[string]interface{}{"plan": "free"})
+		assert.True(t, match)
+		match, _ = EvaluateConditions(expr, nil, map[string]interface{}{"plan": "enterprise"})
+		assert.False(t, match)
+	})
+
+	t.Run("invalid group", func(t *testing.T) {
+		_, _, err := ConstraintGroup{ConstraintID: 4}.ToExpr(cs)
+		assert.Error(t, err)
+	})
+}
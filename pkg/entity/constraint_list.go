@@ -0,0 +1,41 @@
+package entity
+
+import (
+	"fmt"
+
+	"github.com/checkr/flagr/pkg/util"
+	"github.com/checkr/flagr/swagger_gen/models"
+	"github.com/spf13/cast"
+)
+
+// IDListKey returns the key of the ID list the constraint references, if its operator is
+// IN_LIST or NOT_IN_LIST
+func (c *Constraint) IDListKey() (string, bool) {
+	if c.Operator != models.ConstraintOperatorINLIST && c.Operator != models.ConstraintOperatorNOTINLIST {
+		return "", false
+	}
+	return unquoteValue(c.Value), true
+}
+
+// idListMatcher matches the property as a string against the ID list whose key is the value,
+// or the negation of it if not is set. The ID list is looked up at every evaluation, so the
+// uploads of IDs take effect without changing the constraint
+func idListMatcher(not bool) func(value string) (valueMatcher, error) {
+	return func(value string) (valueMatcher, error) {
+		if ok, reason := util.IsSafeKey(value); !ok {
+			return nil, fmt.Errorf("invalid ID list key. reason: %s", reason)
+		}
+		return func(v interface{}) (bool, error) {
+			set, ok := IDListLookup(value)
+			if !ok {
+				return false, fmt.Errorf("ID list %s not found", value)
+			}
+			s, err := cast.ToStringE(v)
+			if err != nil {
+				return false, fmt.Errorf("invalid string %v", v)
+			}
+			_, in := set[s]
+			return in != not, nil
+		}, nil
+	}
+}
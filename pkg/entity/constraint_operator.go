@@ -0,0 +1,133 @@
+package entity
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strconv"
+	"strings"
+
+	"github.com/checkr/flagr/swagger_gen/models"
+	"github.com/spf13/cast"
+)
+
+// ConstraintMatcher matches the entityContext against a constraint whose operator the
+// conditions package doesn't support, whose expr is built from a typed value, or whose property
+// is derived
+type ConstraintMatcher func(m map[string]interface{}) (bool, error)
+
+// valueMatcher matches the value of the property in the entityContext
+type valueMatcher func(v interface{}) (bool, error)
+
+// customOperator is an operator the conditions package doesn't support, which compiles the value
+// of the constraint into a valueMatcher. The label describes it in the debug logs
+type customOperator struct {
+	label   string
+	compile func(value string) (valueMatcher, error)
+}
+
+var customOperators = map[string]customOperator{
+	models.ConstraintOperatorSEMVEREQ:      {"SEMVER ==", semverMatcher(func(c int) bool { return c == 0 })},
+	models.ConstraintOperatorSEMVERLT:      {"SEMVER <", semverMatcher(func(c int) bool { return c < 0 })},
+	models.ConstraintOperatorSEMVERLTE:     {"SEMVER <=", semverMatcher(func(c int) bool { return c <= 0 })},
+	models.ConstraintOperatorSEMVERGT:      {"SEMVER >", semverMatcher(func(c int) bool { return c > 0 })},
+	models.ConstraintOperatorSEMVERGTE:     {"SEMVER >=", semverMatcher(func(c int) bool { return c >= 0 })},
+	models.ConstraintOperatorBEFORE:        {"BEFORE", timeMatcher(func(c int) bool { return c < 0 })},
+	models.ConstraintOperatorAFTER:         {"AFTER", timeMatcher(func(c int) bool { return c > 0 })},
+	models.ConstraintOperatorBETWEEN:       {"BETWEEN", betweenTimeMatcher},
+	models.ConstraintOperatorSTARTSWITH:    {"STARTS WITH", stringMatcher(strings.HasPrefix, false)},
+	models.ConstraintOperatorNOTSTARTSWITH: {"NOT STARTS WITH", stringMatcher(strings.HasPrefix, true)},
+	models.ConstraintOperatorENDSWITH:      {"ENDS WITH", stringMatcher(strings.HasSuffix, false)},
+	models.ConstraintOperatorNOTENDSWITH:   {"NOT ENDS WITH", stringMatcher(strings.HasSuffix, true)},
+	models.ConstraintOperatorEQCI:          {"EQUALS IGNORING CASE", stringMatcher(strings.EqualFold, false)},
+	models.ConstraintOperatorNEQCI:         {"NOT EQUALS IGNORING CASE", stringMatcher(strings.EqualFold, true)},
+	models.ConstraintOperatorINCI:          {"IN IGNORING CASE", inIgnoringCaseMatcher(false)},
+	models.ConstraintOperatorNOTINCI:       {"NOT IN IGNORING CASE", inIgnoringCaseMatcher(true)},
+	models.ConstraintOperatorINCIDR:        {"IN CIDR", cidrMatcher(false)},
+	models.ConstraintOperatorNOTINCIDR:     {"NOT IN CIDR", cidrMatcher(true)},
+	models.ConstraintOperatorINLIST:        {"IN LIST", idListMatcher(false)},
+	models.ConstraintOperatorNOTINLIST:     {"NOT IN LIST", idListMatcher(true)},
+}
+
+func isCustomOperator(operator string) bool {
+	_, ok := customOperators[operator]
+	return ok
+}
+
+// matcherVar is the variable standing for the constraint with a matcher in the expr
+func (c *Constraint) matcherVar() string {
+	h := fnv.New64a()
+	h.Write([]byte(c.Property + "\x00" + c.Operator + "\x00" + c.ValueType + "\x00" + c.Value))
+	return fmt.Sprintf("__constraint_%x", h.Sum64())
+}
+
+func (c *Constraint) matcher() (ConstraintMatcher, error) {
+	if c.isTyped() {
+		return c.typedMatcher()
+	}
+	o, ok := customOperators[c.Operator]
+	if !ok && isDerivedProperty(c.Property) {
+		return c.derivedMatcher()
+	}
+	if !ok {
+		return nil, fmt.Errorf("not supported operator: %s", c.Operator)
+	}
+	match, err := o.compile(unquoteValue(c.Value))
+	if err != nil {
+		return nil, fmt.Errorf("invalid value %s for operator %s. %s", c.Value, c.Operator, err)
+	}
+
+	property := c.Property
+	return func(m map[string]interface{}) (bool, error) {
+		v, ok := m[property]
+		if !ok && property == NowProperty {
+			v, ok = timeNow(), true
+		}
+		if !ok {
+			return false, fmt.Errorf("argument: %v not found", property)
+		}
+		return match(v)
+	}, nil
+}
+
+// matchers compiles the constraints with custom operators, value types or derived properties,
+// keyed by their matcherVar
+func (cs ConstraintArray) matchers() (map[string]ConstraintMatcher, error) {
+	ret := make(map[string]ConstraintMatcher)
+	for _, c := range cs {
+		if !c.usesMatcher() {
+			continue
+		}
+		m, err := c.matcher()
+		if err != nil {
+			return nil, err
+		}
+		ret[c.matcherVar()] = m
+	}
+	return ret, nil
+}
+
+// unquoteValue strips the quotes around the value, the values of the other operators are
+// quoted for the conditions package, so the quotes are tolerated but not required
+func unquoteValue(value string) string {
+	value = strings.TrimSpace(value)
+	if s, err := strconv.Unquote(value); err == nil {
+		return s
+	}
+	return value
+}
+
+func semverMatcher(cmp func(c int) bool) func(value string) (valueMatcher, error) {
+	return func(value string) (valueMatcher, error) {
+		target, err := parseSemver(value)
+		if err != nil {
+			return nil, err
+		}
+		return func(v interface{}) (bool, error) {
+			sv, err := parseSemver(cast.ToString(v))
+			if err != nil {
+				return false, err
+			}
+			return cmp(sv.compare(target)), nil
+		}, nil
+	}
+}
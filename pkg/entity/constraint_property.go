@@ -0,0 +1,47 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/zhouzhuojie/conditions"
+)
+
+// DerivedPropertyPrefix prefixes the properties that Flagr derives into the entityContext
+// before the evaluation, e.g. $geo.country
+const DerivedPropertyPrefix = "$"
+
+// isDerivedProperty tells if the property is derived by Flagr. The conditions package can't
+// parse such a property in the expr, so the constraint is evaluated by a matcher
+func isDerivedProperty(property string) bool {
+	return strings.HasPrefix(property, DerivedPropertyPrefix)
+}
+
+// usesMatcher tells if the constraint stands for a matcher in the expr
+func (c *Constraint) usesMatcher() bool {
+	return isCustomOperator(c.Operator) || c.isTyped() || isDerivedProperty(c.Property)
+}
+
+// derivedMatcher evaluates the constraint whose value is a literal of the expr on a derived
+// property, by parsing the expr on a placeholder variable bound to the value of the property
+func (c *Constraint) derivedMatcher() (ConstraintMatcher, error) {
+	o, ok := OperatorToExprMap[c.Operator]
+	if !ok {
+		return nil, fmt.Errorf("not supported operator: %s", c.Operator)
+	}
+	placeholder := c.matcherVar()
+	p := conditions.NewParser(strings.NewReader(fmt.Sprintf("({%s} %s %s)", placeholder, o, c.Value)))
+	expr, err := p.Parse()
+	if err != nil {
+		return nil, fmt.Errorf("%s. Note: if it's string or array of string, wrap it with quotes \"...\"", err)
+	}
+
+	property := c.Property
+	return func(m map[string]interface{}) (bool, error) {
+		v, ok := m[property]
+		if !ok {
+			return false, fmt.Errorf("argument: %v not found", property)
+		}
+		return conditions.Evaluate(expr, map[string]interface{}{placeholder: v})
+	}, nil
+}
@@ -0,0 +1,46 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/checkr/flagr/swagger_gen/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConstraintDerivedProperties(t *testing.T) {
+	cs := ConstraintArray{
+		{Property: "$geo.country", Operator: models.ConstraintOperatorIN, Value: `["US", "CA"]`},
+		{Property: "$geo.region", Operator: models.ConstraintOperatorEQ, Value: `WA`, ValueType: models.ConstraintValueTypeSTRING},
+		{Property: "$geo.city", Operator: models.ConstraintOperatorSTARTSWITH, Value: `Mil`},
+		{Property: "dl_state", Operator: models.ConstraintOperatorEQ, Value: `"CA"`},
+	}
+	expr, desc, err := ConstraintGroup{}.ToExpr(cs)
+	assert.NoError(t, err)
+	assert.Contains(t, desc, `({$geo.country} IN ["US", "CA"])`)
+	assert.Contains(t, desc, `({$geo.region} == "WA")`)
+	matchers, err := cs.matchers()
+	assert.NoError(t, err)
+	assert.Len(t, matchers, 3)
+
+	m := map[string]interface{}{"$geo.country": "US", "$geo.region": "WA", "$geo.city": "Milton", "dl_state": "CA"}
+	match, errs := EvaluateConditions(expr, matchers, m)
+	assert.True(t, match)
+	assert.Empty(t, errs)
+
+	m["$geo.country"] = "GB"
+	match, _ = EvaluateConditions(expr, matchers, m)
+	assert.False(t, match)
+
+	delete(m, "$geo.country")
+	match, errs = EvaluateConditions(expr, matchers, m)
+	assert.False(t, match)
+	assert.NotEmpty(t, errs)
+
+	c := Constraint{Property: "$geo.country", Operator: models.ConstraintOperatorEQ, Value: `US`}
+	assert.Error(t, c.Validate())
+
+	valueType, value, ok := inferValueType(Constraint{Property: "$geo.country", Operator: models.ConstraintOperatorEQ, Value: `"US"`})
+	assert.True(t, ok)
+	assert.Equal(t, models.ConstraintValueTypeSTRING, valueType)
+	assert.Equal(t, "US", value)
+}
@@ -0,0 +1,77 @@
+package entity
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cast"
+)
+
+// stringMatcher matches the property as a string against the value of the constraint with
+// match, or with the negation of match if not is set
+func stringMatcher(match func(s string, value string) bool, not bool) func(value string) (valueMatcher, error) {
+	return func(value string) (valueMatcher, error) {
+		if value == "" {
+			return nil, fmt.Errorf("empty string")
+		}
+		return func(v interface{}) (bool, error) {
+			s, err := cast.ToStringE(v)
+			if err != nil {
+				return false, fmt.Errorf("invalid string %v", v)
+			}
+			return match(s, value) != not, nil
+		}, nil
+	}
+}
+
+// inIgnoringCaseMatcher matches the property as a string against the list of strings, ignoring
+// case, or the negation of it if not is set
+func inIgnoringCaseMatcher(not bool) func(value string) (valueMatcher, error) {
+	return func(value string) (valueMatcher, error) {
+		list, err := parseStringList(value)
+		if err != nil {
+			return nil, err
+		}
+		set := make(map[string]bool, len(list))
+		for _, s := range list {
+			set[strings.ToLower(s)] = true
+		}
+		return func(v interface{}) (bool, error) {
+			s, err := cast.ToStringE(v)
+			if err != nil {
+				return false, fmt.Errorf("invalid string %v", v)
+			}
+			return set[strings.ToLower(s)] != not, nil
+		}, nil
+	}
+}
+
+// parseStringList parses an array like ["CA", "NY"], or a comma-separated list like CA, NY
+func parseStringList(value string) ([]string, error) {
+	list := []string{}
+	if strings.HasPrefix(value, "[") {
+		items := []interface{}{}
+		if err := json.Unmarshal([]byte(value), &items); err != nil {
+			return nil, fmt.Errorf("invalid array %s. %s", value, err)
+		}
+		for _, item := range items {
+			s, err := cast.ToStringE(item)
+			if err != nil {
+				return nil, fmt.Errorf("invalid string %v in the array", item)
+			}
+			list = append(list, s)
+		}
+	} else {
+		for _, item := range strings.Split(value, ",") {
+			list = append(list, unquoteValue(item))
+		}
+	}
+
+	for _, s := range list {
+		if s == "" {
+			return nil, fmt.Errorf("empty string in the list %s", value)
+		}
+	}
+	return list, nil
+}
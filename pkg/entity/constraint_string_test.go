@@ -0,0 +1,70 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/checkr/flagr/swagger_gen/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConstraintStringOperators(t *testing.T) {
+	match := func(operator string, value string, v interface{}) (bool, []error) {
+		cs := ConstraintArray{{Property: "email", Operator: operator, Value: value}}
+		expr, _, err := ConstraintGroup{}.ToExpr(cs)
+		assert.NoError(t, err)
+		matchers, err := cs.matchers()
+		assert.NoError(t, err)
+		return EvaluateConditions(expr, matchers, map[string]interface{}{"email": v})
+	}
+
+	for _, tc := range []struct {
+		operator string
+		value    string
+		v        interface{}
+		expected bool
+	}{
+		{models.ConstraintOperatorENDSWITH, `"@ourcompany.com"`, "jane@ourcompany.com", true},
+		{models.ConstraintOperatorENDSWITH, `@ourcompany.com`, "jane@ourcompany.com", true},
+		{models.ConstraintOperatorENDSWITH, `@ourcompany.com`, "jane@ourcompany.co", false},
+		{models.ConstraintOperatorNOTENDSWITH, `@ourcompany.com`, "jane@example.com", true},
+		{models.ConstraintOperatorSTARTSWITH, `"admin+"`, "admin+jane@example.com", true},
+		{models.ConstraintOperatorNOTSTARTSWITH, `admin+`, "admin+jane@example.com", false},
+		{models.ConstraintOperatorEQCI, `"Jane@Example.com"`, "jane@example.COM", true},
+		{models.ConstraintOperatorNEQCI, `Jane@Example.com`, "jane@example.COM", false},
+		{models.ConstraintOperatorINCI, `["A@example.com", "B@example.com"]`, "b@EXAMPLE.com", true},
+		{models.ConstraintOperatorINCI, `A@example.com, "B@example.com"`, "b@EXAMPLE.com", true},
+		{models.ConstraintOperatorNOTINCI, `A@example.com, B@example.com`, "c@example.com", true},
+		{models.ConstraintOperatorEQCI, `123`, 123, true},
+	} {
+		m, errs := match(tc.operator, tc.value, tc.v)
+		assert.Equal(t, tc.expected, m, "%v %s %s", tc.v, tc.operator, tc.value)
+		assert.Empty(t, errs)
+	}
+
+	m, errs := match(models.ConstraintOperatorENDSWITH, `@ourcompany.com`, map[string]interface{}{})
+	assert.False(t, m)
+	assert.Len(t, errs, 1)
+}
+
+func TestConstraintStringOperatorsValidate(t *testing.T) {
+	for _, c := range []Constraint{
+		{Property: "email", Operator: models.ConstraintOperatorSTARTSWITH, Value: `""`},
+		{Property: "email", Operator: models.ConstraintOperatorINCI, Value: `["a", ""]`},
+		{Property: "email", Operator: models.ConstraintOperatorINCI, Value: `["a", `},
+		{Property: "email", Operator: models.ConstraintOperatorNOTINCI, Value: `a,,b`},
+	} {
+		assert.Error(t, c.Validate(), c.Value)
+	}
+}
+
+func TestConstraintDescribe(t *testing.T) {
+	c := Constraint{Property: "email", Operator: models.ConstraintOperatorENDSWITH, Value: `"@ourcompany.com"`}
+	d, err := c.describe()
+	assert.NoError(t, err)
+	assert.Equal(t, `({email} ENDS WITH "@ourcompany.com")`, d)
+
+	c = Constraint{Property: "email", Operator: models.ConstraintOperatorEQ, Value: `"jane@ourcompany.com"`}
+	d, err = c.describe()
+	assert.NoError(t, err)
+	assert.Equal(t, `({email} == "jane@ourcompany.com")`, d)
+}
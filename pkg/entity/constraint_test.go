@@ -103,6 +103,52 @@ func TestConstraintValidate(t *testing.T) {
 		}
 		assert.NoError(t, c.Validate())
 	})
+
+	t.Run("invalid semantic version", func(t *testing.T) {
+		c := Constraint{
+			Property: "app_version",
+			Operator: models.ConstraintOperatorSEMVERLT,
+			Value:    `"2.x"`,
+		}
+		assert.Error(t, c.Validate())
+	})
+}
+
+func TestConstraintSemverOperators(t *testing.T) {
+	match := func(operator string, value string, version interface{}) (bool, []error) {
+		cs := ConstraintArray{{Property: "app_version", Operator: operator, Value: value}}
+		expr, desc, err := ConstraintGroup{}.ToExpr(cs)
+		assert.NoError(t, err)
+		assert.Equal(t, "({app_version} "+customOperators[operator].label+" "+value+")", desc)
+		matchers, err := cs.matchers()
+		assert.NoError(t, err)
+		return EvaluateConditions(expr, matchers, map[string]interface{}{"app_version": version})
+	}
+
+	for _, tc := range []struct {
+		operator string
+		value    string
+		version  interface{}
+		expected bool
+	}{
+		{models.ConstraintOperatorSEMVERLT, `"2.10.0"`, "2.9.1", true},
+		{models.ConstraintOperatorSEMVERLT, `"2.10.0"`, "2.10.0", false},
+		{models.ConstraintOperatorSEMVERLT, `"2.10.0"`, "2.10.0-beta.1", true},
+		{models.ConstraintOperatorSEMVERLTE, `"2.10.0"`, "2.10.0", true},
+		{models.ConstraintOperatorSEMVERGT, `2.10.0`, "2.10.1", true},
+		{models.ConstraintOperatorSEMVERGT, `"2.10.0-beta.2"`, "2.10.0-beta.11", true},
+		{models.ConstraintOperatorSEMVERGTE, `"2.10.0"`, "2.9.99", false},
+		{models.ConstraintOperatorSEMVEREQ, `"2.10.0"`, "v2.10", true},
+		{models.ConstraintOperatorSEMVEREQ, `"2"`, 2, true},
+	} {
+		m, errs := match(tc.operator, tc.value, tc.version)
+		assert.Equal(t, tc.expected, m, "%s %s %v", tc.version, tc.operator, tc.value)
+		assert.Empty(t, errs)
+	}
+
+	m, errs := match(models.ConstraintOperatorSEMVERGT, `"2.10.0"`, "latest")
+	assert.False(t, m)
+	assert.Len(t, errs, 1)
 }
 
 func TestConstraintArray(t *testing.T) {
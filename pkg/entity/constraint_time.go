@@ -0,0 +1,151 @@
+package entity
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cast"
+)
+
+// NowProperty is the property standing for the time of the evaluation in the constraints with
+// time operators, unless the entityContext has it
+const NowProperty = "now"
+
+var timeNow = time.Now
+
+var (
+	timeLayouts      = []string{time.RFC3339Nano, "2006-01-02"}
+	timeOfDayLayouts = []string{"15:04", "15:04:05", "15:04Z07:00", "15:04:05Z07:00"}
+)
+
+// timeBound is a bound of the time operators, either now, an instant or a time of day
+type timeBound struct {
+	now       bool
+	timeOfDay bool
+	t         time.Time
+}
+
+func parseTimeBound(v interface{}) (*timeBound, error) {
+	if s, ok := v.(string); ok {
+		s = strings.TrimSpace(s)
+		if strings.EqualFold(s, NowProperty) {
+			return &timeBound{now: true}, nil
+		}
+		for _, layout := range timeOfDayLayouts {
+			if t, err := time.Parse(layout, s); err == nil {
+				return &timeBound{timeOfDay: true, t: t}, nil
+			}
+		}
+	}
+	t, err := parseTime(v)
+	if err != nil {
+		return nil, err
+	}
+	return &timeBound{t: t}, nil
+}
+
+// parseTime parses RFC3339 times, dates and unix timestamps in seconds
+func parseTime(v interface{}) (time.Time, error) {
+	switch t := v.(type) {
+	case time.Time:
+		return t, nil
+	case string:
+		s := strings.TrimSpace(t)
+		for _, layout := range timeLayouts {
+			if ret, err := time.Parse(layout, s); err == nil {
+				return ret, nil
+			}
+		}
+		return unixTime(s)
+	case float64, float32, int, int32, int64, uint, uint32, uint64, json.Number:
+		return unixTime(v)
+	}
+	return time.Time{}, fmt.Errorf("invalid time %v, expected an RFC3339 time, a date or a unix timestamp", v)
+}
+
+func unixTime(v interface{}) (time.Time, error) {
+	secs, err := cast.ToFloat64E(v)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time %v, expected an RFC3339 time, a date or a unix timestamp", v)
+	}
+	return time.Unix(0, int64(secs*float64(time.Second))).UTC(), nil
+}
+
+// compare returns -1, 0 or 1 if t precedes, equals or follows the bound. A time of day is
+// compared in the time zone of the bound
+func (b *timeBound) compare(t time.Time) int {
+	switch {
+	case b.now:
+		return compareTime(t, timeNow())
+	case b.timeOfDay:
+		return compareUint(uint64(secondOfDay(t.In(b.t.Location()))), uint64(secondOfDay(b.t)))
+	}
+	return compareTime(t, b.t)
+}
+
+func secondOfDay(t time.Time) int {
+	return t.Hour()*3600 + t.Minute()*60 + t.Second()
+}
+
+func compareTime(a, b time.Time) int {
+	switch {
+	case a.Before(b):
+		return -1
+	case a.After(b):
+		return 1
+	}
+	return 0
+}
+
+func timeMatcher(cmp func(c int) bool) func(value string) (valueMatcher, error) {
+	return func(value string) (valueMatcher, error) {
+		b, err := parseTimeBound(value)
+		if err != nil {
+			return nil, err
+		}
+		return func(v interface{}) (bool, error) {
+			t, err := parseTime(v)
+			if err != nil {
+				return false, err
+			}
+			return cmp(b.compare(t)), nil
+		}, nil
+	}
+}
+
+// betweenTimeMatcher matches the times from the start included to the end excluded. The
+// window of the times of day wraps around midnight if the end precedes the start
+func betweenTimeMatcher(value string) (valueMatcher, error) {
+	bounds := []interface{}{}
+	if err := json.Unmarshal([]byte(value), &bounds); err != nil || len(bounds) != 2 {
+		return nil, fmt.Errorf("expected an array of the start and the end, e.g. [\"09:00\", \"17:00\"]")
+	}
+	start, err := parseTimeBound(bounds[0])
+	if err != nil {
+		return nil, err
+	}
+	end, err := parseTimeBound(bounds[1])
+	if err != nil {
+		return nil, err
+	}
+	if start.timeOfDay != end.timeOfDay {
+		return nil, fmt.Errorf("the start and the end should be both times of day or neither")
+	}
+	if !start.timeOfDay && !start.now && !end.now && !start.t.Before(end.t) {
+		return nil, fmt.Errorf("the start should precede the end")
+	}
+
+	wraps := start.timeOfDay && end.compare(start.t) >= 0
+	return func(v interface{}) (bool, error) {
+		t, err := parseTime(v)
+		if err != nil {
+			return false, err
+		}
+		if wraps {
+			return start.compare(t) >= 0 || end.compare(t) < 0, nil
+		}
+		return start.compare(t) >= 0 && end.compare(t) < 0, nil
+	}, nil
+}
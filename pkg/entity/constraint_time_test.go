@@ -0,0 +1,86 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/checkr/flagr/swagger_gen/models"
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTime(t *testing.T) {
+	expected := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, v := range []interface{}{"2024-01-01", "2024-01-01T00:00:00Z", "2024-01-01T01:00:00+01:00", "1704067200", float64(1704067200), 1704067200} {
+		ret, err := parseTime(v)
+		assert.NoError(t, err, "%v", v)
+		assert.True(t, expected.Equal(ret), "%v", v)
+	}
+	for _, v := range []interface{}{"", "yesterday", "2024-13-01", true} {
+		_, err := parseTime(v)
+		assert.Error(t, err, "%v", v)
+	}
+}
+
+func TestConstraintTimeOperators(t *testing.T) {
+	defer gostub.StubFunc(&timeNow, time.Date(2024, 6, 1, 18, 30, 0, 0, time.UTC)).Reset()
+
+	match := func(property string, operator string, value string, m map[string]interface{}) (bool, []error) {
+		cs := ConstraintArray{{Property: property, Operator: operator, Value: value}}
+		expr, _, err := ConstraintGroup{}.ToExpr(cs)
+		assert.NoError(t, err)
+		matchers, err := cs.matchers()
+		assert.NoError(t, err)
+		return EvaluateConditions(expr, matchers, m)
+	}
+
+	for _, tc := range []struct {
+		property string
+		operator string
+		value    string
+		m        map[string]interface{}
+		expected bool
+	}{
+		{"signup_date", models.ConstraintOperatorAFTER, `"2024-01-01"`, map[string]interface{}{"signup_date": "2024-03-01T10:00:00Z"}, true},
+		{"signup_date", models.ConstraintOperatorAFTER, `2024-01-01`, map[string]interface{}{"signup_date": float64(1672531200)}, false},
+		{"signup_date", models.ConstraintOperatorBEFORE, `1704067200`, map[string]interface{}{"signup_date": "2023-12-31"}, true},
+		{"expires_at", models.ConstraintOperatorAFTER, `now`, map[string]interface{}{"expires_at": "2024-07-01"}, true},
+		{"expires_at", models.ConstraintOperatorBEFORE, `"now"`, map[string]interface{}{"expires_at": "2024-07-01"}, false},
+		{"now", models.ConstraintOperatorBETWEEN, `["2024-05-01", "2024-07-01"]`, map[string]interface{}{}, true},
+		{"now", models.ConstraintOperatorBETWEEN, `["2024-06-01T18:30:00Z", 1717267800]`, map[string]interface{}{}, true},
+		{"now", models.ConstraintOperatorBETWEEN, `["2024-05-01", "2024-06-01T18:30:00Z"]`, map[string]interface{}{}, false},
+		{"now", models.ConstraintOperatorBETWEEN, `["09:00", "17:00"]`, map[string]interface{}{}, false},
+		{"now", models.ConstraintOperatorBETWEEN, `["09:00", "17:00"]`, map[string]interface{}{"now": "2024-06-01T12:00:00Z"}, true},
+		{"now", models.ConstraintOperatorBETWEEN, `["18:00", "02:00"]`, map[string]interface{}{}, true},
+		{"now", models.ConstraintOperatorBETWEEN, `["09:00+02:00", "17:00+02:00"]`, map[string]interface{}{}, false},
+		{"now", models.ConstraintOperatorBETWEEN, `["13:00-05:00", "17:00-05:00"]`, map[string]interface{}{}, true},
+		{"now", models.ConstraintOperatorAFTER, `"18:00"`, map[string]interface{}{}, true},
+	} {
+		m, errs := match(tc.property, tc.operator, tc.value, tc.m)
+		assert.Equal(t, tc.expected, m, "%s %s %s", tc.property, tc.operator, tc.value)
+		assert.Empty(t, errs)
+	}
+
+	m, errs := match("signup_date", models.ConstraintOperatorAFTER, `"2024-01-01"`, map[string]interface{}{})
+	assert.False(t, m)
+	assert.Len(t, errs, 1)
+
+	m, errs = match("signup_date", models.ConstraintOperatorAFTER, `"2024-01-01"`, map[string]interface{}{"signup_date": "soon"})
+	assert.False(t, m)
+	assert.Len(t, errs, 1)
+}
+
+func TestConstraintTimeOperatorsValidate(t *testing.T) {
+	for _, c := range []Constraint{
+		{Property: "signup_date", Operator: models.ConstraintOperatorAFTER, Value: `"next week"`},
+		{Property: "now", Operator: models.ConstraintOperatorBETWEEN, Value: `"2024-01-01"`},
+		{Property: "now", Operator: models.ConstraintOperatorBETWEEN, Value: `["2024-01-01"]`},
+		{Property: "now", Operator: models.ConstraintOperatorBETWEEN, Value: `["2024-02-01", "2024-01-01"]`},
+		{Property: "now", Operator: models.ConstraintOperatorBETWEEN, Value: `["09:00", "2024-01-01"]`},
+	} {
+		assert.Error(t, c.Validate(), c.Value)
+	}
+
+	c := Constraint{Property: "now", Operator: models.ConstraintOperatorBETWEEN, Value: `["2024-01-01", "now"]`}
+	assert.NoError(t, c.Validate())
+}
@@ -0,0 +1,224 @@
+package entity
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/checkr/flagr/swagger_gen/models"
+	"github.com/jinzhu/gorm"
+	"github.com/zhouzhuojie/conditions"
+)
+
+// operatorValueTypes maps the operators of the conditions package to the value types they support
+var operatorValueTypes = map[string][]string{
+	models.ConstraintOperatorEQ:          {models.ConstraintValueTypeSTRING, models.ConstraintValueTypeNUMBER, models.ConstraintValueTypeBOOL},
+	models.ConstraintOperatorNEQ:         {models.ConstraintValueTypeSTRING, models.ConstraintValueTypeNUMBER, models.ConstraintValueTypeBOOL},
+	models.ConstraintOperatorLT:          {models.ConstraintValueTypeNUMBER},
+	models.ConstraintOperatorLTE:         {models.ConstraintValueTypeNUMBER},
+	models.ConstraintOperatorGT:          {models.ConstraintValueTypeNUMBER},
+	models.ConstraintOperatorGTE:         {models.ConstraintValueTypeNUMBER},
+	models.ConstraintOperatorEREG:        {models.ConstraintValueTypeSTRING},
+	models.ConstraintOperatorNEREG:       {models.ConstraintValueTypeSTRING},
+	models.ConstraintOperatorIN:          {models.ConstraintValueTypeSTRINGLIST, models.ConstraintValueTypeNUMBERLIST},
+	models.ConstraintOperatorNOTIN:       {models.ConstraintValueTypeSTRINGLIST, models.ConstraintValueTypeNUMBERLIST},
+	models.ConstraintOperatorCONTAINS:    {models.ConstraintValueTypeSTRING, models.ConstraintValueTypeNUMBER},
+	models.ConstraintOperatorNOTCONTAINS: {models.ConstraintValueTypeSTRING, models.ConstraintValueTypeNUMBER},
+}
+
+var operatorTokens = map[string]conditions.Token{
+	models.ConstraintOperatorEQ:          conditions.EQ,
+	models.ConstraintOperatorNEQ:         conditions.NEQ,
+	models.ConstraintOperatorLT:          conditions.LT,
+	models.ConstraintOperatorLTE:         conditions.LTE,
+	models.ConstraintOperatorGT:          conditions.GT,
+	models.ConstraintOperatorGTE:         conditions.GTE,
+	models.ConstraintOperatorEREG:        conditions.EREG,
+	models.ConstraintOperatorNEREG:       conditions.NEREG,
+	models.ConstraintOperatorIN:          conditions.IN,
+	models.ConstraintOperatorNOTIN:       conditions.NOTIN,
+	models.ConstraintOperatorCONTAINS:    conditions.CONTAINS,
+	models.ConstraintOperatorNOTCONTAINS: conditions.NOTCONTAINS,
+}
+
+// isTyped tells if the expr of the constraint is built from its plain value and value type,
+// instead of taking the value as a literal of the expr. The custom operators take plain
+// values whatever the value type
+func (c *Constraint) isTyped() bool {
+	_, ok := OperatorToExprMap[c.Operator]
+	return ok && c.ValueType != ""
+}
+
+// typedValue parses the plain value into a string, float64, bool, []string or []float64
+func (c *Constraint) typedValue() (interface{}, error) {
+	supported := false
+	for _, t := range operatorValueTypes[c.Operator] {
+		supported = supported || t == c.ValueType
+	}
+	if !supported {
+		return nil, fmt.Errorf("value type %s is not supported by operator %s. expecting one of %v", c.ValueType, c.Operator, operatorValueTypes[c.Operator])
+	}
+
+	switch c.ValueType {
+	case models.ConstraintValueTypeSTRING:
+		if c.Operator == models.ConstraintOperatorEREG || c.Operator == models.ConstraintOperatorNEREG {
+			if _, err := regexp.Compile(c.Value); err != nil {
+				return nil, err
+			}
+		}
+		return c.Value, nil
+	case models.ConstraintValueTypeNUMBER:
+		return parseNumber(c.Value)
+	case models.ConstraintValueTypeBOOL:
+		b, err := strconv.ParseBool(strings.TrimSpace(c.Value))
+		if err != nil {
+			return nil, fmt.Errorf("invalid bool %s", c.Value)
+		}
+		return b, nil
+	case models.ConstraintValueTypeSTRINGLIST, models.ConstraintValueTypeNUMBERLIST:
+		list, err := parseStringList(c.Value)
+		if err != nil {
+			return nil, err
+		}
+		if len(list) == 0 {
+			return nil, fmt.Errorf("empty list %s", c.Value)
+		}
+		if c.ValueType == models.ConstraintValueTypeSTRINGLIST {
+			return list, nil
+		}
+		numbers := make([]float64, len(list))
+		for i, s := range list {
+			if numbers[i], err = parseNumber(s); err != nil {
+				return nil, err
+			}
+		}
+		return numbers, nil
+	}
+	return nil, fmt.Errorf("not supported value type: %s", c.ValueType)
+}
+
+func parseNumber(s string) (float64, error) {
+	f, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid number %s", s)
+	}
+	return f, nil
+}
+
+// typedMatcher builds the expr of the constraint from the typed value, so that the value is
+// never spliced into the expr string and needs no quotes
+func (c *Constraint) typedMatcher() (ConstraintMatcher, error) {
+	v, err := c.typedValue()
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s value %s for operator %s. %s", c.ValueType, c.Value, c.Operator, err)
+	}
+
+	var literal conditions.Expr
+	switch t := v.(type) {
+	case string:
+		literal = &conditions.StringLiteral{Val: t}
+	case float64:
+		literal = &conditions.NumberLiteral{Val: t}
+	case bool:
+		literal = &conditions.BooleanLiteral{Val: t}
+	case []string:
+		literal = &conditions.SliceStringLiteral{Val: t}
+	case []float64:
+		literal = &conditions.SliceNumberLiteral{Val: t}
+	}
+	expr := &conditions.BinaryExpr{
+		Op:  operatorTokens[c.Operator],
+		LHS: &conditions.VarRef{Val: c.Property},
+		RHS: literal,
+	}
+	return func(m map[string]interface{}) (bool, error) {
+		return conditions.Evaluate(expr, m)
+	}, nil
+}
+
+// describeTypedValue describes the typed value in JSON for the debug logs, e.g. "CA" or [1,2]
+func (c *Constraint) describeTypedValue() string {
+	v, err := c.typedValue()
+	if err != nil {
+		return c.Value
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return c.Value
+	}
+	return string(b)
+}
+
+// MigrateConstraintValueTypes types the constraints created before the value types, whose
+// values are literals of the expr, e.g. "CA" becomes CA with STRING. The values that can't be
+// typed, e.g. of the custom operators, are left as they are. It's safe to run many times
+func MigrateConstraintValueTypes(db *gorm.DB) error {
+	operators := make([]string, 0, len(OperatorToExprMap))
+	for o := range OperatorToExprMap {
+		operators = append(operators, o)
+	}
+	cs := []Constraint{}
+	if err := NewConstraintQuerySet(db).ValueTypeEq("").OperatorIn(operators...).All(&cs); err != nil {
+		return err
+	}
+
+	for i := range cs {
+		valueType, value, ok := inferValueType(cs[i])
+		if !ok {
+			continue
+		}
+		err := NewConstraintQuerySet(db).IDEq(cs[i].ID).GetUpdater().
+			SetValueType(valueType).
+			SetValue(value).
+			Update()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// inferValueType infers the value type and the plain value from the value that's a literal of
+// the expr, the typed constraint evaluates the same as the literal
+func inferValueType(c Constraint) (valueType string, value string, ok bool) {
+	property := c.Property
+	// parse the literal the way the legacy expr does, the derived properties evaluate it the same
+	c.Property, c.ValueType = "p", ""
+	expr, err := c.ToExpr()
+	if err != nil {
+		return "", "", false
+	}
+	p, ok := expr.(*conditions.ParenExpr)
+	if !ok {
+		return "", "", false
+	}
+	b, ok := p.Expr.(*conditions.BinaryExpr)
+	if !ok {
+		return "", "", false
+	}
+
+	switch l := b.RHS.(type) {
+	case *conditions.StringLiteral:
+		valueType, value = models.ConstraintValueTypeSTRING, l.Val
+	case *conditions.NumberLiteral:
+		valueType, value = models.ConstraintValueTypeNUMBER, strconv.FormatFloat(l.Val, 'f', -1, 64)
+	case *conditions.BooleanLiteral:
+		valueType, value = models.ConstraintValueTypeBOOL, strconv.FormatBool(l.Val)
+	case *conditions.SliceStringLiteral:
+		j, _ := json.Marshal(l.Val)
+		valueType, value = models.ConstraintValueTypeSTRINGLIST, string(j)
+	case *conditions.SliceNumberLiteral:
+		j, _ := json.Marshal(l.Val)
+		valueType, value = models.ConstraintValueTypeNUMBERLIST, string(j)
+	default:
+		return "", "", false
+	}
+
+	typed := Constraint{Property: property, Operator: c.Operator, Value: value, ValueType: valueType}
+	if typed.Validate() != nil {
+		return "", "", false
+	}
+	return valueType, value, true
+}
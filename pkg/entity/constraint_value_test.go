@@ -0,0 +1,119 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/checkr/flagr/swagger_gen/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConstraintValueTypes(t *testing.T) {
+	match := func(c Constraint, m map[string]interface{}) (bool, []error) {
+		cs := ConstraintArray{c}
+		expr, _, err := ConstraintGroup{}.ToExpr(cs)
+		assert.NoError(t, err)
+		matchers, err := cs.matchers()
+		assert.NoError(t, err)
+		return EvaluateConditions(expr, matchers, m)
+	}
+
+	for _, tc := range []struct {
+		c        Constraint
+		v        interface{}
+		expected bool
+	}{
+		{Constraint{Operator: models.ConstraintOperatorEQ, Value: `CA`, ValueType: models.ConstraintValueTypeSTRING}, "CA", true},
+		{Constraint{Operator: models.ConstraintOperatorEQ, Value: `say "hi"`, ValueType: models.ConstraintValueTypeSTRING}, `say "hi"`, true},
+		{Constraint{Operator: models.ConstraintOperatorNEQ, Value: `CA`, ValueType: models.ConstraintValueTypeSTRING}, "NY", true},
+		{Constraint{Operator: models.ConstraintOperatorEQ, Value: `42`, ValueType: models.ConstraintValueTypeSTRING}, "42", true},
+		{Constraint{Operator: models.ConstraintOperatorEQ, Value: `42`, ValueType: models.ConstraintValueTypeNUMBER}, float64(42), true},
+		{Constraint{Operator: models.ConstraintOperatorGTE, Value: ` 1.5 `, ValueType: models.ConstraintValueTypeNUMBER}, 2, true},
+		{Constraint{Operator: models.ConstraintOperatorEQ, Value: `true`, ValueType: models.ConstraintValueTypeBOOL}, true, true},
+		{Constraint{Operator: models.ConstraintOperatorEREG, Value: `^\d+@example\.com$`, ValueType: models.ConstraintValueTypeSTRING}, "12@example.com", true},
+		{Constraint{Operator: models.ConstraintOperatorIN, Value: `CA, "NY"`, ValueType: models.ConstraintValueTypeSTRINGLIST}, "NY", true},
+		{Constraint{Operator: models.ConstraintOperatorIN, Value: `["CA, NV", "NY"]`, ValueType: models.ConstraintValueTypeSTRINGLIST}, "CA, NV", true},
+		{Constraint{Operator: models.ConstraintOperatorNOTIN, Value: `1, 2`, ValueType: models.ConstraintValueTypeNUMBERLIST}, 3, true},
+		{Constraint{Operator: models.ConstraintOperatorCONTAINS, Value: `beta`, ValueType: models.ConstraintValueTypeSTRING}, []string{"alpha", "beta"}, true},
+		{Constraint{Operator: models.ConstraintOperatorSTARTSWITH, Value: `admin+`, ValueType: models.ConstraintValueTypeSTRING}, "admin+jane", true},
+	} {
+		tc.c.Property = "p"
+		m, errs := match(tc.c, map[string]interface{}{"p": tc.v})
+		assert.Equal(t, tc.expected, m, "%v %s %s", tc.v, tc.c.Operator, tc.c.Value)
+		assert.Empty(t, errs)
+	}
+
+	// the number is not a string any more
+	m, errs := match(Constraint{Property: "p", Operator: models.ConstraintOperatorEQ, Value: `42`, ValueType: models.ConstraintValueTypeNUMBER}, map[string]interface{}{"p": "42"})
+	assert.False(t, m)
+	assert.Len(t, errs, 1)
+}
+
+func TestConstraintValueTypesValidate(t *testing.T) {
+	for _, c := range []Constraint{
+		{Property: "p", Operator: models.ConstraintOperatorEQ, Value: `CA`, ValueType: "DATE"},
+		{Property: "p", Operator: models.ConstraintOperatorEQ, Value: `CA`, ValueType: models.ConstraintValueTypeNUMBER},
+		{Property: "p", Operator: models.ConstraintOperatorEQ, Value: `yes`, ValueType: models.ConstraintValueTypeBOOL},
+		{Property: "p", Operator: models.ConstraintOperatorGT, Value: `CA`, ValueType: models.ConstraintValueTypeSTRING},
+		{Property: "p", Operator: models.ConstraintOperatorIN, Value: `CA`, ValueType: models.ConstraintValueTypeSTRING},
+		{Property: "p", Operator: models.ConstraintOperatorIN, Value: `[]`, ValueType: models.ConstraintValueTypeSTRINGLIST},
+		{Property: "p", Operator: models.ConstraintOperatorIN, Value: `1, two`, ValueType: models.ConstraintValueTypeNUMBERLIST},
+		{Property: "p", Operator: models.ConstraintOperatorEREG, Value: `(`, ValueType: models.ConstraintValueTypeSTRING},
+	} {
+		assert.Error(t, c.Validate(), "%s %s %s", c.Operator, c.ValueType, c.Value)
+	}
+}
+
+func TestConstraintValueTypesDescribe(t *testing.T) {
+	c := Constraint{Property: "state", Operator: models.ConstraintOperatorIN, Value: `CA, NY`, ValueType: models.ConstraintValueTypeSTRINGLIST}
+	d, err := c.describe()
+	assert.NoError(t, err)
+	assert.Equal(t, `({state} IN ["CA","NY"])`, d)
+
+	c = Constraint{Property: "title", Operator: models.ConstraintOperatorEQ, Value: `say "hi"`, ValueType: models.ConstraintValueTypeSTRING}
+	d, err = c.describe()
+	assert.NoError(t, err)
+	assert.Equal(t, `({title} == "say \"hi\"")`, d)
+}
+
+func TestMigrateConstraintValueTypes(t *testing.T) {
+	db := NewTestDB()
+	defer db.Close()
+
+	cs := []Constraint{
+		{Property: "state", Operator: models.ConstraintOperatorEQ, Value: `"CA"`},
+		{Property: "age", Operator: models.ConstraintOperatorGT, Value: `21.0`},
+		{Property: "admin", Operator: models.ConstraintOperatorEQ, Value: `true`},
+		{Property: "state", Operator: models.ConstraintOperatorNOTIN, Value: `["CA", "NY"]`},
+		{Property: "tier", Operator: models.ConstraintOperatorIN, Value: `[1, 2]`},
+		{Property: "email", Operator: models.ConstraintOperatorEREG, Value: `"^.*@example\.com$"`},
+		{Property: "email", Operator: models.ConstraintOperatorENDSWITH, Value: `@example.com`},
+		{Property: "state", Operator: models.ConstraintOperatorIN, Value: `"CA"`},
+		{Property: "state", Operator: models.ConstraintOperatorEQ, Value: `CA`, ValueType: models.ConstraintValueTypeSTRING},
+	}
+	for i := range cs {
+		assert.NoError(t, cs[i].Create(db))
+	}
+
+	assert.NoError(t, MigrateConstraintValueTypes(db))
+	assert.NoError(t, MigrateConstraintValueTypes(db))
+
+	migrated := []Constraint{}
+	assert.NoError(t, NewConstraintQuerySet(db).OrderAscByID().All(&migrated))
+	for i, expected := range []struct {
+		valueType string
+		value     string
+	}{
+		{models.ConstraintValueTypeSTRING, `CA`},
+		{models.ConstraintValueTypeNUMBER, `21`},
+		{models.ConstraintValueTypeBOOL, `true`},
+		{models.ConstraintValueTypeSTRINGLIST, `["CA","NY"]`},
+		{models.ConstraintValueTypeNUMBERLIST, `[1,2]`},
+		{models.ConstraintValueTypeSTRING, `^.*@example\.com$`},
+		{"", `@example.com`},
+		{"", `"CA"`},
+		{models.ConstraintValueTypeSTRING, `CA`},
+	} {
+		assert.Equal(t, expected.valueType, migrated[i].ValueType, migrated[i].Value)
+		assert.Equal(t, expected.value, migrated[i].Value)
+	}
+}
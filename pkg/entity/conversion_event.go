@@ -0,0 +1,17 @@
+//go:generate goqueryset -in conversion_event.go
+
+package entity
+
+import (
+	"github.com/jinzhu/gorm"
+)
+
+// ConversionEvent is an outcome of an entity, e.g. checkout, attributed to a flag
+// gen:qs
+type ConversionEvent struct {
+	gorm.Model
+	FlagID   uint   `gorm:"index:idx_conversionevent_flagid_entityid"`
+	EntityID string `gorm:"type:varchar(255);index:idx_conversionevent_flagid_entityid"`
+	Metric   string `gorm:"type:varchar(255)"`
+	Value    float64
+}
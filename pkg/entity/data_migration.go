@@ -0,0 +1,85 @@
+//go:generate goqueryset -in data_migration.go
+
+package entity
+
+import (
+	"fmt"
+
+	"github.com/jinzhu/gorm"
+	"github.com/sirupsen/logrus"
+)
+
+// DataMigration records a data migration applied to the DB. Unlike AutoMigrate, that only adds
+// the tables and the columns, the data migrations rewrite the stored data, so they are applied
+// only when they are asked for, and once.
+// gen:qs
+type DataMigration struct {
+	gorm.Model
+	Version string `gorm:"type:varchar(64);unique_index:idx_datamigration_version"`
+}
+
+type dataMigration struct {
+	version string
+	migrate func(tx *gorm.DB) error
+}
+
+// dataMigrations are applied in order, a new one is appended with the next version
+var dataMigrations = []dataMigration{
+	{version: "1_constraint_value_types", migrate: MigrateConstraintValueTypes},
+}
+
+// RunDataMigrations applies the data migrations up to and including the version that are not
+// applied yet. Each of them runs in a transaction with its record, so it's applied entirely or
+// not at all. An empty version applies none, an unknown one is an error
+func RunDataMigrations(db *gorm.DB, version string) error {
+	if version == "" {
+		return nil
+	}
+
+	last := -1
+	for i, m := range dataMigrations {
+		if m.version == version {
+			last = i
+		}
+	}
+	if last < 0 {
+		return fmt.Errorf("unknown data migration version %s", version)
+	}
+
+	for _, m := range dataMigrations[:last+1] {
+		applied, err := isDataMigrationApplied(db, m.version)
+		if err != nil {
+			return err
+		}
+		if applied {
+			continue
+		}
+
+		tx := db.Begin()
+		err = m.migrate(tx)
+		if err == nil {
+			err = (&DataMigration{Version: m.version}).Create(tx)
+		}
+		if err == nil {
+			err = tx.Commit().Error
+		}
+		if err != nil {
+			tx.Rollback()
+			// another instance applied it in the meantime, the unique index rejects this one
+			if applied, aerr := isDataMigrationApplied(db, m.version); aerr == nil && applied {
+				continue
+			}
+			return fmt.Errorf("failed to apply the data migration %s. %s", m.version, err)
+		}
+		logrus.Infof("applied the data migration %s", m.version)
+	}
+	return nil
+}
+
+func isDataMigrationApplied(db *gorm.DB, version string) (bool, error) {
+	n, err := NewDataMigrationQuerySet(db).VersionEq(version).Count()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
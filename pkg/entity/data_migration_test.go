@@ -0,0 +1,70 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/checkr/flagr/swagger_gen/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunDataMigrations(t *testing.T) {
+	t.Run("empty version applies none", func(t *testing.T) {
+		db := NewTestDB()
+		defer db.Close()
+
+		c := Constraint{Property: "state", Operator: models.ConstraintOperatorEQ, Value: `"CA"`}
+		assert.NoError(t, c.Create(db))
+
+		assert.NoError(t, RunDataMigrations(db, ""))
+
+		n, err := NewDataMigrationQuerySet(db).Count()
+		assert.NoError(t, err)
+		assert.Zero(t, n)
+		assert.NoError(t, NewConstraintQuerySet(db).IDEq(c.ID).One(&c))
+		assert.Equal(t, "", c.ValueType)
+		assert.Equal(t, `"CA"`, c.Value)
+	})
+
+	t.Run("unknown version", func(t *testing.T) {
+		db := NewTestDB()
+		defer db.Close()
+
+		assert.Error(t, RunDataMigrations(db, "999_unknown"))
+	})
+
+	t.Run("applies the migration once", func(t *testing.T) {
+		db := NewTestDB()
+		defer db.Close()
+
+		c := Constraint{Property: "state", Operator: models.ConstraintOperatorEQ, Value: `"CA"`}
+		assert.NoError(t, c.Create(db))
+
+		assert.NoError(t, RunDataMigrations(db, "1_constraint_value_types"))
+		assert.NoError(t, NewConstraintQuerySet(db).IDEq(c.ID).One(&c))
+		assert.Equal(t, models.ConstraintValueTypeSTRING, c.ValueType)
+		assert.Equal(t, `CA`, c.Value)
+
+		// the constraints created after it are left to the API
+		legacy := Constraint{Property: "state", Operator: models.ConstraintOperatorEQ, Value: `"NY"`}
+		assert.NoError(t, legacy.Create(db))
+		assert.NoError(t, RunDataMigrations(db, "1_constraint_value_types"))
+		assert.NoError(t, NewConstraintQuerySet(db).IDEq(legacy.ID).One(&legacy))
+		assert.Equal(t, "", legacy.ValueType)
+
+		n, err := NewDataMigrationQuerySet(db).VersionEq("1_constraint_value_types").Count()
+		assert.NoError(t, err)
+		assert.Equal(t, 1, n)
+	})
+
+	t.Run("a failed migration is rolled back", func(t *testing.T) {
+		db := NewTestDB()
+		defer db.Close()
+
+		db.DropTable(&Constraint{})
+
+		assert.Error(t, RunDataMigrations(db, "1_constraint_value_types"))
+		n, err := NewDataMigrationQuerySet(db).Count()
+		assert.NoError(t, err)
+		assert.Zero(t, n)
+	})
+}
@@ -31,6 +31,9 @@ func GetDB() *gorm.DB {
 		}
 		db.SetLogger(logrus.StandardLogger())
 		db.Debug().AutoMigrate(AutoMigrateTables...)
+		if err := RunDataMigrations(db, config.Config.DBDataMigrationVersion); err != nil {
+			logrus.WithField("err", err).Fatal("failed to apply the data migrations")
+		}
 		singletonDB = db
 	})
 
@@ -62,3 +65,13 @@ func PopulateTestDB(flag Flag) *gorm.DB {
 	flag.Create(testDB)
 	return testDB
 }
+
+// ForUpdate locks the rows that the query of the transaction reads until the transaction ends,
+// so that concurrent transactions reading them wait. sqlite doesn't support it, and locks the
+// whole database on the first write of a transaction instead
+func ForUpdate(tx *gorm.DB) *gorm.DB {
+	if tx.Dialect().GetName() == "sqlite3" {
+		return tx
+	}
+	return tx.Set("gorm:query_option", "FOR UPDATE")
+}
@@ -3,6 +3,8 @@
 package entity
 
 import (
+	"crypto/sha1"
+	"encoding/binary"
 	"fmt"
 	"hash/crc32"
 	"sort"
@@ -17,8 +19,46 @@ const (
 
 	// PercentMultiplier implies that the multiplier between percentage (100) and TotalBucketNum
 	PercentMultiplier uint = TotalBucketNum / uint(100)
+
+	// HighResolutionBucketNum is the number of buckets of the high resolution hash,
+	// it's a multiple of TotalBucketNum so that the distributions map to the same buckets
+	HighResolutionBucketNum uint = 1000000
+
+	// BasisPointsPerPercent is the number of basis points (0.01%) in a percent
+	BasisPointsPerPercent uint = 100
+)
+
+// The hash functions of bucketing the entities
+const (
+	HashCRC32 = "crc32" // crc32 into TotalBucketNum buckets, the default
+	HashSHA1  = "sha1"  // the first 8 bytes of sha1 into HighResolutionBucketNum buckets
 )
 
+// Hashes are all the hash functions of bucketing
+var Hashes = []string{HashCRC32, HashSHA1}
+
+// Bucketing is how the entities are hashed into the buckets
+type Bucketing struct {
+	Salt string
+	Hash string // HashCRC32 if empty
+}
+
+// BucketNum returns the number of buckets of the hash
+func (b Bucketing) BucketNum() uint {
+	if b.Hash == HashSHA1 {
+		return HighResolutionBucketNum
+	}
+	return TotalBucketNum
+}
+
+func (b Bucketing) bucket(entityID string) uint {
+	if b.Hash == HashSHA1 {
+		sum := sha1.Sum([]byte(b.Salt + entityID))
+		return uint(binary.BigEndian.Uint64(sum[:8]) % uint64(HighResolutionBucketNum))
+	}
+	return crc32Num(entityID, b.Salt)
+}
+
 // Distribution is the struct represents distribution under segment and links to variant
 // gen:qs
 type Distribution struct {
@@ -39,19 +79,25 @@ type DistributionArray struct {
 
 // DistributionDebugLog is useful for making debug logs
 type DistributionDebugLog struct {
-	BucketNum         uint
-	DistributionArray DistributionArray
-	VariantID         uint
-	RolloutPercent    uint
+	BucketNum          uint
+	DistributionArray  DistributionArray
+	VariantID          uint
+	RolloutPercent     uint
+	RolloutBasisPoints uint
 }
 
-// Rollout rolls out the entity based on the rolloutPercent
+// Rollout rolls out the entity based on the rolloutPercent with the default crc32 bucketing
 func (d DistributionArray) Rollout(entityID string, salt string, rolloutPercent uint) (variantID *uint, msg string) {
+	return d.RolloutWithBucketing(entityID, Bucketing{Salt: salt}, rolloutPercent*BasisPointsPerPercent)
+}
+
+// RolloutWithBucketing rolls out the entity based on the rollout in basis points (0.01%)
+func (d DistributionArray) RolloutWithBucketing(entityID string, b Bucketing, rolloutBasisPoints uint) (variantID *uint, msg string) {
 	if entityID == "" {
 		return nil, "rollout no. empty entityID"
 	}
 
-	if rolloutPercent == uint(0) {
+	if rolloutBasisPoints == uint(0) {
 		return nil, "rollout no. 0% rolloutPercent"
 	}
 
@@ -59,16 +105,18 @@ func (d DistributionArray) Rollout(entityID string, salt string, rolloutPercent
 		return nil, "rollout no. there's no distribution set"
 	}
 
-	num := crc32Num(entityID, salt)
-	vID, index := d.bucketByNum(num)
+	num := b.bucket(entityID)
+	scale := b.BucketNum() / TotalBucketNum
+	vID, index := d.bucketByNum(num / scale)
 	log := fmt.Sprintf("%+v", DistributionDebugLog{
-		BucketNum:         num,
-		DistributionArray: d,
-		VariantID:         vID,
-		RolloutPercent:    rolloutPercent,
+		BucketNum:          num,
+		DistributionArray:  d,
+		VariantID:          vID,
+		RolloutPercent:     rolloutBasisPoints / BasisPointsPerPercent,
+		RolloutBasisPoints: rolloutBasisPoints,
 	})
 
-	if d.rollout(num, rolloutPercent, index) {
+	if d.rolloutBasisPoints(num, scale, rolloutBasisPoints, index) {
 		return &vID, "rollout yes. " + log
 	}
 	return nil, "rollout no. " + log
@@ -80,23 +128,35 @@ func (d DistributionArray) bucketByNum(bucketNum uint) (variantID uint, index in
 }
 
 func (d DistributionArray) rollout(bucketNum uint, rolloutPercent uint, index int) bool {
-	if rolloutPercent == uint(0) {
+	return d.rolloutBasisPoints(bucketNum, 1, rolloutPercent*BasisPointsPerPercent, index)
+}
+
+// rolloutBasisPoints rolls out the bucketNum out of scale*TotalBucketNum buckets, the
+// first rolloutBasisPoints/10000 of the buckets of the variant are rolled out. Below the
+// resolution of the buckets, i.e. less than a bucket out of all the buckets, only the
+// buckets that are wholly within the rollout are rolled out, so that e.g. 1 basis point
+// with the 1000 buckets of crc32 doesn't roll out the first bucket of every variant
+func (d DistributionArray) rolloutBasisPoints(bucketNum uint, scale uint, rolloutBasisPoints uint, index int) bool {
+	if rolloutBasisPoints == uint(0) {
 		return false
 	}
-	if rolloutPercent == uint(100) {
+	if rolloutBasisPoints >= 100*BasisPointsPerPercent {
 		return true
 	}
 
-	min := 0
-	max := d.PercentsAccumulated[index]
-	r := 0
+	min := uint64(0)
+	max := uint64(d.PercentsAccumulated[index]) * uint64(scale)
+	r := uint64(0)
 	if index != 0 {
-		min = d.PercentsAccumulated[index-1]
+		min = uint64(d.PercentsAccumulated[index-1]) * uint64(scale)
+	}
+	if uint64(rolloutBasisPoints)*uint64(scale)*uint64(TotalBucketNum) < uint64(100*BasisPointsPerPercent) {
+		return uint64(100*BasisPointsPerPercent)*(uint64(bucketNum)-min+1) <= (max-min)*uint64(rolloutBasisPoints)
 	}
-	if max-min-1 > 0 {
+	if max > min+1 {
 		r = max - min - 1
 	}
-	return 100*(bucketNum-uint(min)) <= uint(r)*rolloutPercent
+	return uint64(100*BasisPointsPerPercent)*(uint64(bucketNum)-min) <= r*uint64(rolloutBasisPoints)
 }
 
 func crc32Num(entityID string, salt string) uint {
@@ -1,6 +1,7 @@
 package entity
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -177,3 +178,77 @@ func TestRolloutWithEntity(t *testing.T) {
 		assert.Contains(t, msg, "no")
 	})
 }
+
+func TestRolloutBasisPoints(t *testing.T) {
+	d := DistributionArray{
+		VariantIDs:          []uint{1111, 2222},
+		PercentsAccumulated: []int{500, 1000},
+	}
+	scale := HighResolutionBucketNum / TotalBucketNum
+
+	assert.True(t, d.rolloutBasisPoints(0, scale, 1, 0))
+	assert.True(t, d.rolloutBasisPoints(49, scale, 1, 0))
+	assert.False(t, d.rolloutBasisPoints(50, scale, 1, 0))
+	assert.True(t, d.rolloutBasisPoints(500000+49, scale, 1, 1))
+	assert.False(t, d.rolloutBasisPoints(500000+50, scale, 1, 1))
+	assert.True(t, d.rolloutBasisPoints(999999, scale, 10000, 1))
+	assert.False(t, d.rolloutBasisPoints(0, scale, 0, 0))
+
+	// below the resolution of the default bucketing, no bucket is wholly within 1 basis point
+	for num := uint(0); num < TotalBucketNum; num++ {
+		_, index := d.bucketByNum(num)
+		assert.False(t, d.rolloutBasisPoints(num, 1, 1, index))
+	}
+
+	// the same as the percents with the default bucketing
+	for num := uint(0); num < TotalBucketNum; num++ {
+		_, index := d.bucketByNum(num)
+		assert.Equal(t, d.rollout(num, 34, index), d.rolloutBasisPoints(num, 1, 3400, index))
+	}
+}
+
+func TestBucketing(t *testing.T) {
+	crc := Bucketing{Salt: "salt1"}
+	assert.Equal(t, TotalBucketNum, crc.BucketNum())
+	assert.Equal(t, crc32Num("entity1", "salt1"), crc.bucket("entity1"))
+
+	sha := Bucketing{Salt: "salt1", Hash: HashSHA1}
+	assert.Equal(t, HighResolutionBucketNum, sha.BucketNum())
+	for i := 0; i < 100; i++ {
+		num := sha.bucket(fmt.Sprintf("entity%d", i))
+		assert.True(t, num < HighResolutionBucketNum)
+		assert.Equal(t, num, sha.bucket(fmt.Sprintf("entity%d", i)))
+	}
+
+	t.Run("rollout in basis points", func(t *testing.T) {
+		d := DistributionArray{
+			VariantIDs:          []uint{1111},
+			PercentsAccumulated: []int{1000},
+		}
+		n := 0
+		for i := 0; i < 100000; i++ {
+			if vID, _ := d.RolloutWithBucketing(fmt.Sprintf("entity%d", i), sha, 10); vID != nil {
+				n++
+			}
+		}
+		assert.InDelta(t, 100, n, 40) // 0.1% of 100000
+	})
+
+	t.Run("rollout of 1 basis point", func(t *testing.T) {
+		d := DistributionArray{
+			VariantIDs:          []uint{1111, 2222},
+			PercentsAccumulated: []int{500, 1000},
+		}
+		rolledOut := func(b Bucketing) int {
+			n := 0
+			for i := 0; i < 200000; i++ {
+				if vID, _ := d.RolloutWithBucketing(fmt.Sprintf("entity%d", i), b, 1); vID != nil {
+					n++
+				}
+			}
+			return n
+		}
+		assert.InDelta(t, 20, rolledOut(sha), 12)               // 0.01% of 200000
+		assert.Equal(t, 0, rolledOut(Bucketing{Salt: "salt1"})) // below the resolution of crc32
+	})
+}
@@ -2,11 +2,24 @@ package entity
 
 // AutoMigrateTables stores the entity tables that we can auto migrate in gorm
 var AutoMigrateTables = []interface{}{
+	AssignmentCount{},
+	AssignmentRecord{},
+	Audience{},
 	Constraint{},
+	ConversionEvent{},
+	DataMigration{},
 	Distribution{},
 	FlagSnapshot{},
 	Flag{},
+	IDListItem{},
+	IDList{},
+	Layer{},
+	Override{},
+	SRMAlert{},
 	Segment{},
+	StickyAssignment{},
 	User{},
 	Variant{},
+	WebhookDelivery{},
+	Webhook{},
 }
@@ -22,15 +22,36 @@ type Flag struct {
 	Enabled            bool
 	Segments           []Segment
 	Variants           []Variant
+	Overrides          []Override
 	DataRecordsEnabled bool
-	SnapshotID         uint `json:"-"`
+	AttachmentSchema   AttachmentSchema `sql:"type:text"`
+	SnapshotID         uint             `json:"-"`
+
+	// Salt and Hash are how the entities are bucketed, the flagID is the salt if Salt is empty
+	Salt string
+	Hash string
+
+	// BucketBy is the entityContext property that the entities are bucketed by instead
+	// of the entityID, e.g. company_id so that all the users of a company get the same variant
+	BucketBy string
+
+	// StickyAssignments stores the first assignment of every entity, so that the entity keeps
+	// its variant when the distributions change as long as it stays in the same segment
+	StickyAssignments bool
+
+	// LayerID is the layer the flag is in, and [LayerBucketStart, LayerBucketEnd)
+	// are the buckets of the layer allocated to the flag
+	LayerID          uint `gorm:"index:idx_flag_layerid"`
+	LayerBucketStart uint
+	LayerBucketEnd   uint
 
 	FlagEvaluation FlagEvaluation `gorm:"-" json:"-"`
 }
 
 // FlagEvaluation is a struct that holds the necessary info for evaluation
 type FlagEvaluation struct {
-	VariantsMap map[uint]*Variant
+	VariantsMap  map[uint]*Variant
+	OverridesMap map[overrideKey]*Override
 }
 
 // CreateFlagKey creates the key based on the given key
@@ -46,7 +67,16 @@ func CreateFlagKey(key string) (string, error) {
 	return key, nil
 }
 
-// Preload preloads the segments and variants into flags
+// Bucketing returns how the entities are bucketed in the flag
+func (f *Flag) Bucketing() Bucketing {
+	salt := f.Salt
+	if salt == "" {
+		salt = fmt.Sprint(f.ID) // default use the flagID as salt
+	}
+	return Bucketing{Salt: salt, Hash: f.Hash}
+}
+
+// Preload preloads the segments, variants and overrides into flags
 func (f *Flag) Preload(db *gorm.DB) error {
 	ss := []Segment{}
 	segmentQuery := NewSegmentQuerySet(db)
@@ -68,13 +98,20 @@ func (f *Flag) Preload(db *gorm.DB) error {
 		return err
 	}
 	f.Variants = vs
+
+	ovs := []Override{}
+	if err := NewOverrideQuerySet(db).FlagIDEq(f.ID).OrderAscByID().All(&ovs); err != nil {
+		return err
+	}
+	f.Overrides = ovs
 	return nil
 }
 
 // PrepareEvaluation prepares the information for evaluation
 func (f *Flag) PrepareEvaluation() error {
 	f.FlagEvaluation = FlagEvaluation{
-		VariantsMap: make(map[uint]*Variant),
+		VariantsMap:  make(map[uint]*Variant),
+		OverridesMap: make(map[overrideKey]*Override),
 	}
 	for i := range f.Segments {
 		if err := f.Segments[i].PrepareEvaluation(); err != nil {
@@ -84,6 +121,10 @@ func (f *Flag) PrepareEvaluation() error {
 	for i := range f.Variants {
 		f.FlagEvaluation.VariantsMap[f.Variants[i].ID] = &f.Variants[i]
 	}
+	for i := range f.Overrides {
+		o := &f.Overrides[i]
+		f.FlagEvaluation.OverridesMap[overrideKey{entityType: o.EntityType, entityID: o.EntityID}] = o
+	}
 	return nil
 }
 
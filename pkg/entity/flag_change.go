@@ -0,0 +1,238 @@
+package entity
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// describeFlagChange returns the human-readable summary of the flag change between
+// the consecutive snapshots, e.g. "alice enabled `new_checkout` (rollout 10%→25% in segment 'US users')"
+func describeFlagChange(event string, actor string, prev *Flag, cur *Flag) string {
+	if actor == "" {
+		actor = "anonymous"
+	}
+	msg := fmt.Sprintf("%s %s `%s`", actor, strings.TrimPrefix(event, "flag."), cur.Key)
+
+	if prev == nil {
+		return msg
+	}
+	changes := describeFlagChanges(prev, cur)
+	if len(changes) == 0 {
+		return msg
+	}
+	return fmt.Sprintf("%s (%s)", msg, strings.Join(changes, "; "))
+}
+
+func describeFlagChanges(prev *Flag, cur *Flag) []string {
+	changes := []string{}
+	if prev.Key != cur.Key {
+		changes = append(changes, fmt.Sprintf("key `%s`→`%s`", prev.Key, cur.Key))
+	}
+	if prev.Description != cur.Description {
+		changes = append(changes, "description changed")
+	}
+	if prev.DataRecordsEnabled != cur.DataRecordsEnabled {
+		if cur.DataRecordsEnabled {
+			changes = append(changes, "data records enabled")
+		} else {
+			changes = append(changes, "data records disabled")
+		}
+	}
+	if (len(prev.AttachmentSchema) != 0 || len(cur.AttachmentSchema) != 0) &&
+		!reflect.DeepEqual(prev.AttachmentSchema, cur.AttachmentSchema) {
+		changes = append(changes, "attachment schema changed")
+	}
+	if prev.StickyAssignments != cur.StickyAssignments {
+		if cur.StickyAssignments {
+			changes = append(changes, "sticky assignments enabled")
+		} else {
+			changes = append(changes, "sticky assignments disabled")
+		}
+	}
+	if prev.Bucketing() != cur.Bucketing() || prev.BucketBy != cur.BucketBy {
+		changes = append(changes, "bucketing changed")
+	}
+	if prev.LayerID != cur.LayerID || prev.LayerBucketStart != cur.LayerBucketStart ||
+		prev.LayerBucketEnd != cur.LayerBucketEnd {
+		if cur.LayerID == 0 {
+			changes = append(changes, fmt.Sprintf("left layer %d", prev.LayerID))
+		} else {
+			changes = append(changes, fmt.Sprintf("layer %d buckets [%d, %d)", cur.LayerID, cur.LayerBucketStart, cur.LayerBucketEnd))
+		}
+	}
+	changes = append(changes, describeVariantChanges(prev.Variants, cur.Variants)...)
+	changes = append(changes, describeOverrideChanges(prev.Overrides, cur.Overrides)...)
+	changes = append(changes, describeSegmentChanges(prev.Segments, cur.Segments)...)
+	return changes
+}
+
+func describeVariantChanges(prev []Variant, cur []Variant) []string {
+	changes := []string{}
+	pm := make(map[uint]Variant)
+	for _, v := range prev {
+		pm[v.ID] = v
+	}
+	for _, v := range cur {
+		pv, ok := pm[v.ID]
+		delete(pm, v.ID)
+		switch {
+		case !ok:
+			changes = append(changes, fmt.Sprintf("added variant '%s'", v.Key))
+		case pv.Key != v.Key:
+			changes = append(changes, fmt.Sprintf("variant '%s' renamed to '%s'", pv.Key, v.Key))
+		case !reflect.DeepEqual(pv.Attachment, v.Attachment):
+			changes = append(changes, fmt.Sprintf("attachment changed in variant '%s'", v.Key))
+		}
+	}
+	for _, v := range prev {
+		if _, ok := pm[v.ID]; ok {
+			changes = append(changes, fmt.Sprintf("removed variant '%s'", v.Key))
+		}
+	}
+	return changes
+}
+
+func describeOverrideChanges(prev []Override, cur []Override) []string {
+	changes := []string{}
+	pm := make(map[uint]Override)
+	for _, o := range prev {
+		pm[o.ID] = o
+	}
+	for _, o := range cur {
+		po, ok := pm[o.ID]
+		delete(pm, o.ID)
+		switch {
+		case !ok:
+			changes = append(changes, fmt.Sprintf("added override of entityID '%s'", o.EntityID))
+		case po.VariantID != o.VariantID || !equalTimes(po.ExpiresAt, o.ExpiresAt):
+			changes = append(changes, fmt.Sprintf("override of entityID '%s' changed", o.EntityID))
+		}
+	}
+	for _, o := range prev {
+		if _, ok := pm[o.ID]; ok {
+			changes = append(changes, fmt.Sprintf("removed override of entityID '%s'", o.EntityID))
+		}
+	}
+	return changes
+}
+
+func equalTimes(prev *time.Time, cur *time.Time) bool {
+	if prev == nil || cur == nil {
+		return prev == cur
+	}
+	return prev.Equal(*cur)
+}
+
+func describeSegmentChanges(prev []Segment, cur []Segment) []string {
+	changes := []string{}
+	pm := make(map[uint]Segment)
+	for _, s := range prev {
+		pm[s.ID] = s
+	}
+
+	prevOrder := []uint{}
+	curOrder := []uint{}
+	for _, s := range cur {
+		ps, ok := pm[s.ID]
+		delete(pm, s.ID)
+		if !ok {
+			changes = append(changes, fmt.Sprintf("added segment '%s'", s.Description))
+			continue
+		}
+		curOrder = append(curOrder, s.ID)
+		if ps.Description != s.Description {
+			changes = append(changes, fmt.Sprintf("segment '%s' renamed to '%s'", ps.Description, s.Description))
+		}
+		if ps.RolloutInBasisPoints() != s.RolloutInBasisPoints() {
+			changes = append(changes, fmt.Sprintf(
+				"rollout %s→%s in segment '%s'",
+				formatBasisPoints(ps.RolloutInBasisPoints()), formatBasisPoints(s.RolloutInBasisPoints()), s.Description))
+		}
+		if !equalConstraints(ps.Constraints, s.Constraints) || !reflect.DeepEqual(ps.ConstraintGroup, s.ConstraintGroup) {
+			changes = append(changes, fmt.Sprintf("constraints changed in segment '%s'", s.Description))
+		}
+		if ps.AudienceID != s.AudienceID || !equalConstraints(audienceConstraints(ps), audienceConstraints(s)) {
+			changes = append(changes, fmt.Sprintf("audience changed in segment '%s'", s.Description))
+		}
+		if d := describeDistributionChanges(ps.Distributions, s.Distributions); d != "" {
+			changes = append(changes, fmt.Sprintf("distribution %s in segment '%s'", d, s.Description))
+		}
+	}
+	for _, s := range prev {
+		if _, ok := pm[s.ID]; ok {
+			changes = append(changes, fmt.Sprintf("removed segment '%s'", s.Description))
+		} else {
+			prevOrder = append(prevOrder, s.ID)
+		}
+	}
+	if !reflect.DeepEqual(prevOrder, curOrder) {
+		changes = append(changes, "segments reordered")
+	}
+	return changes
+}
+
+func audienceConstraints(s Segment) ConstraintArray {
+	if s.Audience == nil {
+		return nil
+	}
+	return s.Audience.Constraints
+}
+
+func equalConstraints(prev ConstraintArray, cur ConstraintArray) bool {
+	if len(prev) != len(cur) {
+		return false
+	}
+	for i := range prev {
+		p, c := typedConstraint(prev[i]), typedConstraint(cur[i])
+		if p.Property != c.Property ||
+			p.Operator != c.Operator ||
+			p.Value != c.Value ||
+			p.ValueType != c.ValueType {
+			return false
+		}
+	}
+	return true
+}
+
+// typedConstraint types the constraint the way MigrateConstraintValueTypes does, so that
+// typing a constraint without changing how it evaluates, e.g. by the migration, isn't a change
+func typedConstraint(c Constraint) Constraint {
+	if _, ok := OperatorToExprMap[c.Operator]; !ok || c.ValueType != "" {
+		return c
+	}
+	if valueType, value, ok := inferValueType(c); ok {
+		c.ValueType, c.Value = valueType, value
+	}
+	return c
+}
+
+// describeDistributionChanges returns e.g. "control 50%→40%, treatment 50%→60%"
+func describeDistributionChanges(prev []Distribution, cur []Distribution) string {
+	percents := make(map[uint]uint)
+	for _, d := range prev {
+		percents[d.VariantID] = d.Percent
+	}
+
+	changes := []string{}
+	for _, d := range cur {
+		p := percents[d.VariantID]
+		delete(percents, d.VariantID)
+		if p != d.Percent {
+			changes = append(changes, fmt.Sprintf("%s %d%%→%d%%", d.VariantKey, p, d.Percent))
+		}
+	}
+	for _, d := range prev {
+		if _, ok := percents[d.VariantID]; ok && d.Percent != 0 {
+			changes = append(changes, fmt.Sprintf("%s %d%%→0%%", d.VariantKey, d.Percent))
+		}
+	}
+	return strings.Join(changes, ", ")
+}
+
+// formatBasisPoints formats the basis points as a percent, e.g. 1050 as 10.5%
+func formatBasisPoints(bp uint) string {
+	return strconv.FormatFloat(float64(bp)/float64(BasisPointsPerPercent), 'f', -1, 64) + "%"
+}
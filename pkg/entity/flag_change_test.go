@@ -0,0 +1,192 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/checkr/flagr/swagger_gen/models"
+	"github.com/jinzhu/gorm"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDescribeFlagChange(t *testing.T) {
+	t.Run("no previous snapshot", func(t *testing.T) {
+		f := GenFixtureFlag()
+		assert.Equal(t, "alice created `flag_key_100`", describeFlagChange(FlagEventCreated, "alice", nil, &f))
+		assert.Equal(t, "anonymous deleted `flag_key_100`", describeFlagChange(FlagEventDeleted, "", nil, &f))
+	})
+
+	t.Run("enabled with rollout change", func(t *testing.T) {
+		prev := GenFixtureFlag()
+		prev.Enabled = false
+		prev.Segments[0].Description = "US users"
+		prev.Segments[0].RolloutPercent = 10
+
+		cur := GenFixtureFlag()
+		cur.Key = "new_checkout"
+		prev.Key = "new_checkout"
+		cur.Segments[0].Description = "US users"
+		cur.Segments[0].RolloutPercent = 25
+
+		assert.Equal(t,
+			"alice enabled `new_checkout` (rollout 10%→25% in segment 'US users')",
+			describeFlagChange(FlagEventEnabled, "alice", &prev, &cur),
+		)
+	})
+
+	t.Run("flag level changes", func(t *testing.T) {
+		prev := GenFixtureFlag()
+		cur := GenFixtureFlag()
+		cur.Key = "flag_key_101"
+		cur.Description = "new description"
+		cur.DataRecordsEnabled = true
+		assert.Equal(t,
+			"alice updated `flag_key_101` (key `flag_key_100`→`flag_key_101`; description changed; data records enabled)",
+			describeFlagChange(FlagEventUpdated, "alice", &prev, &cur),
+		)
+	})
+
+	t.Run("bucketing changes", func(t *testing.T) {
+		prev := GenFixtureFlag()
+		cur := GenFixtureFlag()
+		cur.Salt = "100"
+		assert.Equal(t, []string{}, describeFlagChanges(&prev, &cur))
+
+		cur.BucketBy = "company_id"
+		assert.Equal(t, []string{"bucketing changed"}, describeFlagChanges(&prev, &cur))
+		cur.BucketBy = ""
+
+		cur.Hash = HashSHA1
+		cur.Segments[0].RolloutBasisPoints = 5
+		assert.Equal(t, []string{
+			"bucketing changed",
+			"rollout 100%→0.05% in segment ''",
+		}, describeFlagChanges(&prev, &cur))
+	})
+
+	t.Run("sticky assignments", func(t *testing.T) {
+		prev := GenFixtureFlag()
+		cur := GenFixtureFlag()
+		cur.StickyAssignments = true
+		assert.Equal(t, []string{"sticky assignments enabled"}, describeFlagChanges(&prev, &cur))
+		assert.Equal(t, []string{"sticky assignments disabled"}, describeFlagChanges(&cur, &prev))
+	})
+
+	t.Run("layer changes", func(t *testing.T) {
+		prev := GenFixtureFlag()
+		cur := GenFixtureFlag()
+		cur.LayerID = 3
+		cur.LayerBucketEnd = 250
+		assert.Equal(t, []string{"layer 3 buckets [0, 250)"}, describeFlagChanges(&prev, &cur))
+		assert.Equal(t, []string{"left layer 3"}, describeFlagChanges(&cur, &prev))
+	})
+
+	t.Run("variant changes", func(t *testing.T) {
+		prev := GenFixtureFlag()
+		cur := GenFixtureFlag()
+		cur.Variants[0].Key = "baseline"
+		cur.Variants[1].Attachment = map[string]interface{}{"value": "123"}
+		cur.Variants = append(cur.Variants, Variant{Model: gorm.Model{ID: 302}, Key: "treatment2"})
+		assert.Equal(t, []string{
+			"variant 'control' renamed to 'baseline'",
+			"attachment changed in variant 'treatment'",
+			"added variant 'treatment2'",
+		}, describeFlagChanges(&prev, &cur))
+
+		orig := GenFixtureFlag()
+		orig.Variants[0].Key = "baseline"
+		orig.Variants[1].Attachment = map[string]interface{}{"value": "123"}
+		assert.Equal(t, []string{"removed variant 'treatment2'"}, describeFlagChanges(&cur, &orig))
+	})
+
+	t.Run("override changes", func(t *testing.T) {
+		expiresAt := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+		prev := GenFixtureFlag()
+		prev.Overrides = []Override{
+			{Model: gorm.Model{ID: 600}, EntityID: "qa_1", VariantID: 300},
+			{Model: gorm.Model{ID: 601}, EntityID: "qa_2", VariantID: 300, ExpiresAt: &expiresAt},
+		}
+		cur := GenFixtureFlag()
+		cur.Overrides = []Override{
+			{Model: gorm.Model{ID: 600}, EntityID: "qa_1", VariantID: 301},
+			{Model: gorm.Model{ID: 602}, EntityID: "qa_3", VariantID: 301},
+		}
+		assert.Equal(t, []string{
+			"override of entityID 'qa_1' changed",
+			"added override of entityID 'qa_3'",
+			"removed override of entityID 'qa_2'",
+		}, describeFlagChanges(&prev, &cur))
+
+		sameExpiresAt := expiresAt.In(time.FixedZone("PST", -8*3600))
+		cur.Overrides = []Override{
+			{Model: gorm.Model{ID: 600}, EntityID: "qa_1", VariantID: 300},
+			{Model: gorm.Model{ID: 601}, EntityID: "qa_2", VariantID: 300, ExpiresAt: &sameExpiresAt},
+		}
+		assert.Equal(t, []string{}, describeFlagChanges(&prev, &cur))
+	})
+
+	t.Run("segment changes", func(t *testing.T) {
+		prev := GenFixtureFlag()
+		cur := GenFixtureFlag()
+		cur.Segments[0].Constraints[0].Value = `"NY"`
+		cur.Segments[0].Distributions[0].Percent = 40
+		cur.Segments[0].Distributions[1].Percent = 60
+		assert.Equal(t, []string{
+			"constraints changed in segment ''",
+			"distribution control 50%→40%, treatment 50%→60% in segment ''",
+		}, describeFlagChanges(&prev, &cur))
+	})
+
+	t.Run("typing the constraints is not a change", func(t *testing.T) {
+		prev := GenFixtureFlag()
+		cur := GenFixtureFlag()
+		cur.Segments[0].Constraints[0].Value = "CA"
+		cur.Segments[0].Constraints[0].ValueType = models.ConstraintValueTypeSTRING
+		assert.Equal(t, []string{}, describeFlagChanges(&prev, &cur))
+
+		cur.Segments[0].Constraints[0].Value = "NY"
+		assert.Equal(t, []string{"constraints changed in segment ''"}, describeFlagChanges(&prev, &cur))
+	})
+
+	t.Run("constraint group changes", func(t *testing.T) {
+		prev := GenFixtureFlag()
+		cur := GenFixtureFlag()
+		cur.Segments[0].ConstraintGroup = ConstraintGroup{
+			Operator: ConstraintGroupNOT,
+			Children: []ConstraintGroup{{ConstraintID: 500}},
+		}
+		assert.Equal(t, []string{"constraints changed in segment ''"}, describeFlagChanges(&prev, &cur))
+	})
+
+	t.Run("audience changes", func(t *testing.T) {
+		employees := &Audience{Model: gorm.Model{ID: 1}, Constraints: []Constraint{
+			{Property: "email", Operator: "EREG", Value: `"@example.com$"`},
+		}}
+		prev := GenFixtureFlag()
+		cur := GenFixtureFlag()
+		cur.Segments[0].AudienceID = 1
+		cur.Segments[0].Audience = employees
+		assert.Equal(t, []string{"audience changed in segment ''"}, describeFlagChanges(&prev, &cur))
+
+		prev.Segments[0].AudienceID = 1
+		prev.Segments[0].Audience = &Audience{Model: gorm.Model{ID: 1}, Constraints: []Constraint{
+			{Property: "email", Operator: "EREG", Value: `"@example.org$"`},
+		}}
+		assert.Equal(t, []string{"audience changed in segment ''"}, describeFlagChanges(&prev, &cur))
+
+		prev.Segments[0].Audience = employees
+		assert.Equal(t, []string{}, describeFlagChanges(&prev, &cur))
+	})
+
+	t.Run("segments added, removed and reordered", func(t *testing.T) {
+		s1 := Segment{Model: gorm.Model{ID: 1}, Description: "s1"}
+		s2 := Segment{Model: gorm.Model{ID: 2}, Description: "s2"}
+		s3 := Segment{Model: gorm.Model{ID: 3}, Description: "s3"}
+
+		prev := &Flag{Segments: []Segment{s1, s2}}
+		assert.Equal(t, []string{"added segment 's3'", "removed segment 's1'"},
+			describeFlagChanges(prev, &Flag{Segments: []Segment{s2, s3}}))
+		assert.Equal(t, []string{"segments reordered"},
+			describeFlagChanges(prev, &Flag{Segments: []Segment{s2, s1}}))
+	})
+}
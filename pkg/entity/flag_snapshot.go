@@ -43,6 +43,12 @@ func SaveFlagSnapshot(db *gorm.DB, flagID uint, updatedBy string) {
 		return
 	}
 
+	var prev *FlagSnapshot
+	ps := []FlagSnapshot{}
+	if err := NewFlagSnapshotQuerySet(tx).FlagIDEq(f.ID).OrderDescByID().Limit(1).All(&ps); err == nil && len(ps) > 0 {
+		prev = &ps[0]
+	}
+
 	fs := FlagSnapshot{FlagID: f.ID, UpdatedBy: updatedBy, Flag: b}
 	if err := tx.Create(&fs).Error; err != nil {
 		logrus.WithFields(logrus.Fields{
@@ -63,6 +69,16 @@ func SaveFlagSnapshot(db *gorm.DB, flagID uint, updatedBy string) {
 		return
 	}
 
+	if err := EnqueueFlagEvent(tx, NewFlagEvent(prev, &fs, f)); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"err":            err,
+			"flagID":         f.ID,
+			"flagSnapshotID": fs.ID,
+		}).Error("failed to enqueue the webhook deliveries of the flag event")
+		tx.Rollback()
+		return
+	}
+
 	if err := tx.Commit().Error; err != nil {
 		tx.Rollback()
 	}
@@ -0,0 +1,139 @@
+//go:generate goqueryset -in id_list.go
+
+package entity
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/jinzhu/gorm"
+)
+
+// IDListItemMaxLength is the max length of an ID of an ID list
+const IDListItemMaxLength = 255
+
+// idListInsertBatchSize keeps the variables of the batch inserts below the limit of sqlite
+const idListInsertBatchSize = 400
+
+// IDList is a named list of IDs, e.g. the account IDs of a beta, that the constraints with
+// the IN_LIST operators match against, so a large list doesn't live in the constraint value
+// gen:qs
+type IDList struct {
+	gorm.Model
+
+	Key         string `gorm:"type:varchar(64);unique_index:idx_id_list_key"`
+	Description string `sql:"type:text"`
+	Size        uint
+	// Version is incremented by every upload of the IDs, the EvalCache reloads the IDs when it changes
+	Version uint `gorm:"not null;default:0"`
+}
+
+// IDListItem is an ID of an ID list
+// gen:qs
+type IDListItem struct {
+	ID       uint   `gorm:"primary_key"`
+	IDListID uint   `gorm:"index:idx_id_list_item_idlistid"`
+	Value    string `gorm:"type:varchar(255)"`
+}
+
+// IDListSet is the set of the IDs of an ID list for the evaluation
+type IDListSet map[string]struct{}
+
+// IDListLookup looks up the IDs of the ID list by key for the evaluation of the IN_LIST
+// operators, the EvalCache provides it
+var IDListLookup = func(key string) (IDListSet, bool) {
+	return nil, false
+}
+
+// ParseIDs parses the uploaded IDs separated by newlines or commas, e.g. the content of a
+// CSV file. The IDs are trimmed, and the empty and duplicated ones are skipped
+func ParseIDs(s string) ([]string, error) {
+	r := csv.NewReader(strings.NewReader(s))
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+	r.LazyQuotes = true
+
+	ids := []string{}
+	seen := make(map[string]bool)
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid IDs. %s", err)
+		}
+		for _, id := range record {
+			id = strings.TrimSpace(id)
+			if id == "" || seen[id] {
+				continue
+			}
+			if len(id) > IDListItemMaxLength {
+				return nil, fmt.Errorf("ID %s is longer than %d characters", id, IDListItemMaxLength)
+			}
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+// ReplaceIDs replaces all the IDs of the ID list, and updates its size
+func (l *IDList) ReplaceIDs(tx *gorm.DB, ids []string) error {
+	if err := NewIDListItemQuerySet(tx).IDListIDEq(l.ID).Delete(); err != nil {
+		return err
+	}
+	for start := 0; start < len(ids); start += idListInsertBatchSize {
+		end := start + idListInsertBatchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+		placeholders := make([]string, 0, end-start)
+		values := make([]interface{}, 0, 2*(end-start))
+		for _, id := range ids[start:end] {
+			placeholders = append(placeholders, "(?, ?)")
+			values = append(values, l.ID, id)
+		}
+		err := tx.Exec(
+			"INSERT INTO id_list_items (id_list_id, value) VALUES "+strings.Join(placeholders, ", "),
+			values...,
+		).Error
+		if err != nil {
+			return err
+		}
+	}
+
+	// the version is incremented in DB, so that the concurrent uploads get distinct versions
+	err := tx.Model(&IDList{}).Where("id = ?", l.ID).UpdateColumns(map[string]interface{}{
+		"size":       len(ids),
+		"updated_at": time.Now(),
+		"version":    gorm.Expr("version + 1"),
+	}).Error
+	if err != nil {
+		return err
+	}
+	return NewIDListQuerySet(tx).IDEq(l.ID).One(l)
+}
+
+// IDs returns the IDs of the ID list in the uploaded order
+func (l *IDList) IDs(db *gorm.DB) ([]string, error) {
+	ids := []string{}
+	err := db.Model(&IDListItem{}).Where("id_list_id = ?", l.ID).Order("id ASC").Pluck("value", &ids).Error
+	return ids, err
+}
+
+// IDSet returns the set of the IDs of the ID list
+func (l *IDList) IDSet(db *gorm.DB) (IDListSet, error) {
+	ids, err := l.IDs(db)
+	if err != nil {
+		return nil, err
+	}
+	set := make(IDListSet, len(ids))
+	for _, id := range ids {
+		set[id] = struct{}{}
+	}
+	return set, nil
+}
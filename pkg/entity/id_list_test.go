@@ -0,0 +1,99 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/checkr/flagr/swagger_gen/models"
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseIDs(t *testing.T) {
+	ids, err := ParseIDs("a1\na2, a3\r\n\"a,4\"\n\na1,")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a1", "a2", "a3", "a,4"}, ids)
+
+	ids, err = ParseIDs("")
+	assert.NoError(t, err)
+	assert.Empty(t, ids)
+
+	_, err = ParseIDs(strings.Repeat("a", IDListItemMaxLength+1))
+	assert.Error(t, err)
+}
+
+func TestIDListReplaceIDs(t *testing.T) {
+	db := NewTestDB()
+	defer db.Close()
+
+	l := &IDList{Key: "customers_beta"}
+	assert.NoError(t, l.Create(db))
+
+	ids := make([]string, 2*idListInsertBatchSize+1)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("a%d", i)
+	}
+	assert.NoError(t, l.ReplaceIDs(db, ids))
+	assert.Equal(t, uint(len(ids)), l.Size)
+	assert.Equal(t, uint(1), l.Version)
+
+	set, err := l.IDSet(db)
+	assert.NoError(t, err)
+	assert.Len(t, set, len(ids))
+
+	assert.NoError(t, l.ReplaceIDs(db, []string{"b1", "b0"}))
+	ret, err := l.IDs(db)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"b1", "b0"}, ret)
+
+	stored := &IDList{}
+	assert.NoError(t, NewIDListQuerySet(db).IDEq(l.ID).One(stored))
+	assert.Equal(t, uint(2), stored.Size)
+	assert.Equal(t, uint(2), stored.Version)
+}
+
+func TestConstraintIDListOperators(t *testing.T) {
+	defer gostub.Stub(&IDListLookup, func(key string) (IDListSet, bool) {
+		if key != "customers_beta" {
+			return nil, false
+		}
+		return IDListSet{"a1": {}, "42": {}}, true
+	}).Reset()
+
+	match := func(operator string, value string, v interface{}) (bool, []error) {
+		cs := ConstraintArray{{Property: "account_id", Operator: operator, Value: value}}
+		expr, _, err := ConstraintGroup{}.ToExpr(cs)
+		assert.NoError(t, err)
+		matchers, err := cs.matchers()
+		assert.NoError(t, err)
+		return EvaluateConditions(expr, matchers, map[string]interface{}{"account_id": v})
+	}
+
+	for _, tc := range []struct {
+		operator string
+		value    string
+		v        interface{}
+		expected bool
+	}{
+		{models.ConstraintOperatorINLIST, `customers_beta`, "a1", true},
+		{models.ConstraintOperatorINLIST, `"customers_beta"`, float64(42), true},
+		{models.ConstraintOperatorINLIST, `customers_beta`, "a2", false},
+		{models.ConstraintOperatorNOTINLIST, `customers_beta`, "a2", true},
+	} {
+		m, errs := match(tc.operator, tc.value, tc.v)
+		assert.Equal(t, tc.expected, m, "%v %s %s", tc.v, tc.operator, tc.value)
+		assert.Empty(t, errs)
+	}
+
+	m, errs := match(models.ConstraintOperatorINLIST, `customers_alpha`, "a1")
+	assert.False(t, m)
+	assert.Len(t, errs, 1)
+
+	c := Constraint{Property: "account_id", Operator: models.ConstraintOperatorINLIST, Value: `"customers beta"`}
+	assert.Error(t, c.Validate())
+	c.Value = `customers_beta`
+	key, ok := c.IDListKey()
+	assert.True(t, ok)
+	assert.Equal(t, "customers_beta", key)
+}
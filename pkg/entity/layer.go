@@ -0,0 +1,84 @@
+//go:generate goqueryset -in layer.go
+
+package entity
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/jinzhu/gorm"
+)
+
+// Layer is a mutual exclusion group of flags. An entity is bucketed once per layer,
+// and the buckets of the layer are partitioned across its flags, so that the entity
+// gets a variant from at most one flag of the layer
+// gen:qs
+type Layer struct {
+	gorm.Model
+
+	Key         string `gorm:"type:varchar(64);unique_index:idx_layer_key"`
+	Description string `sql:"type:text"`
+}
+
+// LayerSalt is the salt of the layer when bucketing the entity, it's different
+// from the salt of any flag so that the layer buckets are independent of the variant buckets
+func LayerSalt(layerID uint) string {
+	return fmt.Sprintf("layer_%d_", layerID)
+}
+
+// LayerBucketNum is the bucket of the entity in the layer
+func LayerBucketNum(layerID uint, entityID string) uint {
+	return crc32Num(entityID, LayerSalt(layerID))
+}
+
+// InLayerBuckets checks whether the entity is bucketed into the buckets of the flag
+// in its layer. It's always true if the flag is not in a layer
+func (f *Flag) InLayerBuckets(entityID string) (ok bool, bucketNum uint) {
+	if f.LayerID == 0 {
+		return true, 0
+	}
+	num := LayerBucketNum(f.LayerID, entityID)
+	return num >= f.LayerBucketStart && num < f.LayerBucketEnd, num
+}
+
+// AllocateLayerBuckets finds the first free range of size buckets in the layer,
+// given the flags that are already in it. The ranges of the other flags never
+// move, so that their entities are not reshuffled
+func AllocateLayerBuckets(flags []Flag, size uint) (start uint, err error) {
+	if size == 0 || size > TotalBucketNum {
+		return 0, fmt.Errorf("invalid number of buckets %d", size)
+	}
+
+	sorted := make([]Flag, len(flags))
+	copy(sorted, flags)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].LayerBucketStart < sorted[j].LayerBucketStart
+	})
+
+	for _, f := range sorted {
+		if f.LayerBucketStart >= start+size {
+			return start, nil
+		}
+		if f.LayerBucketEnd > start {
+			start = f.LayerBucketEnd
+		}
+	}
+	if start+size > TotalBucketNum {
+		return 0, fmt.Errorf("there are not %d free buckets left in the layer", size)
+	}
+	return start, nil
+}
+
+// LayerBucketsFree checks whether [start, start+size) doesn't overlap the buckets
+// of the flags in the layer
+func LayerBucketsFree(flags []Flag, start uint, size uint) bool {
+	if start+size > TotalBucketNum {
+		return false
+	}
+	for _, f := range flags {
+		if f.LayerBucketStart < start+size && start < f.LayerBucketEnd {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,78 @@
+package entity
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAllocateLayerBuckets(t *testing.T) {
+	t.Run("empty layer", func(t *testing.T) {
+		start, err := AllocateLayerBuckets(nil, 250)
+		assert.NoError(t, err)
+		assert.Equal(t, uint(0), start)
+
+		start, err = AllocateLayerBuckets(nil, TotalBucketNum)
+		assert.NoError(t, err)
+		assert.Equal(t, uint(0), start)
+	})
+
+	t.Run("first free range", func(t *testing.T) {
+		flags := []Flag{
+			{LayerBucketStart: 500, LayerBucketEnd: 600},
+			{LayerBucketStart: 0, LayerBucketEnd: 250},
+		}
+		start, err := AllocateLayerBuckets(flags, 250)
+		assert.NoError(t, err)
+		assert.Equal(t, uint(250), start)
+
+		start, err = AllocateLayerBuckets(flags, 300)
+		assert.NoError(t, err)
+		assert.Equal(t, uint(600), start)
+	})
+
+	t.Run("not enough buckets", func(t *testing.T) {
+		flags := []Flag{
+			{LayerBucketStart: 0, LayerBucketEnd: 500},
+			{LayerBucketStart: 600, LayerBucketEnd: 1000},
+		}
+		_, err := AllocateLayerBuckets(flags, 200)
+		assert.Error(t, err)
+
+		_, err = AllocateLayerBuckets(nil, 0)
+		assert.Error(t, err)
+	})
+}
+
+func TestLayerBucketsFree(t *testing.T) {
+	flags := []Flag{{LayerBucketStart: 250, LayerBucketEnd: 500}}
+	assert.True(t, LayerBucketsFree(flags, 0, 250))
+	assert.True(t, LayerBucketsFree(flags, 500, 500))
+	assert.False(t, LayerBucketsFree(flags, 100, 200))
+	assert.False(t, LayerBucketsFree(flags, 400, 100))
+	assert.False(t, LayerBucketsFree(flags, 600, 500))
+}
+
+func TestInLayerBuckets(t *testing.T) {
+	f := GenFixtureFlag()
+	ok, _ := f.InLayerBuckets("entity_1")
+	assert.True(t, ok)
+
+	// the flags partitioning the layer get every entity exactly once
+	flags := []Flag{
+		{LayerID: 1, LayerBucketStart: 0, LayerBucketEnd: 300},
+		{LayerID: 1, LayerBucketStart: 300, LayerBucketEnd: 1000},
+	}
+	for i := 0; i < 100; i++ {
+		entityID := fmt.Sprintf("entity_%d", i)
+		in := 0
+		for _, f := range flags {
+			if ok, num := f.InLayerBuckets(entityID); ok {
+				assert.Equal(t, LayerBucketNum(1, entityID), num)
+				in++
+			}
+		}
+		assert.Equal(t, 1, in)
+	}
+}
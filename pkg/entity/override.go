@@ -0,0 +1,45 @@
+//go:generate goqueryset -in override.go
+
+package entity
+
+import (
+	"time"
+
+	"github.com/jinzhu/gorm"
+)
+
+// Override forces the variant of an entity in a flag, e.g. for QA. The overrides are
+// checked before the segments, and an empty EntityType matches any entityType
+// gen:qs
+type Override struct {
+	gorm.Model
+	FlagID     uint   `gorm:"index:idx_override_flagid"`
+	EntityType string `gorm:"type:varchar(255)"`
+	EntityID   string `gorm:"type:varchar(255)"`
+	VariantID  uint
+	ExpiresAt  *time.Time
+}
+
+type overrideKey struct {
+	entityType string
+	entityID   string
+}
+
+// Expired checks whether the override is expired at the time
+func (o *Override) Expired(now time.Time) bool {
+	return o.ExpiresAt != nil && !now.Before(*o.ExpiresAt)
+}
+
+// GetOverride gets the override of the entity that is not expired, the one of the
+// entityType takes precedence over the one of any entityType. It's nil if there's none
+func (f *Flag) GetOverride(entityType string, entityID string, now time.Time) *Override {
+	if len(f.FlagEvaluation.OverridesMap) == 0 {
+		return nil
+	}
+	for _, k := range []overrideKey{{entityType: entityType, entityID: entityID}, {entityID: entityID}} {
+		if o, ok := f.FlagEvaluation.OverridesMap[k]; ok && !o.Expired(now) {
+			return o
+		}
+	}
+	return nil
+}
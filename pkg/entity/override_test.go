@@ -0,0 +1,42 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlagGetOverride(t *testing.T) {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+
+	f := GenFixtureFlag()
+	f.Overrides = []Override{
+		{Model: gorm.Model{ID: 600}, EntityID: "qa_1", VariantID: 300},
+		{Model: gorm.Model{ID: 601}, EntityType: "user", EntityID: "qa_1", VariantID: 301},
+		{Model: gorm.Model{ID: 602}, EntityID: "qa_2", VariantID: 301, ExpiresAt: &past},
+		{Model: gorm.Model{ID: 603}, EntityID: "qa_3", VariantID: 301, ExpiresAt: &future},
+	}
+	f.PrepareEvaluation()
+
+	t.Run("entityType takes precedence", func(t *testing.T) {
+		assert.Equal(t, uint(601), f.GetOverride("user", "qa_1", now).ID)
+		assert.Equal(t, uint(600), f.GetOverride("company", "qa_1", now).ID)
+		assert.Equal(t, uint(600), f.GetOverride("", "qa_1", now).ID)
+	})
+
+	t.Run("expired overrides are skipped", func(t *testing.T) {
+		assert.Nil(t, f.GetOverride("user", "qa_2", now))
+		assert.Equal(t, uint(603), f.GetOverride("user", "qa_3", now).ID)
+		assert.Nil(t, f.GetOverride("user", "qa_3", future))
+	})
+
+	t.Run("no override", func(t *testing.T) {
+		assert.Nil(t, f.GetOverride("user", "qa_4", now))
+		fixture := GenFixtureFlag()
+		assert.Nil(t, fixture.GetOverride("user", "qa_1", now))
+	})
+}
@@ -3,6 +3,8 @@
 package entity
 
 import (
+	"fmt"
+
 	"github.com/jinzhu/gorm"
 	"github.com/zhouzhuojie/conditions"
 )
@@ -21,10 +23,30 @@ type Segment struct {
 	Constraints    ConstraintArray
 	Distributions  []Distribution
 
+	// ConstraintGroup combines the constraints with AND, OR and NOT, all the constraints
+	// are joined by AND if it's empty
+	ConstraintGroup ConstraintGroup `sql:"type:text"`
+
+	// RolloutBasisPoints is the rollout in 0.01%, it overrides RolloutPercent if it's not 0
+	RolloutBasisPoints uint
+
+	// AudienceID is the audience whose constraints the entities must match besides
+	// the constraints of the segment, and Audience is loaded for the evaluation
+	AudienceID uint      `gorm:"index:idx_segment_audienceid"`
+	Audience   *Audience `gorm:"-"`
+
 	// Purely for evaluation
 	SegmentEvaluation SegmentEvaluation `gorm:"-" json:"-"`
 }
 
+// RolloutInBasisPoints returns the rollout of the segment in 0.01%
+func (s *Segment) RolloutInBasisPoints() uint {
+	if s.RolloutBasisPoints != 0 {
+		return s.RolloutBasisPoints
+	}
+	return s.RolloutPercent * BasisPointsPerPercent
+}
+
 // Preload preloads the segment
 func (s *Segment) Preload(db *gorm.DB) error {
 	cs := []Constraint{}
@@ -44,13 +66,39 @@ func (s *Segment) Preload(db *gorm.DB) error {
 	}
 	s.Distributions = ds
 
+	if s.AudienceID != 0 {
+		a := &Audience{}
+		if err := NewAudienceQuerySet(db).IDEq(s.AudienceID).One(a); err != nil {
+			return err
+		}
+		if err := a.Preload(db); err != nil {
+			return err
+		}
+		s.Audience = a
+	}
 	return nil
 }
 
+// EvaluationConstraints returns the constraints of the audience of the segment followed
+// by the constraints of the segment itself
+func (s *Segment) EvaluationConstraints() (ConstraintArray, error) {
+	if s.AudienceID == 0 {
+		return s.Constraints, nil
+	}
+	if s.Audience == nil || s.Audience.ID != s.AudienceID {
+		return nil, fmt.Errorf("audience %v of segment %v is not loaded", s.AudienceID, s.ID)
+	}
+	cs := make(ConstraintArray, 0, len(s.Audience.Constraints)+len(s.Constraints))
+	cs = append(cs, s.Audience.Constraints...)
+	return append(cs, s.Constraints...), nil
+}
+
 // SegmentEvaluation is a struct that holds the necessary info for evaluation
 type SegmentEvaluation struct {
-	ConditionsExpr    conditions.Expr
-	DistributionArray DistributionArray
+	ConditionsExpr        conditions.Expr
+	ConditionsMatchers    map[string]ConstraintMatcher
+	ConditionsDescription string
+	DistributionArray     DistributionArray
 }
 
 // PrepareEvaluation prepares the segment for evaluation by parsing constraints
@@ -64,12 +112,22 @@ func (s *Segment) PrepareEvaluation() error {
 		},
 	}
 
-	if len(s.Constraints) != 0 {
-		expr, err := s.Constraints.ToExpr()
+	cs, err := s.EvaluationConstraints()
+	if err != nil {
+		return err
+	}
+	if len(cs) != 0 {
+		expr, desc, err := s.ConstraintGroup.ToExpr(cs)
+		if err != nil {
+			return err
+		}
+		matchers, err := cs.matchers()
 		if err != nil {
 			return err
 		}
 		se.ConditionsExpr = expr
+		se.ConditionsMatchers = matchers
+		se.ConditionsDescription = desc
 	}
 
 	for i, d := range s.Distributions {
@@ -3,6 +3,7 @@ package entity
 import (
 	"testing"
 
+	"github.com/jinzhu/gorm"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -23,6 +24,23 @@ func TestSegmentPrepareEvaluation(t *testing.T) {
 		assert.Empty(t, s.SegmentEvaluation.DistributionArray.VariantIDs)
 		assert.Empty(t, s.SegmentEvaluation.DistributionArray.PercentsAccumulated)
 	})
+
+	t.Run("audience constraints", func(t *testing.T) {
+		s := GenFixtureSegment()
+		s.AudienceID = 1
+		assert.Error(t, s.PrepareEvaluation())
+
+		s.Audience = &Audience{Model: gorm.Model{ID: 1}, Constraints: []Constraint{
+			{Property: "email", Operator: "EREG", Value: `"@example.com$"`},
+		}}
+		assert.NoError(t, s.PrepareEvaluation())
+		assert.Equal(t, `(email =~ "@example.com$") AND (dl_state == "CA")`, s.SegmentEvaluation.ConditionsExpr.String())
+
+		cs, err := s.EvaluationConstraints()
+		assert.NoError(t, err)
+		assert.Len(t, cs, 2)
+		assert.Len(t, s.Constraints, 1)
+	})
 }
 
 func TestSegmentPreload(t *testing.T) {
@@ -35,4 +53,22 @@ func TestSegmentPreload(t *testing.T) {
 		err := s.Preload(db)
 		assert.NoError(t, err)
 	})
+
+	t.Run("preloads the audience", func(t *testing.T) {
+		s := GenFixtureSegment()
+		f := GenFixtureFlag()
+		db := PopulateTestDB(f)
+		defer db.Close()
+
+		a := &Audience{Key: "employees", Constraints: []Constraint{
+			{Property: "email", Operator: "EREG", Value: `"@example.com$"`},
+		}}
+		assert.NoError(t, a.Create(db))
+		s.AudienceID = a.ID
+
+		assert.NoError(t, s.Preload(db))
+		assert.Equal(t, "employees", s.Audience.Key)
+		assert.Len(t, s.Audience.Constraints, 1)
+		assert.Len(t, s.Constraints, 1)
+	})
 }
@@ -0,0 +1,110 @@
+package entity
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// semver is a parsed semantic version, see https://semver.org. The build metadata is
+// dropped since it doesn't take part in the precedence
+type semver struct {
+	major, minor, patch uint64
+	prerelease          []string
+}
+
+// parseSemver parses a semantic version. The leading "v" and the missing minor or patch
+// version are tolerated, e.g. "v2.10" is parsed as 2.10.0
+func parseSemver(s string) (*semver, error) {
+	v := strings.TrimPrefix(strings.TrimSpace(s), "v")
+	if i := strings.Index(v, "+"); i >= 0 {
+		v = v[:i]
+	}
+
+	prerelease := ""
+	if i := strings.Index(v, "-"); i >= 0 {
+		v, prerelease = v[:i], v[i+1:]
+		if prerelease == "" {
+			return nil, fmt.Errorf("invalid semantic version %q: empty prerelease", s)
+		}
+	}
+
+	parts := strings.Split(v, ".")
+	if len(parts) > 3 {
+		return nil, fmt.Errorf("invalid semantic version %q: too many version numbers", s)
+	}
+	nums := make([]uint64, 3, 3)
+	for i, p := range parts {
+		n, err := strconv.ParseUint(p, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid semantic version %q: %q is not a version number", s, p)
+		}
+		nums[i] = n
+	}
+
+	sv := &semver{major: nums[0], minor: nums[1], patch: nums[2]}
+	if prerelease != "" {
+		sv.prerelease = strings.Split(prerelease, ".")
+		for _, id := range sv.prerelease {
+			if id == "" {
+				return nil, fmt.Errorf("invalid semantic version %q: empty prerelease identifier", s)
+			}
+		}
+	}
+	return sv, nil
+}
+
+// compare returns -1, 0 or 1 if v precedes, equals or follows o
+func (v *semver) compare(o *semver) int {
+	if c := compareUint(v.major, o.major); c != 0 {
+		return c
+	}
+	if c := compareUint(v.minor, o.minor); c != 0 {
+		return c
+	}
+	if c := compareUint(v.patch, o.patch); c != 0 {
+		return c
+	}
+
+	// a prerelease precedes its release
+	switch {
+	case len(v.prerelease) == 0 && len(o.prerelease) == 0:
+		return 0
+	case len(v.prerelease) == 0:
+		return 1
+	case len(o.prerelease) == 0:
+		return -1
+	}
+	for i := 0; i < len(v.prerelease) && i < len(o.prerelease); i++ {
+		if c := comparePrereleaseID(v.prerelease[i], o.prerelease[i]); c != 0 {
+			return c
+		}
+	}
+	return compareUint(uint64(len(v.prerelease)), uint64(len(o.prerelease)))
+}
+
+// comparePrereleaseID compares the numeric identifiers numerically, and the others in ASCII
+// order. The numeric identifiers precede the others
+func comparePrereleaseID(a, b string) int {
+	an, aerr := strconv.ParseUint(a, 10, 64)
+	bn, berr := strconv.ParseUint(b, 10, 64)
+	switch {
+	case aerr == nil && berr == nil:
+		return compareUint(an, bn)
+	case aerr == nil:
+		return -1
+	case berr == nil:
+		return 1
+	}
+	return strings.Compare(a, b)
+}
+
+func compareUint(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,46 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSemver(t *testing.T) {
+	for _, s := range []string{"1.2.3", "v1.2.3", "1.2", "1", "1.2.3-beta.1", "1.2.3+build.5", "1.2.3-rc.1+build.5"} {
+		_, err := parseSemver(s)
+		assert.NoError(t, err, s)
+	}
+	for _, s := range []string{"", "a.b.c", "1.2.3.4", "1.2.3-", "1.2.3-beta..1", "-1.2.3", "1..3"} {
+		_, err := parseSemver(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestSemverCompare(t *testing.T) {
+	// ordered by precedence, see https://semver.org/#spec-item-11
+	ordered := []string{
+		"1.0.0-alpha",
+		"1.0.0-alpha.1",
+		"1.0.0-alpha.beta",
+		"1.0.0-beta",
+		"1.0.0-beta.2",
+		"1.0.0-beta.11",
+		"1.0.0-rc.1",
+		"1.0.0",
+		"1.9.0",
+		"1.10.0",
+		"2.0.0",
+	}
+	for i := range ordered {
+		for j := range ordered {
+			a, _ := parseSemver(ordered[i])
+			b, _ := parseSemver(ordered[j])
+			assert.Equal(t, compareUint(uint64(i), uint64(j)), a.compare(b), "%s vs %s", ordered[i], ordered[j])
+		}
+	}
+
+	a, _ := parseSemver("v2.10")
+	b, _ := parseSemver("2.10.0+build.1")
+	assert.Equal(t, 0, a.compare(b))
+}
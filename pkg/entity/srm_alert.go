@@ -0,0 +1,59 @@
+//go:generate goqueryset -in srm_alert.go
+
+package entity
+
+import (
+	"github.com/jinzhu/gorm"
+)
+
+// SRMAlert marks that the SRM webhook has been sent for the current detection of a segment.
+// Every replica runs the SRMChecker, so the mark is shared in DB to send the webhook only once.
+// gen:qs
+type SRMAlert struct {
+	gorm.Model
+	FlagID    uint `gorm:"index:idx_srmalert_flagid"`
+	SegmentID uint `gorm:"unique_index:idx_srmalert_segmentid"`
+	Alerted   bool
+}
+
+// ClaimSRMAlert marks the segment as alerted and returns true if it was not, so that the caller
+// is the only one to send the webhook. It returns false if the segment is already alerted,
+// e.g. by another replica. The check and the mark are one conditional update
+func ClaimSRMAlert(db *gorm.DB, flagID uint, segmentID uint) (bool, error) {
+	q := db.Model(&SRMAlert{}).
+		Where("segment_id = ? AND alerted = ?", segmentID, false).
+		UpdateColumn("alerted", true)
+	if err := q.Error; err != nil {
+		return false, err
+	}
+	if q.RowsAffected > 0 {
+		return true, nil
+	}
+
+	n, err := NewSRMAlertQuerySet(db).SegmentIDEq(segmentID).Count()
+	if err != nil {
+		return false, err
+	}
+	if n > 0 {
+		return false, nil
+	}
+
+	a := &SRMAlert{FlagID: flagID, SegmentID: segmentID, Alerted: true}
+	if err := a.Create(db); err != nil {
+		// another replica created it in the meantime, the unique index rejects this one
+		if n, cerr := NewSRMAlertQuerySet(db).SegmentIDEq(segmentID).Count(); cerr == nil && n > 0 {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// ResetSRMAlert clears the alerted mark of the segment, e.g. the SRM is gone or the webhook
+// failed, so that the next detection sends the webhook again
+func ResetSRMAlert(db *gorm.DB, segmentID uint) error {
+	return db.Model(&SRMAlert{}).
+		Where("segment_id = ? AND alerted = ?", segmentID, true).
+		UpdateColumn("alerted", false).
+		Error
+}
@@ -0,0 +1,28 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClaimSRMAlert(t *testing.T) {
+	db := NewTestDB()
+	defer db.Close()
+
+	claimed, err := ClaimSRMAlert(db, 100, 200)
+	assert.NoError(t, err)
+	assert.True(t, claimed)
+
+	claimed, err = ClaimSRMAlert(db, 100, 200)
+	assert.NoError(t, err)
+	assert.False(t, claimed)
+
+	assert.NoError(t, ResetSRMAlert(db, 200))
+	claimed, err = ClaimSRMAlert(db, 100, 200)
+	assert.NoError(t, err)
+	assert.True(t, claimed)
+
+	n, _ := NewSRMAlertQuerySet(db).SegmentIDEq(200).Count()
+	assert.Equal(t, 1, n)
+}
@@ -0,0 +1,18 @@
+//go:generate goqueryset -in sticky_assignment.go
+
+package entity
+
+import (
+	"github.com/jinzhu/gorm"
+)
+
+// StickyAssignment is the stored variant assignment of an entity for a flag with
+// sticky assignments, it's reused as long as the entity stays in the same segment
+// gen:qs
+type StickyAssignment struct {
+	gorm.Model
+	FlagID    uint   `gorm:"unique_index:idx_stickyassignment_flagid_entityid"`
+	EntityID  string `gorm:"type:varchar(255);unique_index:idx_stickyassignment_flagid_entityid"`
+	SegmentID uint
+	VariantID uint
+}
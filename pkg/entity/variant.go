@@ -30,8 +30,8 @@ func (v *Variant) Validate() error {
 	return nil
 }
 
-// Attachment supports dynamic configuration in variant
-type Attachment map[string]string
+// Attachment supports dynamic configuration in variant, the values can be any JSON values
+type Attachment map[string]interface{}
 
 // Scan implements scanner interface
 func (a *Attachment) Scan(value interface{}) error {
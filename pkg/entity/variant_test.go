@@ -32,6 +32,19 @@ func TestVariantScan(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("typed values", func(t *testing.T) {
+		a := &Attachment{}
+		err := a.Scan([]byte(`{"key": "value", "n": 1.5, "b": true, "l": ["x"], "o": {"k": null}}`))
+		assert.NoError(t, err)
+		assert.Equal(t, &Attachment{
+			"key": "value",
+			"n":   1.5,
+			"b":   true,
+			"l":   []interface{}{"x"},
+			"o":   map[string]interface{}{"k": nil},
+		}, a)
+	})
+
 	t.Run("nil value", func(t *testing.T) {
 		a := &Attachment{}
 		err := a.Scan(nil)
@@ -0,0 +1,238 @@
+//go:generate goqueryset -in webhook.go
+
+package entity
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/checkr/flagr/pkg/config"
+	"github.com/checkr/flagr/pkg/util"
+	"github.com/jinzhu/gorm"
+)
+
+// The events of the flag changes that webhooks can subscribe to
+const (
+	FlagEventCreated  = "flag.created"
+	FlagEventUpdated  = "flag.updated"
+	FlagEventEnabled  = "flag.enabled"
+	FlagEventDisabled = "flag.disabled"
+	FlagEventDeleted  = "flag.deleted"
+)
+
+// FlagEvents are all the events of the flag changes
+var FlagEvents = []string{
+	FlagEventCreated,
+	FlagEventUpdated,
+	FlagEventEnabled,
+	FlagEventDisabled,
+	FlagEventDeleted,
+}
+
+// The formats of the webhook payloads
+const (
+	WebhookFormatJSON  = "json"  // the FlagEvent
+	WebhookFormatSlack = "slack" // the message of the FlagEvent for chat incoming webhooks
+)
+
+// Webhook is the subscription of an external URL to the flag changes
+// gen:qs
+type Webhook struct {
+	gorm.Model
+	URL      string `sql:"type:text"`
+	Secret   string
+	Events   string // comma separated events, empty means all the events
+	FlagKeys string `sql:"type:text"` // comma separated flag keys, empty means all the flags
+	Format   string
+	Enabled  bool
+}
+
+// EventList returns the subscribed events
+func (w *Webhook) EventList() []string {
+	return splitList(w.Events)
+}
+
+// SetEventList sets the subscribed events
+func (w *Webhook) SetEventList(events []string) {
+	w.Events = strings.Join(events, ",")
+}
+
+// FlagKeyList returns the subscribed flag keys
+func (w *Webhook) FlagKeyList() []string {
+	return splitList(w.FlagKeys)
+}
+
+// SetFlagKeyList sets the subscribed flag keys
+func (w *Webhook) SetFlagKeyList(flagKeys []string) {
+	w.FlagKeys = strings.Join(flagKeys, ",")
+}
+
+// Subscribes returns whether the webhook subscribes to the event of the flag
+func (w *Webhook) Subscribes(event string, flagKey string) bool {
+	return containsOrEmpty(w.EventList(), event) && containsOrEmpty(w.FlagKeyList(), flagKey)
+}
+
+// Payload renders the FlagEvent in the format of the webhook
+func (w *Webhook) Payload(e *FlagEvent) ([]byte, error) {
+	if w.Format == WebhookFormatSlack {
+		return json.Marshal(map[string]string{"text": e.Message})
+	}
+	return json.Marshal(e)
+}
+
+func splitList(s string) []string {
+	if s == "" {
+		return []string{}
+	}
+	return strings.Split(s, ",")
+}
+
+func containsOrEmpty(list []string, s string) bool {
+	if len(list) == 0 {
+		return true
+	}
+	for _, e := range list {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
+
+// IsFlagEvent returns whether the event is one of the FlagEvents
+func IsFlagEvent(event string) bool {
+	for _, e := range FlagEvents {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// FlagEvent is the JSON payload sent to the webhooks
+type FlagEvent struct {
+	Event          string   `json:"event"`
+	Actor          string   `json:"actor"`
+	FlagID         uint     `json:"flagID"`
+	FlagKey        string   `json:"flagKey"`
+	FlagSnapshotID uint     `json:"flagSnapshotID"`
+	Diff           []string `json:"diff"`    // the changed fields of the flag since the last snapshot
+	Message        string   `json:"message"` // the human-readable summary of the change
+	Timestamp      string   `json:"timestamp"`
+}
+
+// NewFlagEvent creates the FlagEvent by comparing the flag with its previous snapshot
+func NewFlagEvent(prev *FlagSnapshot, fs *FlagSnapshot, f *Flag) *FlagEvent {
+	e := &FlagEvent{
+		Event:          FlagEventCreated,
+		Actor:          fs.UpdatedBy,
+		FlagID:         f.ID,
+		FlagKey:        f.Key,
+		FlagSnapshotID: fs.ID,
+		Diff:           []string{},
+		Timestamp:      util.TimeNow(),
+	}
+	if prev == nil {
+		e.Message = describeFlagChange(e.Event, e.Actor, nil, f)
+		return e
+	}
+
+	e.Event = FlagEventUpdated
+	pf := &Flag{}
+	if err := json.Unmarshal(prev.Flag, pf); err != nil {
+		pf = nil
+	} else if !pf.Enabled && f.Enabled {
+		e.Event = FlagEventEnabled
+	} else if pf.Enabled && !f.Enabled {
+		e.Event = FlagEventDisabled
+	}
+	e.Diff = diffFlagSnapshots(prev.Flag, fs.Flag)
+	e.Message = describeFlagChange(e.Event, e.Actor, pf, f)
+	return e
+}
+
+// NewFlagDeletedEvent creates the FlagEvent of the deleted flag
+func NewFlagDeletedEvent(f *Flag, actor string) *FlagEvent {
+	return &FlagEvent{
+		Event:          FlagEventDeleted,
+		Actor:          actor,
+		FlagID:         f.ID,
+		FlagKey:        f.Key,
+		FlagSnapshotID: f.SnapshotID,
+		Diff:           []string{},
+		Message:        describeFlagChange(FlagEventDeleted, actor, nil, f),
+		Timestamp:      util.TimeNow(),
+	}
+}
+
+// the bookkeeping fields that change on every snapshot
+var ignoredDiffFields = map[string]bool{
+	"ID":        true,
+	"CreatedAt": true,
+	"UpdatedAt": true,
+	"DeletedAt": true,
+	"CreatedBy": true,
+	"UpdatedBy": true,
+}
+
+// diffFlagSnapshots returns the top level fields of the flag JSON that differ, e.g. ["description", "segments"]
+func diffFlagSnapshots(prev []byte, cur []byte) []string {
+	pm := make(map[string]json.RawMessage)
+	cm := make(map[string]json.RawMessage)
+	json.Unmarshal(prev, &pm)
+	json.Unmarshal(cur, &cm)
+
+	keys := make(map[string]bool)
+	for k := range pm {
+		keys[k] = true
+	}
+	for k := range cm {
+		keys[k] = true
+	}
+
+	diff := []string{}
+	for k := range keys {
+		if ignoredDiffFields[k] || string(pm[k]) == string(cm[k]) {
+			continue
+		}
+		diff = append(diff, strings.ToLower(k[:1])+k[1:])
+	}
+	sort.Strings(diff)
+	return diff
+}
+
+// EnqueueFlagEvent writes one pending WebhookDelivery into the outbox for every enabled webhook
+// that subscribes to the event. Pass the transaction of the flag change to make it atomic.
+func EnqueueFlagEvent(db *gorm.DB, e *FlagEvent) error {
+	if !config.Config.WebhookEnabled {
+		return nil
+	}
+
+	ws := []Webhook{}
+	if err := NewWebhookQuerySet(db).EnabledEq(true).All(&ws); err != nil {
+		return err
+	}
+
+	for _, w := range ws {
+		if !w.Subscribes(e.Event, e.FlagKey) {
+			continue
+		}
+		payload, err := w.Payload(e)
+		if err != nil {
+			return err
+		}
+		d := &WebhookDelivery{
+			WebhookID:     w.ID,
+			Event:         e.Event,
+			Payload:       payload,
+			Status:        WebhookDeliveryStatusPending,
+			NextAttemptAt: time.Now(),
+		}
+		if err := d.Create(db); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+//go:generate goqueryset -in webhook_delivery.go
+
+package entity
+
+import (
+	"time"
+
+	"github.com/jinzhu/gorm"
+)
+
+// The statuses of the WebhookDelivery
+const (
+	WebhookDeliveryStatusPending   = "pending"
+	WebhookDeliveryStatusSucceeded = "succeeded"
+	WebhookDeliveryStatusFailed    = "failed"
+)
+
+// WebhookDelivery is the outbox of the webhook payloads, and also the log of the deliveries.
+// Pending deliveries are retried with backoff until they succeed or run out of attempts.
+// gen:qs
+type WebhookDelivery struct {
+	gorm.Model
+	WebhookID     uint `gorm:"index:idx_webhookdelivery_webhookid"`
+	Event         string
+	Payload       []byte `sql:"type:text"`
+	Status        string `gorm:"index:idx_webhookdelivery_status_nextattemptat"`
+	Attempts      uint
+	NextAttemptAt time.Time `gorm:"index:idx_webhookdelivery_status_nextattemptat"`
+	ResponseCode  int
+	LastError     string `sql:"type:text"`
+}
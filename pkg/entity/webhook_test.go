@@ -0,0 +1,114 @@
+package entity
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/checkr/flagr/pkg/config"
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookSubscribes(t *testing.T) {
+	w := &Webhook{}
+	assert.True(t, w.Subscribes(FlagEventCreated, "flag_key_100"))
+	assert.Equal(t, []string{}, w.EventList())
+	assert.Equal(t, []string{}, w.FlagKeyList())
+
+	w.SetEventList([]string{FlagEventEnabled, FlagEventDisabled})
+	assert.Equal(t, "flag.enabled,flag.disabled", w.Events)
+	assert.True(t, w.Subscribes(FlagEventDisabled, "flag_key_100"))
+	assert.False(t, w.Subscribes(FlagEventUpdated, "flag_key_100"))
+
+	w.SetFlagKeyList([]string{"flag_key_100", "flag_key_101"})
+	assert.True(t, w.Subscribes(FlagEventDisabled, "flag_key_101"))
+	assert.False(t, w.Subscribes(FlagEventDisabled, "flag_key_102"))
+
+	assert.True(t, IsFlagEvent(FlagEventDeleted))
+	assert.False(t, IsFlagEvent("flag.unknown"))
+}
+
+func TestWebhookPayload(t *testing.T) {
+	e := &FlagEvent{Event: FlagEventEnabled, FlagKey: "flag_key_100", Message: "alice enabled `flag_key_100`"}
+
+	b, err := (&Webhook{Format: WebhookFormatJSON}).Payload(e)
+	assert.NoError(t, err)
+	assert.Contains(t, string(b), `"event":"flag.enabled"`)
+
+	b, err = (&Webhook{Format: WebhookFormatSlack}).Payload(e)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"text": "alice enabled `+"`flag_key_100`"+`"}`, string(b))
+}
+
+func TestNewFlagEvent(t *testing.T) {
+	f := GenFixtureFlag()
+	b, _ := json.Marshal(f)
+	fs := &FlagSnapshot{FlagID: f.ID, UpdatedBy: "flagr-test@example.com", Flag: b}
+
+	t.Run("created", func(t *testing.T) {
+		e := NewFlagEvent(nil, fs, &f)
+		assert.Equal(t, FlagEventCreated, e.Event)
+		assert.Equal(t, "flagr-test@example.com created `flag_key_100`", e.Message)
+		assert.Equal(t, "flagr-test@example.com", e.Actor)
+		assert.Equal(t, "flag_key_100", e.FlagKey)
+		assert.Empty(t, e.Diff)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		cur := GenFixtureFlag()
+		cur.Enabled = false
+		cur.Description = "new description"
+		cb, _ := json.Marshal(cur)
+		e := NewFlagEvent(fs, &FlagSnapshot{UpdatedBy: "flagr-test@example.com", Flag: cb}, &cur)
+		assert.Equal(t, FlagEventDisabled, e.Event)
+		assert.Equal(t, []string{"description", "enabled"}, e.Diff)
+		assert.Equal(t, "flagr-test@example.com disabled `flag_key_100` (description changed)", e.Message)
+	})
+
+	t.Run("updated", func(t *testing.T) {
+		cur := GenFixtureFlag()
+		cur.Variants = cur.Variants[:1]
+		cb, _ := json.Marshal(cur)
+		e := NewFlagEvent(fs, &FlagSnapshot{UpdatedBy: "flagr-test@example.com", Flag: cb}, &cur)
+		assert.Equal(t, FlagEventUpdated, e.Event)
+		assert.Equal(t, []string{"variants"}, e.Diff)
+		assert.Equal(t, "flagr-test@example.com updated `flag_key_100` (removed variant 'treatment')", e.Message)
+	})
+}
+
+func TestEnqueueFlagEvent(t *testing.T) {
+	f := GenFixtureFlag()
+	db := PopulateTestDB(f)
+	defer db.Close()
+
+	db.Create(&Webhook{URL: "http://example.com/all", Enabled: true})
+	db.Create(&Webhook{URL: "http://example.com/enabled", Events: FlagEventEnabled, Enabled: true})
+	db.Create(&Webhook{URL: "http://example.com/disabled", Enabled: false})
+	db.Create(&Webhook{URL: "http://example.com/other", FlagKeys: "other_flag_key", Enabled: true})
+
+	t.Run("webhook not enabled globally", func(t *testing.T) {
+		SaveFlagSnapshot(db, f.ID, "flagr-test@example.com")
+		cnt, _ := NewWebhookDeliveryQuerySet(db).Count()
+		assert.Equal(t, 0, cnt)
+	})
+
+	t.Run("webhook enabled globally", func(t *testing.T) {
+		defer gostub.Stub(&config.Config.WebhookEnabled, true).Reset()
+
+		SaveFlagSnapshot(db, f.ID, "flagr-test@example.com")
+		ds := []WebhookDelivery{}
+		NewWebhookDeliveryQuerySet(db).All(&ds)
+		assert.Len(t, ds, 1)
+		assert.Equal(t, FlagEventUpdated, ds[0].Event)
+		assert.Equal(t, WebhookDeliveryStatusPending, ds[0].Status)
+
+		e := &FlagEvent{}
+		assert.NoError(t, json.Unmarshal(ds[0].Payload, e))
+		assert.Equal(t, f.ID, e.FlagID)
+		assert.NotZero(t, e.FlagSnapshotID)
+
+		assert.NoError(t, EnqueueFlagEvent(db, &FlagEvent{Event: FlagEventEnabled, FlagKey: f.Key}))
+		cnt, _ := NewWebhookDeliveryQuerySet(db).Count()
+		assert.Equal(t, 3, cnt)
+	})
+}
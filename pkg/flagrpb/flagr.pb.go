@@ -0,0 +1,614 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: flagr.proto
+
+package flagrpb
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _struct "github.com/golang/protobuf/ptypes/struct"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type EvalContext struct {
+	EntityId      string          `protobuf:"bytes,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	EntityType    string          `protobuf:"bytes,2,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	EntityContext *_struct.Struct `protobuf:"bytes,3,opt,name=entity_context,json=entityContext,proto3" json:"entity_context,omitempty"`
+	EnableDebug   bool            `protobuf:"varint,4,opt,name=enable_debug,json=enableDebug,proto3" json:"enable_debug,omitempty"`
+	// flag_id takes precedence over flag_key if both are set
+	FlagId               int64    `protobuf:"varint,5,opt,name=flag_id,json=flagId,proto3" json:"flag_id,omitempty"`
+	FlagKey              string   `protobuf:"bytes,6,opt,name=flag_key,json=flagKey,proto3" json:"flag_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EvalContext) Reset()         { *m = EvalContext{} }
+func (m *EvalContext) String() string { return proto.CompactTextString(m) }
+func (*EvalContext) ProtoMessage()    {}
+func (*EvalContext) Descriptor() ([]byte, []int) {
+	return fileDescriptor_flagr_84852dac86e728c9, []int{0}
+}
+func (m *EvalContext) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvalContext.Unmarshal(m, b)
+}
+func (m *EvalContext) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvalContext.Marshal(b, m, deterministic)
+}
+func (dst *EvalContext) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvalContext.Merge(dst, src)
+}
+func (m *EvalContext) XXX_Size() int {
+	return xxx_messageInfo_EvalContext.Size(m)
+}
+func (m *EvalContext) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvalContext.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvalContext proto.InternalMessageInfo
+
+func (m *EvalContext) GetEntityId() string {
+	if m != nil {
+		return m.EntityId
+	}
+	return ""
+}
+
+func (m *EvalContext) GetEntityType() string {
+	if m != nil {
+		return m.EntityType
+	}
+	return ""
+}
+
+func (m *EvalContext) GetEntityContext() *_struct.Struct {
+	if m != nil {
+		return m.EntityContext
+	}
+	return nil
+}
+
+func (m *EvalContext) GetEnableDebug() bool {
+	if m != nil {
+		return m.EnableDebug
+	}
+	return false
+}
+
+func (m *EvalContext) GetFlagId() int64 {
+	if m != nil {
+		return m.FlagId
+	}
+	return 0
+}
+
+func (m *EvalContext) GetFlagKey() string {
+	if m != nil {
+		return m.FlagKey
+	}
+	return ""
+}
+
+type EvalResult struct {
+	FlagId         int64  `protobuf:"varint,1,opt,name=flag_id,json=flagId,proto3" json:"flag_id,omitempty"`
+	FlagKey        string `protobuf:"bytes,2,opt,name=flag_key,json=flagKey,proto3" json:"flag_key,omitempty"`
+	FlagSnapshotId int64  `protobuf:"varint,3,opt,name=flag_snapshot_id,json=flagSnapshotId,proto3" json:"flag_snapshot_id,omitempty"`
+	// segment_id is 0 if no segment matched
+	SegmentId int64 `protobuf:"varint,4,opt,name=segment_id,json=segmentId,proto3" json:"segment_id,omitempty"`
+	// variant_id is 0 and variant_key is empty if no variant is assigned
+	VariantId            int64           `protobuf:"varint,5,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	VariantKey           string          `protobuf:"bytes,6,opt,name=variant_key,json=variantKey,proto3" json:"variant_key,omitempty"`
+	VariantAttachment    *_struct.Struct `protobuf:"bytes,7,opt,name=variant_attachment,json=variantAttachment,proto3" json:"variant_attachment,omitempty"`
+	EvalContext          *EvalContext    `protobuf:"bytes,8,opt,name=eval_context,json=evalContext,proto3" json:"eval_context,omitempty"`
+	Timestamp            string          `protobuf:"bytes,9,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	EvalDebugLog         *EvalDebugLog   `protobuf:"bytes,10,opt,name=eval_debug_log,json=evalDebugLog,proto3" json:"eval_debug_log,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *EvalResult) Reset()         { *m = EvalResult{} }
+func (m *EvalResult) String() string { return proto.CompactTextString(m) }
+func (*EvalResult) ProtoMessage()    {}
+func (*EvalResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_flagr_84852dac86e728c9, []int{1}
+}
+func (m *EvalResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvalResult.Unmarshal(m, b)
+}
+func (m *EvalResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvalResult.Marshal(b, m, deterministic)
+}
+func (dst *EvalResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvalResult.Merge(dst, src)
+}
+func (m *EvalResult) XXX_Size() int {
+	return xxx_messageInfo_EvalResult.Size(m)
+}
+func (m *EvalResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvalResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvalResult proto.InternalMessageInfo
+
+func (m *EvalResult) GetFlagId() int64 {
+	if m != nil {
+		return m.FlagId
+	}
+	return 0
+}
+
+func (m *EvalResult) GetFlagKey() string {
+	if m != nil {
+		return m.FlagKey
+	}
+	return ""
+}
+
+func (m *EvalResult) GetFlagSnapshotId() int64 {
+	if m != nil {
+		return m.FlagSnapshotId
+	}
+	return 0
+}
+
+func (m *EvalResult) GetSegmentId() int64 {
+	if m != nil {
+		return m.SegmentId
+	}
+	return 0
+}
+
+func (m *EvalResult) GetVariantId() int64 {
+	if m != nil {
+		return m.VariantId
+	}
+	return 0
+}
+
+func (m *EvalResult) GetVariantKey() string {
+	if m != nil {
+		return m.VariantKey
+	}
+	return ""
+}
+
+func (m *EvalResult) GetVariantAttachment() *_struct.Struct {
+	if m != nil {
+		return m.VariantAttachment
+	}
+	return nil
+}
+
+func (m *EvalResult) GetEvalContext() *EvalContext {
+	if m != nil {
+		return m.EvalContext
+	}
+	return nil
+}
+
+func (m *EvalResult) GetTimestamp() string {
+	if m != nil {
+		return m.Timestamp
+	}
+	return ""
+}
+
+func (m *EvalResult) GetEvalDebugLog() *EvalDebugLog {
+	if m != nil {
+		return m.EvalDebugLog
+	}
+	return nil
+}
+
+type EvalDebugLog struct {
+	Msg                  string             `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	SegmentDebugLogs     []*SegmentDebugLog `protobuf:"bytes,2,rep,name=segment_debug_logs,json=segmentDebugLogs,proto3" json:"segment_debug_logs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *EvalDebugLog) Reset()         { *m = EvalDebugLog{} }
+func (m *EvalDebugLog) String() string { return proto.CompactTextString(m) }
+func (*EvalDebugLog) ProtoMessage()    {}
+func (*EvalDebugLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_flagr_84852dac86e728c9, []int{2}
+}
+func (m *EvalDebugLog) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvalDebugLog.Unmarshal(m, b)
+}
+func (m *EvalDebugLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvalDebugLog.Marshal(b, m, deterministic)
+}
+func (dst *EvalDebugLog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvalDebugLog.Merge(dst, src)
+}
+func (m *EvalDebugLog) XXX_Size() int {
+	return xxx_messageInfo_EvalDebugLog.Size(m)
+}
+func (m *EvalDebugLog) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvalDebugLog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvalDebugLog proto.InternalMessageInfo
+
+func (m *EvalDebugLog) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
+func (m *EvalDebugLog) GetSegmentDebugLogs() []*SegmentDebugLog {
+	if m != nil {
+		return m.SegmentDebugLogs
+	}
+	return nil
+}
+
+type SegmentDebugLog struct {
+	SegmentId            int64    `protobuf:"varint,1,opt,name=segment_id,json=segmentId,proto3" json:"segment_id,omitempty"`
+	Msg                  string   `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SegmentDebugLog) Reset()         { *m = SegmentDebugLog{} }
+func (m *SegmentDebugLog) String() string { return proto.CompactTextString(m) }
+func (*SegmentDebugLog) ProtoMessage()    {}
+func (*SegmentDebugLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_flagr_84852dac86e728c9, []int{3}
+}
+func (m *SegmentDebugLog) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentDebugLog.Unmarshal(m, b)
+}
+func (m *SegmentDebugLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentDebugLog.Marshal(b, m, deterministic)
+}
+func (dst *SegmentDebugLog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentDebugLog.Merge(dst, src)
+}
+func (m *SegmentDebugLog) XXX_Size() int {
+	return xxx_messageInfo_SegmentDebugLog.Size(m)
+}
+func (m *SegmentDebugLog) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentDebugLog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentDebugLog proto.InternalMessageInfo
+
+func (m *SegmentDebugLog) GetSegmentId() int64 {
+	if m != nil {
+		return m.SegmentId
+	}
+	return 0
+}
+
+func (m *SegmentDebugLog) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
+type EvaluationEntity struct {
+	EntityId             string          `protobuf:"bytes,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	EntityType           string          `protobuf:"bytes,2,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	EntityContext        *_struct.Struct `protobuf:"bytes,3,opt,name=entity_context,json=entityContext,proto3" json:"entity_context,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *EvaluationEntity) Reset()         { *m = EvaluationEntity{} }
+func (m *EvaluationEntity) String() string { return proto.CompactTextString(m) }
+func (*EvaluationEntity) ProtoMessage()    {}
+func (*EvaluationEntity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_flagr_84852dac86e728c9, []int{4}
+}
+func (m *EvaluationEntity) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvaluationEntity.Unmarshal(m, b)
+}
+func (m *EvaluationEntity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvaluationEntity.Marshal(b, m, deterministic)
+}
+func (dst *EvaluationEntity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvaluationEntity.Merge(dst, src)
+}
+func (m *EvaluationEntity) XXX_Size() int {
+	return xxx_messageInfo_EvaluationEntity.Size(m)
+}
+func (m *EvaluationEntity) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvaluationEntity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvaluationEntity proto.InternalMessageInfo
+
+func (m *EvaluationEntity) GetEntityId() string {
+	if m != nil {
+		return m.EntityId
+	}
+	return ""
+}
+
+func (m *EvaluationEntity) GetEntityType() string {
+	if m != nil {
+		return m.EntityType
+	}
+	return ""
+}
+
+func (m *EvaluationEntity) GetEntityContext() *_struct.Struct {
+	if m != nil {
+		return m.EntityContext
+	}
+	return nil
+}
+
+type EvaluationBatchRequest struct {
+	Entities             []*EvaluationEntity `protobuf:"bytes,1,rep,name=entities,proto3" json:"entities,omitempty"`
+	EnableDebug          bool                `protobuf:"varint,2,opt,name=enable_debug,json=enableDebug,proto3" json:"enable_debug,omitempty"`
+	FlagIds              []int64             `protobuf:"varint,3,rep,packed,name=flag_ids,json=flagIds,proto3" json:"flag_ids,omitempty"`
+	FlagKeys             []string            `protobuf:"bytes,4,rep,name=flag_keys,json=flagKeys,proto3" json:"flag_keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *EvaluationBatchRequest) Reset()         { *m = EvaluationBatchRequest{} }
+func (m *EvaluationBatchRequest) String() string { return proto.CompactTextString(m) }
+func (*EvaluationBatchRequest) ProtoMessage()    {}
+func (*EvaluationBatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_flagr_84852dac86e728c9, []int{5}
+}
+func (m *EvaluationBatchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvaluationBatchRequest.Unmarshal(m, b)
+}
+func (m *EvaluationBatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvaluationBatchRequest.Marshal(b, m, deterministic)
+}
+func (dst *EvaluationBatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvaluationBatchRequest.Merge(dst, src)
+}
+func (m *EvaluationBatchRequest) XXX_Size() int {
+	return xxx_messageInfo_EvaluationBatchRequest.Size(m)
+}
+func (m *EvaluationBatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvaluationBatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvaluationBatchRequest proto.InternalMessageInfo
+
+func (m *EvaluationBatchRequest) GetEntities() []*EvaluationEntity {
+	if m != nil {
+		return m.Entities
+	}
+	return nil
+}
+
+func (m *EvaluationBatchRequest) GetEnableDebug() bool {
+	if m != nil {
+		return m.EnableDebug
+	}
+	return false
+}
+
+func (m *EvaluationBatchRequest) GetFlagIds() []int64 {
+	if m != nil {
+		return m.FlagIds
+	}
+	return nil
+}
+
+func (m *EvaluationBatchRequest) GetFlagKeys() []string {
+	if m != nil {
+		return m.FlagKeys
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*EvalContext)(nil), "flagr.EvalContext")
+	proto.RegisterType((*EvalResult)(nil), "flagr.EvalResult")
+	proto.RegisterType((*EvalDebugLog)(nil), "flagr.EvalDebugLog")
+	proto.RegisterType((*SegmentDebugLog)(nil), "flagr.SegmentDebugLog")
+	proto.RegisterType((*EvaluationEntity)(nil), "flagr.EvaluationEntity")
+	proto.RegisterType((*EvaluationBatchRequest)(nil), "flagr.EvaluationBatchRequest")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// EvaluationClient is the client API for Evaluation service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type EvaluationClient interface {
+	// Evaluate evaluates a flag for an entity
+	Evaluate(ctx context.Context, in *EvalContext, opts ...grpc.CallOption) (*EvalResult, error)
+	// EvaluateBatch evaluates the flags for the entities, and streams the
+	// results entity by entity, in the order of the flags
+	EvaluateBatch(ctx context.Context, in *EvaluationBatchRequest, opts ...grpc.CallOption) (Evaluation_EvaluateBatchClient, error)
+}
+
+type evaluationClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewEvaluationClient(cc *grpc.ClientConn) EvaluationClient {
+	return &evaluationClient{cc}
+}
+
+func (c *evaluationClient) Evaluate(ctx context.Context, in *EvalContext, opts ...grpc.CallOption) (*EvalResult, error) {
+	out := new(EvalResult)
+	err := c.cc.Invoke(ctx, "/flagr.Evaluation/Evaluate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *evaluationClient) EvaluateBatch(ctx context.Context, in *EvaluationBatchRequest, opts ...grpc.CallOption) (Evaluation_EvaluateBatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Evaluation_serviceDesc.Streams[0], "/flagr.Evaluation/EvaluateBatch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &evaluationEvaluateBatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Evaluation_EvaluateBatchClient interface {
+	Recv() (*EvalResult, error)
+	grpc.ClientStream
+}
+
+type evaluationEvaluateBatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *evaluationEvaluateBatchClient) Recv() (*EvalResult, error) {
+	m := new(EvalResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// EvaluationServer is the server API for Evaluation service.
+type EvaluationServer interface {
+	// Evaluate evaluates a flag for an entity
+	Evaluate(context.Context, *EvalContext) (*EvalResult, error)
+	// EvaluateBatch evaluates the flags for the entities, and streams the
+	// results entity by entity, in the order of the flags
+	EvaluateBatch(*EvaluationBatchRequest, Evaluation_EvaluateBatchServer) error
+}
+
+func RegisterEvaluationServer(s *grpc.Server, srv EvaluationServer) {
+	s.RegisterService(&_Evaluation_serviceDesc, srv)
+}
+
+func _Evaluation_Evaluate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvalContext)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EvaluationServer).Evaluate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/flagr.Evaluation/Evaluate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EvaluationServer).Evaluate(ctx, req.(*EvalContext))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Evaluation_EvaluateBatch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EvaluationBatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EvaluationServer).EvaluateBatch(m, &evaluationEvaluateBatchServer{stream})
+}
+
+type Evaluation_EvaluateBatchServer interface {
+	Send(*EvalResult) error
+	grpc.ServerStream
+}
+
+type evaluationEvaluateBatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *evaluationEvaluateBatchServer) Send(m *EvalResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _Evaluation_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "flagr.Evaluation",
+	HandlerType: (*EvaluationServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Evaluate",
+			Handler:    _Evaluation_Evaluate_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "EvaluateBatch",
+			Handler:       _Evaluation_EvaluateBatch_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "flagr.proto",
+}
+
+func init() { proto.RegisterFile("flagr.proto", fileDescriptor_flagr_84852dac86e728c9) }
+
+var fileDescriptor_flagr_84852dac86e728c9 = []byte{
+	// 576 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x53, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0xd5, 0xc4, 0x6d, 0x1a, 0x5f, 0xa7, 0xf9, 0xd2, 0xf9, 0xa4, 0x66, 0x28, 0xad, 0x30, 0x5e,
+	0x79, 0x95, 0x42, 0x2a, 0x16, 0x6c, 0x90, 0x48, 0x5b, 0xa4, 0x08, 0x56, 0x0e, 0x2b, 0x36, 0xd1,
+	0x24, 0x9e, 0x38, 0x16, 0x8e, 0x6d, 0x32, 0xe3, 0x88, 0xec, 0x79, 0x00, 0x5e, 0x81, 0x1d, 0xcf,
+	0xc5, 0x93, 0xa0, 0xf9, 0xf1, 0x4f, 0x93, 0xc2, 0x96, 0x9d, 0xe7, 0xdc, 0x73, 0x8f, 0xcf, 0xfd,
+	0x03, 0x67, 0x99, 0xd0, 0x68, 0x33, 0xcc, 0x37, 0x99, 0xc8, 0xf0, 0xb1, 0x7a, 0x5c, 0x5c, 0x46,
+	0x59, 0x16, 0x25, 0xec, 0x5a, 0x81, 0xf3, 0x62, 0x79, 0xcd, 0xc5, 0xa6, 0x58, 0x08, 0x4d, 0xf2,
+	0x7e, 0x21, 0x70, 0xee, 0xb7, 0x34, 0xb9, 0xcd, 0x52, 0xc1, 0xbe, 0x0a, 0xfc, 0x14, 0x6c, 0x96,
+	0x8a, 0x58, 0xec, 0x66, 0x71, 0x48, 0x90, 0x8b, 0x7c, 0x3b, 0xe8, 0x68, 0x60, 0x12, 0xe2, 0x67,
+	0xe0, 0x98, 0xa0, 0xd8, 0xe5, 0x8c, 0xb4, 0x54, 0x18, 0x34, 0xf4, 0x71, 0x97, 0x33, 0xfc, 0x06,
+	0x7a, 0x86, 0xb0, 0xd0, 0x7a, 0xc4, 0x72, 0x91, 0xef, 0x8c, 0x06, 0x43, 0x6d, 0x62, 0x58, 0x9a,
+	0x18, 0x4e, 0x95, 0x89, 0xe0, 0x54, 0xd3, 0xcb, 0xbf, 0x3f, 0x87, 0x2e, 0x4b, 0xe9, 0x3c, 0x61,
+	0xb3, 0x90, 0xcd, 0x8b, 0x88, 0x1c, 0xb9, 0xc8, 0xef, 0x04, 0x8e, 0xc6, 0xee, 0x24, 0x84, 0x07,
+	0x70, 0x22, 0xeb, 0x92, 0xf6, 0x8e, 0x5d, 0xe4, 0x5b, 0x41, 0x5b, 0x3e, 0x27, 0x21, 0x7e, 0x02,
+	0x1d, 0x15, 0xf8, 0xcc, 0x76, 0xa4, 0xad, 0x9c, 0x29, 0xe2, 0x7b, 0xb6, 0xf3, 0x7e, 0x58, 0x00,
+	0xb2, 0xc8, 0x80, 0xf1, 0x22, 0x11, 0x4d, 0x09, 0xf4, 0x47, 0x89, 0xd6, 0x03, 0x09, 0xec, 0x43,
+	0x5f, 0x85, 0x78, 0x4a, 0x73, 0xbe, 0xca, 0x84, 0x4c, 0xb6, 0x54, 0x72, 0x4f, 0xe2, 0x53, 0x03,
+	0x4f, 0x42, 0x7c, 0x05, 0xc0, 0x59, 0xb4, 0x66, 0xa9, 0xe2, 0x1c, 0x29, 0x8e, 0x6d, 0x10, 0x1d,
+	0xde, 0xd2, 0x4d, 0x4c, 0x53, 0x51, 0x97, 0x60, 0x1b, 0x44, 0xb7, 0xb8, 0x0c, 0xd7, 0x85, 0x94,
+	0x19, 0xd2, 0xc8, 0x3b, 0xc0, 0x25, 0x81, 0x0a, 0x41, 0x17, 0x2b, 0xa9, 0x4b, 0x4e, 0xfe, 0xde,
+	0xe6, 0x33, 0x93, 0xf2, 0xb6, 0xca, 0xc0, 0xaf, 0xa0, 0xcb, 0xb6, 0x34, 0xa9, 0x06, 0xd5, 0x51,
+	0x0a, 0x78, 0xa8, 0x37, 0xa8, 0xb1, 0x12, 0x81, 0xc3, 0xea, 0x07, 0xbe, 0x04, 0x5b, 0xc4, 0x6b,
+	0xc6, 0x05, 0x5d, 0xe7, 0xc4, 0x56, 0xee, 0x6a, 0x00, 0xbf, 0x86, 0x9e, 0x12, 0x55, 0xd3, 0x9b,
+	0x25, 0x59, 0x44, 0x40, 0xc9, 0xfe, 0xdf, 0x90, 0x55, 0x63, 0xfc, 0x90, 0x45, 0x41, 0x97, 0x35,
+	0x5e, 0xde, 0x12, 0xba, 0xcd, 0x28, 0xee, 0x83, 0xb5, 0xe6, 0x91, 0x59, 0x41, 0xf9, 0x89, 0xef,
+	0x00, 0x97, 0x8d, 0xad, 0xf4, 0x39, 0x69, 0xb9, 0x96, 0xef, 0x8c, 0xce, 0xcd, 0x0f, 0xa6, 0x9a,
+	0x50, 0xfd, 0xa3, 0xcf, 0x1f, 0x02, 0xdc, 0x1b, 0xc3, 0x7f, 0x7b, 0xa4, 0xbd, 0x89, 0xa1, 0xfd,
+	0x89, 0x19, 0x27, 0xad, 0xca, 0x89, 0xf7, 0x1d, 0x41, 0x5f, 0x9a, 0x2d, 0xa8, 0x88, 0xb3, 0xf4,
+	0x5e, 0xad, 0xf0, 0xbf, 0xbd, 0x1c, 0xef, 0x27, 0x82, 0xf3, 0xda, 0xd2, 0x98, 0x8a, 0xc5, 0x2a,
+	0x60, 0x5f, 0x0a, 0xc6, 0x05, 0xbe, 0x01, 0xed, 0x23, 0x66, 0x9c, 0x20, 0xd5, 0xad, 0x41, 0x63,
+	0x1c, 0xcd, 0x1a, 0x82, 0x8a, 0x78, 0x70, 0x89, 0xad, 0xc3, 0x4b, 0x2c, 0xaf, 0x25, 0x0e, 0x39,
+	0xb1, 0x5c, 0xcb, 0xb7, 0xf4, 0xb5, 0x4c, 0x42, 0x2e, 0x7b, 0x51, 0x1e, 0x12, 0x27, 0x47, 0xae,
+	0x25, 0x7b, 0x61, 0x2e, 0x89, 0x8f, 0xbe, 0x21, 0x80, 0xfa, 0xcf, 0xf8, 0x25, 0x74, 0xcc, 0x8b,
+	0xe1, 0x47, 0xd6, 0xef, 0xe2, 0xac, 0x81, 0x99, 0x03, 0xbe, 0x85, 0xd3, 0x32, 0x45, 0x55, 0x8a,
+	0xaf, 0x0e, 0x0a, 0x6a, 0x76, 0xe0, 0x11, 0x89, 0x17, 0x68, 0x6c, 0x7f, 0x52, 0x76, 0x37, 0xf9,
+	0x7c, 0xde, 0x56, 0xcd, 0xbd, 0xf9, 0x3d, 0x00, 0x82, 0x9b, 0xdf, 0x18, 0x3f, 0x05, 0x00, 0x00,
+}
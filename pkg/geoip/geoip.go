@@ -0,0 +1,91 @@
+// Package geoip looks up the locations of IP addresses in a MaxMind DB file, e.g. the
+// GeoLite2 City or GeoIP2 City databases
+package geoip
+
+import (
+	"io/ioutil"
+	"net"
+
+	"github.com/oschwald/maxminddb-golang"
+)
+
+// DB is a GeoIP database loaded in memory, it's safe for concurrent use
+type DB struct {
+	reader *maxminddb.Reader
+}
+
+// Location is the location of an IP address. The fields the database doesn't have are empty
+type Location struct {
+	// Country is the ISO 3166-1 code of the country, e.g. US
+	Country string
+	// Region is the ISO 3166-2 code of the first subdivision without the country, e.g. CA
+	Region string
+	// City is the English name of the city, e.g. San Francisco
+	City string
+	// Continent is the code of the continent, e.g. NA
+	Continent string
+}
+
+// record is the part of the records of the City databases that's looked up
+type record struct {
+	City struct {
+		Names map[string]string `maxminddb:"names"`
+	} `maxminddb:"city"`
+	Continent struct {
+		Code string `maxminddb:"code"`
+	} `maxminddb:"continent"`
+	Country struct {
+		ISOCode string `maxminddb:"iso_code"`
+	} `maxminddb:"country"`
+	RegisteredCountry struct {
+		ISOCode string `maxminddb:"iso_code"`
+	} `maxminddb:"registered_country"`
+	Subdivisions []struct {
+		ISOCode string `maxminddb:"iso_code"`
+	} `maxminddb:"subdivisions"`
+}
+
+// Open loads the database file
+func Open(path string) (*DB, error) {
+	buf, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return New(buf)
+}
+
+// New loads the database from the content of a database file
+func New(buf []byte) (*DB, error) {
+	r, err := maxminddb.FromBytes(buf)
+	if err != nil {
+		return nil, err
+	}
+	return &DB{reader: r}, nil
+}
+
+// DatabaseType is the type of the database in its metadata, e.g. GeoLite2-City
+func (db *DB) DatabaseType() string {
+	return db.reader.Metadata.DatabaseType
+}
+
+// Lookup returns the location of the IP address, or nil if the database has none
+func (db *DB) Lookup(ip net.IP) (*Location, error) {
+	r := record{}
+	_, ok, err := db.reader.LookupNetwork(ip, &r)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	l := &Location{
+		Country:   r.Country.ISOCode,
+		City:      r.City.Names["en"],
+		Continent: r.Continent.Code,
+	}
+	if l.Country == "" {
+		l.Country = r.RegisteredCountry.ISOCode
+	}
+	if len(r.Subdivisions) > 0 {
+		l.Region = r.Subdivisions[0].ISOCode
+	}
+	return l, nil
+}
@@ -0,0 +1,51 @@
+package geoip
+
+import (
+	"io/ioutil"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testDBPath = "./testdata/test-city.mmdb"
+
+func TestLookup(t *testing.T) {
+	db, err := Open(testDBPath)
+	assert.NoError(t, err)
+	assert.Equal(t, "Flagr-Test-City", db.DatabaseType())
+
+	for _, tc := range []struct {
+		ip       string
+		expected *Location
+	}{
+		{"81.2.69.160", &Location{Country: "GB", Region: "ENG", City: "London", Continent: "EU"}},
+		{"216.160.83.60", &Location{Country: "US", Region: "WA", City: "Milton", Continent: "NA"}},
+		{"89.160.20.113", &Location{Country: "SE", Region: "E", City: "Linköping", Continent: "EU"}},
+		{"::ffff:81.2.69.1", &Location{Country: "GB", Region: "ENG", City: "London", Continent: "EU"}},
+		{"2001:480::1", &Location{Country: "US", Continent: "NA"}},
+		{"216.160.83.64", nil},
+		{"127.0.0.1", nil},
+		{"2002::1", nil},
+	} {
+		l, err := db.Lookup(net.ParseIP(tc.ip))
+		assert.NoError(t, err, tc.ip)
+		assert.Equal(t, tc.expected, l, tc.ip)
+	}
+
+	_, err = db.Lookup(nil)
+	assert.Error(t, err)
+}
+
+func TestOpenInvalid(t *testing.T) {
+	_, err := Open("./testdata/not-found.mmdb")
+	assert.Error(t, err)
+
+	_, err = New([]byte("not a database"))
+	assert.Error(t, err)
+
+	buf, err := ioutil.ReadFile(testDBPath)
+	assert.NoError(t, err)
+	_, err = New(buf[:len(buf)/2])
+	assert.Error(t, err)
+}
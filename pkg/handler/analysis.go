@@ -0,0 +1,223 @@
+package handler
+
+import (
+	"math"
+
+	"github.com/checkr/flagr/pkg/config"
+	"github.com/checkr/flagr/pkg/entity"
+	"github.com/checkr/flagr/pkg/util"
+	"github.com/checkr/flagr/swagger_gen/models"
+	"github.com/checkr/flagr/swagger_gen/restapi/operations/analysis"
+	"github.com/go-openapi/runtime/middleware"
+)
+
+var postConversionEventsHandler = func(params analysis.PostConversionEventsParams) middleware.Responder {
+	events := []entity.ConversionEvent{}
+	for _, e := range params.Body.Events {
+		f := GetEvalCache().GetByFlagKeyOrID(util.SafeString(e.FlagKey))
+		if f == nil {
+			return analysis.NewPostConversionEventsDefault(400).WithPayload(
+				ErrorMessage("cannot find flag with key %s", util.SafeString(e.FlagKey)))
+		}
+		events = append(events, entity.ConversionEvent{
+			FlagID:   f.ID,
+			EntityID: util.SafeString(e.EntityID),
+			Metric:   util.SafeString(e.Metric),
+			Value:    *e.Value,
+		})
+	}
+
+	tx := getDB().Begin()
+	for i := range events {
+		if err := events[i].Create(tx); err != nil {
+			tx.Rollback()
+			return analysis.NewPostConversionEventsDefault(500).WithPayload(ErrorMessage("%s", err))
+		}
+	}
+	if err := tx.Commit().Error; err != nil {
+		tx.Rollback()
+		return analysis.NewPostConversionEventsDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+
+	return analysis.NewPostConversionEventsOK()
+}
+
+var getFlagAnalysisHandler = func(params analysis.GetFlagAnalysisParams) middleware.Responder {
+	f := &entity.Flag{}
+	if err := entity.NewFlagQuerySet(getDB()).IDEq(uint(params.FlagID)).One(f); err != nil {
+		return analysis.NewGetFlagAnalysisDefault(404).WithPayload(
+			ErrorMessage("cannot find flag %v. %s", params.FlagID, err))
+	}
+	if err := f.Preload(getDB()); err != nil {
+		return analysis.NewGetFlagAnalysisDefault(500).WithPayload(
+			ErrorMessage("cannot preload flag %v. %s", params.FlagID, err))
+	}
+	if len(f.Variants) == 0 {
+		return analysis.NewGetFlagAnalysisDefault(400).WithPayload(
+			ErrorMessage("flag %v has no variants", params.FlagID))
+	}
+
+	control := f.Variants[0]
+	if params.ControlVariantKey != nil {
+		found := false
+		for _, v := range f.Variants {
+			if v.Key == *params.ControlVariantKey {
+				control = v
+				found = true
+			}
+		}
+		if !found {
+			return analysis.NewGetFlagAnalysisDefault(400).WithPayload(
+				ErrorMessage("cannot find control variant %s in flag %v", *params.ControlVariantKey, params.FlagID))
+		}
+	}
+
+	conversions, err := fetchVariantConversions(f.ID, params.Metric)
+	if err != nil {
+		return analysis.NewGetFlagAnalysisDefault(500).WithPayload(
+			ErrorMessage("cannot query the conversions of flag %v. %s", params.FlagID, err))
+	}
+
+	level := config.Config.AnalysisConfidenceLevel
+	c := conversions[control.ID]
+	fa := &models.FlagAnalysis{
+		FlagID:            util.Int64Ptr(int64(f.ID)),
+		FlagKey:           f.Key,
+		Metric:            util.StringPtr(params.Metric),
+		ControlVariantKey: control.Key,
+		ConfidenceLevel:   util.Float64Ptr(level),
+		Variants:          []*models.VariantAnalysis{},
+	}
+	for _, v := range f.Variants {
+		fa.Variants = append(fa.Variants, computeVariantAnalysis(v, conversions[v.ID], c, v.ID == control.ID, level))
+	}
+	return analysis.NewGetFlagAnalysisOK().WithPayload(fa)
+}
+
+type variantConversion struct {
+	assigned  uint
+	converted uint
+	valueSum  float64
+}
+
+// fetchVariantConversions joins the assignment records with the conversion events
+// of the metric that happened after the assignment
+var fetchVariantConversions = func(flagID uint, metric string) (map[uint]*variantConversion, error) {
+	m := make(map[uint]*variantConversion)
+	get := func(variantID uint) *variantConversion {
+		if m[variantID] == nil {
+			m[variantID] = &variantConversion{}
+		}
+		return m[variantID]
+	}
+
+	rows, err := getDB().Model(&entity.AssignmentRecord{}).
+		Select("variant_id, COUNT(*)").
+		Where("flag_id = ?", flagID).
+		Group("variant_id").
+		Rows()
+	if err != nil {
+		return nil, err
+	}
+	for rows.Next() {
+		var variantID, assigned uint
+		if err := rows.Scan(&variantID, &assigned); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		get(variantID).assigned = assigned
+	}
+	rows.Close()
+
+	rows, err = getDB().Table("assignment_records a").
+		Select("a.variant_id, COUNT(DISTINCT a.entity_id), SUM(e.value)").
+		Joins("JOIN conversion_events e ON e.flag_id = a.flag_id AND e.entity_id = a.entity_id").
+		Where("a.flag_id = ? AND e.metric = ? AND e.created_at >= a.created_at", flagID, metric).
+		Where("a.deleted_at IS NULL AND e.deleted_at IS NULL").
+		Group("a.variant_id").
+		Rows()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var variantID, converted uint
+		var valueSum float64
+		if err := rows.Scan(&variantID, &converted, &valueSum); err != nil {
+			return nil, err
+		}
+		vc := get(variantID)
+		vc.converted = converted
+		vc.valueSum = valueSum
+	}
+	return m, nil
+}
+
+func computeVariantAnalysis(
+	v entity.Variant,
+	vc *variantConversion,
+	control *variantConversion,
+	isControl bool,
+	level float64,
+) *models.VariantAnalysis {
+	if vc == nil {
+		vc = &variantConversion{}
+	}
+	va := &models.VariantAnalysis{
+		VariantID:      util.Int64Ptr(int64(v.ID)),
+		VariantKey:     util.StringPtr(v.Key),
+		AssignedCount:  util.Int64Ptr(int64(vc.assigned)),
+		ConvertedCount: util.Int64Ptr(int64(vc.converted)),
+		ValueSum:       vc.valueSum,
+		PValue:         1,
+		Significant:    util.BoolPtr(false),
+	}
+	if vc.assigned == 0 {
+		return va
+	}
+
+	n := float64(vc.assigned)
+	p := float64(vc.converted) / n
+	va.ConversionRate = p
+	va.ConversionRateLower, va.ConversionRateUpper = wilsonInterval(p, n, level)
+	va.ValueMean = vc.valueSum / n
+
+	if isControl || control == nil || control.assigned == 0 {
+		return va
+	}
+	pc := float64(control.converted) / float64(control.assigned)
+	if pc > 0 {
+		va.Lift = (p - pc) / pc
+	}
+	va.PValue = twoProportionZTest(vc.converted, vc.assigned, control.converted, control.assigned)
+	va.Significant = util.BoolPtr(va.PValue < 1-level)
+	return va
+}
+
+// normalQuantile returns z such that P(-z <= Z <= z) = level for the standard normal Z
+func normalQuantile(level float64) float64 {
+	return math.Sqrt2 * math.Erfinv(level)
+}
+
+// wilsonInterval returns the Wilson score interval of the proportion p out of n trials
+func wilsonInterval(p float64, n float64, level float64) (lower float64, upper float64) {
+	z := normalQuantile(level)
+	z2 := z * z
+	denominator := 1 + z2/n
+	center := (p + z2/(2*n)) / denominator
+	halfWidth := z * math.Sqrt(p*(1-p)/n+z2/(4*n*n)) / denominator
+	return math.Max(0, center-halfWidth), math.Min(1, center+halfWidth)
+}
+
+// twoProportionZTest returns the two-sided p-value of the difference between the proportions
+func twoProportionZTest(c1, n1, c2, n2 uint) float64 {
+	p1 := float64(c1) / float64(n1)
+	p2 := float64(c2) / float64(n2)
+	pooled := float64(c1+c2) / float64(n1+n2)
+	se := math.Sqrt(pooled * (1 - pooled) * (1/float64(n1) + 1/float64(n2)))
+	if se == 0 {
+		return 1
+	}
+	z := (p1 - p2) / se
+	return math.Erfc(math.Abs(z) / math.Sqrt2)
+}
@@ -0,0 +1,163 @@
+package handler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/checkr/flagr/pkg/entity"
+	"github.com/checkr/flagr/pkg/util"
+	"github.com/checkr/flagr/swagger_gen/models"
+	"github.com/checkr/flagr/swagger_gen/restapi/operations/analysis"
+
+	"github.com/jinzhu/gorm"
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWilsonInterval(t *testing.T) {
+	lower, upper := wilsonInterval(0.5, 100, 0.95)
+	assert.InDelta(t, 0.4038, lower, 1e-4)
+	assert.InDelta(t, 0.5962, upper, 1e-4)
+
+	lower, upper = wilsonInterval(0, 10, 0.95)
+	assert.Equal(t, 0.0, lower)
+	assert.InDelta(t, 0.2775, upper, 1e-4)
+}
+
+func TestTwoProportionZTest(t *testing.T) {
+	assert.InDelta(t, 0.0074, twoProportionZTest(250, 1000, 200, 1000), 1e-4)
+	assert.Equal(t, 1.0, twoProportionZTest(0, 100, 0, 100))
+}
+
+func TestConversionEventsAndAnalysis(t *testing.T) {
+	f := entity.GenFixtureFlag()
+	db := entity.PopulateTestDB(f)
+	defer db.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+	defer gostub.StubFunc(&GetEvalCache, GenFixtureEvalCache()).Reset()
+
+	assignedAt := time.Now().Add(-time.Hour)
+	for i := 0; i < 1000; i++ {
+		for _, variantID := range []uint{300, 301} {
+			entity.SaveAssignmentRecord(db, &entity.AssignmentRecord{
+				Model:     gorm.Model{CreatedAt: assignedAt},
+				FlagID:    100,
+				EntityID:  util.SafeString(int(variantID)*10000 + i),
+				SegmentID: 200,
+				VariantID: variantID,
+			})
+		}
+	}
+
+	t.Run("post conversion events", func(t *testing.T) {
+		events := []*models.ConversionEvent{}
+		for i := 0; i < 250; i++ {
+			events = append(events, &models.ConversionEvent{
+				EntityID: util.StringPtr(util.SafeString(3000000 + i)),
+				FlagKey:  util.StringPtr("flag_key_100"),
+				Metric:   util.StringPtr("checkout"),
+				Value:    util.Float64Ptr(2),
+			})
+		}
+		for i := 0; i < 200; i++ {
+			events = append(events, &models.ConversionEvent{
+				EntityID: util.StringPtr(util.SafeString(3010000 + i)),
+				FlagKey:  util.StringPtr("flag_key_100"),
+				Metric:   util.StringPtr("checkout"),
+				Value:    util.Float64Ptr(1),
+			})
+		}
+		res := postConversionEventsHandler(analysis.PostConversionEventsParams{
+			Body: &models.PostConversionEventsRequest{Events: events},
+		})
+		assert.IsType(t, &analysis.PostConversionEventsOK{}, res)
+	})
+
+	t.Run("post conversion events with unknown flag key", func(t *testing.T) {
+		res := postConversionEventsHandler(analysis.PostConversionEventsParams{
+			Body: &models.PostConversionEventsRequest{Events: []*models.ConversionEvent{
+				{
+					EntityID: util.StringPtr("1"),
+					FlagKey:  util.StringPtr("unknown_flag_key"),
+					Metric:   util.StringPtr("checkout"),
+					Value:    util.Float64Ptr(1),
+				},
+			}},
+		})
+		assert.IsType(t, &analysis.PostConversionEventsDefault{}, res)
+	})
+
+	t.Run("analysis against the default control", func(t *testing.T) {
+		res := getFlagAnalysisHandler(analysis.GetFlagAnalysisParams{FlagID: 100, Metric: "checkout"})
+		fa := res.(*analysis.GetFlagAnalysisOK).Payload
+		assert.Equal(t, "control", fa.ControlVariantKey)
+		assert.Len(t, fa.Variants, 2)
+
+		control, treatment := fa.Variants[0], fa.Variants[1]
+		assert.Equal(t, int64(1000), *control.AssignedCount)
+		assert.Equal(t, int64(250), *control.ConvertedCount)
+		assert.Equal(t, 0.25, control.ConversionRate)
+		assert.Equal(t, 0.5, control.ValueMean)
+		assert.Equal(t, 1.0, control.PValue)
+
+		assert.Equal(t, int64(200), *treatment.ConvertedCount)
+		assert.InDelta(t, -0.2, treatment.Lift, 1e-9)
+		assert.InDelta(t, 0.0074, treatment.PValue, 1e-4)
+		assert.True(t, *treatment.Significant)
+	})
+
+	t.Run("analysis against the given control", func(t *testing.T) {
+		res := getFlagAnalysisHandler(analysis.GetFlagAnalysisParams{
+			FlagID:            100,
+			Metric:            "checkout",
+			ControlVariantKey: util.StringPtr("treatment"),
+		})
+		fa := res.(*analysis.GetFlagAnalysisOK).Payload
+		assert.InDelta(t, 0.25, fa.Variants[0].Lift, 1e-9)
+	})
+
+	t.Run("analysis of a metric without events", func(t *testing.T) {
+		res := getFlagAnalysisHandler(analysis.GetFlagAnalysisParams{FlagID: 100, Metric: "signup"})
+		fa := res.(*analysis.GetFlagAnalysisOK).Payload
+		assert.Equal(t, int64(0), *fa.Variants[1].ConvertedCount)
+		assert.False(t, *fa.Variants[1].Significant)
+	})
+
+	t.Run("analysis with unknown control", func(t *testing.T) {
+		res := getFlagAnalysisHandler(analysis.GetFlagAnalysisParams{
+			FlagID:            100,
+			Metric:            "checkout",
+			ControlVariantKey: util.StringPtr("unknown"),
+		})
+		assert.IsType(t, &analysis.GetFlagAnalysisDefault{}, res)
+	})
+
+	t.Run("analysis of unknown flag", func(t *testing.T) {
+		res := getFlagAnalysisHandler(analysis.GetFlagAnalysisParams{FlagID: 99999, Metric: "checkout"})
+		assert.IsType(t, &analysis.GetFlagAnalysisDefault{}, res)
+	})
+}
+
+func TestAssignmentRecorder(t *testing.T) {
+	db := entity.NewTestDB()
+	defer db.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+
+	ar := &AssignmentRecorder{records: make(map[assignmentRecordKey]entity.AssignmentRecord)}
+	ar.Record(100, "entity1", 200, 300)
+	ar.Record(100, "entity1", 200, 301)
+	ar.Record(100, "entity2", 200, 301)
+
+	assert.NoError(t, ar.flush())
+	assert.Empty(t, ar.records)
+
+	ars := []entity.AssignmentRecord{}
+	entity.NewAssignmentRecordQuerySet(db).OrderAscByID().All(&ars)
+	assert.Len(t, ars, 2)
+
+	ar.Record(100, "entity1", 200, 301)
+	assert.NoError(t, ar.flush())
+	first := entity.AssignmentRecord{}
+	entity.NewAssignmentRecordQuerySet(db).EntityIDEq("entity1").One(&first)
+	assert.Equal(t, uint(300), first.VariantID)
+}
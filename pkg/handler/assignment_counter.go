@@ -0,0 +1,85 @@
+package handler
+
+import (
+	"sync"
+	"time"
+
+	"github.com/checkr/flagr/pkg/config"
+	"github.com/checkr/flagr/pkg/entity"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	singletonAssignmentCounter     *AssignmentCounter
+	singletonAssignmentCounterOnce sync.Once
+)
+
+type assignmentKey struct {
+	flagID    uint
+	segmentID uint
+	variantID uint
+}
+
+// AssignmentCounter counts the variant assignments of the evaluations in memory,
+// and periodically flushes them into DB for the SRM detection
+type AssignmentCounter struct {
+	counts     map[assignmentKey]uint
+	countsLock sync.Mutex
+
+	flushInterval time.Duration
+}
+
+// GetAssignmentCounter gets the AssignmentCounter
+var GetAssignmentCounter = func() *AssignmentCounter {
+	singletonAssignmentCounterOnce.Do(func() {
+		singletonAssignmentCounter = &AssignmentCounter{
+			counts:        make(map[assignmentKey]uint),
+			flushInterval: config.Config.SRMFlushInterval,
+		}
+	})
+	return singletonAssignmentCounter
+}
+
+// Start starts the flushing of AssignmentCounter
+func (ac *AssignmentCounter) Start() {
+	go func() {
+		for range time.Tick(ac.flushInterval) {
+			err := ac.flush()
+			if err != nil {
+				logrus.WithField("err", err).Error("flush assignment counts error")
+			}
+		}
+	}()
+}
+
+// Incr increments the in-memory assignment count by one
+func (ac *AssignmentCounter) Incr(flagID uint, segmentID uint, variantID uint) {
+	ac.countsLock.Lock()
+	ac.counts[assignmentKey{flagID: flagID, segmentID: segmentID, variantID: variantID}]++
+	ac.countsLock.Unlock()
+}
+
+func (ac *AssignmentCounter) flush() error {
+	ac.countsLock.Lock()
+	counts := ac.counts
+	ac.counts = make(map[assignmentKey]uint)
+	ac.countsLock.Unlock()
+
+	for k, n := range counts {
+		if err := entity.IncrAssignmentCount(getDB(), k.flagID, k.segmentID, k.variantID, n); err != nil {
+			ac.restore(counts)
+			return err
+		}
+		delete(counts, k)
+	}
+	return nil
+}
+
+// restore puts back the counts that failed to flush, so that they will be retried next time
+func (ac *AssignmentCounter) restore(counts map[assignmentKey]uint) {
+	ac.countsLock.Lock()
+	for k, n := range counts {
+		ac.counts[k] += n
+	}
+	ac.countsLock.Unlock()
+}
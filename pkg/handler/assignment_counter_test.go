@@ -0,0 +1,42 @@
+package handler
+
+import (
+	"testing"
+
+	"github.com/checkr/flagr/pkg/entity"
+
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAssignmentCounter(t *testing.T) {
+	db := entity.NewTestDB()
+	defer db.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+
+	ac := &AssignmentCounter{counts: make(map[assignmentKey]uint)}
+	ac.Incr(100, 200, 300)
+	ac.Incr(100, 200, 300)
+	ac.Incr(100, 200, 301)
+
+	t.Run("flush into DB", func(t *testing.T) {
+		assert.NoError(t, ac.flush())
+		assert.Empty(t, ac.counts)
+
+		acs := []entity.AssignmentCount{}
+		entity.NewAssignmentCountQuerySet(db).OrderAscByVariantID().All(&acs)
+		assert.Len(t, acs, 2)
+		assert.Equal(t, uint(2), acs[0].Count)
+		assert.Equal(t, uint(1), acs[1].Count)
+	})
+
+	t.Run("keep the counts if flush fails", func(t *testing.T) {
+		ac.Incr(100, 200, 300)
+		brokenDB := entity.NewTestDB()
+		brokenDB.Close()
+		defer gostub.StubFunc(&getDB, brokenDB).Reset()
+
+		assert.Error(t, ac.flush())
+		assert.Equal(t, uint(1), ac.counts[assignmentKey{flagID: 100, segmentID: 200, variantID: 300}])
+	})
+}
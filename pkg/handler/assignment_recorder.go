@@ -0,0 +1,94 @@
+package handler
+
+import (
+	"sync"
+	"time"
+
+	"github.com/checkr/flagr/pkg/config"
+	"github.com/checkr/flagr/pkg/entity"
+	"github.com/jinzhu/gorm"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	singletonAssignmentRecorder     *AssignmentRecorder
+	singletonAssignmentRecorderOnce sync.Once
+)
+
+type assignmentRecordKey struct {
+	flagID   uint
+	entityID string
+}
+
+// AssignmentRecorder buffers the variant assignments of the entities in memory,
+// and periodically saves the first assignment of every entity into DB
+type AssignmentRecorder struct {
+	records     map[assignmentRecordKey]entity.AssignmentRecord
+	recordsLock sync.Mutex
+
+	flushInterval time.Duration
+}
+
+// GetAssignmentRecorder gets the AssignmentRecorder
+var GetAssignmentRecorder = func() *AssignmentRecorder {
+	singletonAssignmentRecorderOnce.Do(func() {
+		singletonAssignmentRecorder = &AssignmentRecorder{
+			records:       make(map[assignmentRecordKey]entity.AssignmentRecord),
+			flushInterval: config.Config.AnalysisFlushInterval,
+		}
+	})
+	return singletonAssignmentRecorder
+}
+
+// Start starts the flushing of AssignmentRecorder
+func (ar *AssignmentRecorder) Start() {
+	go func() {
+		for range time.Tick(ar.flushInterval) {
+			err := ar.flush()
+			if err != nil {
+				logrus.WithField("err", err).Error("flush assignment records error")
+			}
+		}
+	}()
+}
+
+// Record buffers the assignment, the earliest one wins if the entity is evaluated multiple times
+func (ar *AssignmentRecorder) Record(flagID uint, entityID string, segmentID uint, variantID uint) {
+	k := assignmentRecordKey{flagID: flagID, entityID: entityID}
+	ar.recordsLock.Lock()
+	if _, ok := ar.records[k]; !ok {
+		ar.records[k] = entity.AssignmentRecord{
+			Model:     gorm.Model{CreatedAt: time.Now()}, // the time of the assignment rather than the flush
+			FlagID:    flagID,
+			EntityID:  entityID,
+			SegmentID: segmentID,
+			VariantID: variantID,
+		}
+	}
+	ar.recordsLock.Unlock()
+}
+
+func (ar *AssignmentRecorder) flush() error {
+	ar.recordsLock.Lock()
+	records := ar.records
+	ar.records = make(map[assignmentRecordKey]entity.AssignmentRecord)
+	ar.recordsLock.Unlock()
+
+	for k, r := range records {
+		if err := entity.SaveAssignmentRecord(getDB(), &r); err != nil {
+			ar.restore(records)
+			return err
+		}
+		delete(records, k)
+	}
+	return nil
+}
+
+// restore puts back the records that failed to flush, so that they will be retried next time
+func (ar *AssignmentRecorder) restore(records map[assignmentRecordKey]entity.AssignmentRecord) {
+	ar.recordsLock.Lock()
+	for k, r := range records {
+		ar.records[k] = r // the restored one is earlier
+	}
+	ar.recordsLock.Unlock()
+}
@@ -0,0 +1,233 @@
+package handler
+
+import (
+	"github.com/checkr/flagr/pkg/entity"
+	"github.com/checkr/flagr/pkg/mapper/entity_restapi/e2r"
+	"github.com/checkr/flagr/pkg/util"
+	"github.com/checkr/flagr/swagger_gen/models"
+	"github.com/checkr/flagr/swagger_gen/restapi/operations/audience"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/jinzhu/gorm"
+)
+
+var findAudiencesHandler = func(params audience.FindAudiencesParams) middleware.Responder {
+	as := []entity.Audience{}
+	if err := entity.NewAudienceQuerySet(getDB()).OrderAscByID().All(&as); err != nil {
+		return audience.NewFindAudiencesDefault(500).WithPayload(
+			ErrorMessage("cannot query all audiences. %s", err))
+	}
+
+	ret := make([]*models.Audience, len(as), len(as))
+	for i := range as {
+		r, err := mapAudience(getDB(), &as[i])
+		if err != nil {
+			return audience.NewFindAudiencesDefault(500).WithPayload(
+				ErrorMessage("cannot query audience %v. %s", as[i].ID, err))
+		}
+		ret[i] = r
+	}
+	return audience.NewFindAudiencesOK().WithPayload(ret)
+}
+
+var createAudienceHandler = func(params audience.CreateAudienceParams) middleware.Responder {
+	key := util.SafeString(params.Body.Key)
+	if ok, reason := util.IsSafeKey(key); !ok {
+		return audience.NewCreateAudienceDefault(400).WithPayload(
+			ErrorMessage("cannot create audience due to invalid key. reason: %s", reason))
+	}
+	if n, _ := entity.NewAudienceQuerySet(getDB()).KeyEq(key).Count(); n > 0 {
+		return audience.NewCreateAudienceDefault(400).WithPayload(
+			ErrorMessage("cannot create audience. key %s already exists", key))
+	}
+	cs, e := mapAudienceConstraints(params.Body.Constraints)
+	if e != nil {
+		return audience.NewCreateAudienceDefault(e.StatusCode).WithPayload(ErrorMessage("%s", e))
+	}
+
+	a := &entity.Audience{Key: key, Description: params.Body.Description, Constraints: cs}
+	if err := a.Create(getDB()); err != nil {
+		return audience.NewCreateAudienceDefault(500).WithPayload(
+			ErrorMessage("cannot create audience. %s", err))
+	}
+	r, err := mapAudience(getDB(), a)
+	if err != nil {
+		return audience.NewCreateAudienceDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+	return audience.NewCreateAudienceOK().WithPayload(r)
+}
+
+var getAudienceHandler = func(params audience.GetAudienceParams) middleware.Responder {
+	a := &entity.Audience{}
+	if err := entity.NewAudienceQuerySet(getDB()).IDEq(uint(params.AudienceID)).One(a); err != nil {
+		return audience.NewGetAudienceDefault(404).WithPayload(
+			ErrorMessage("cannot find audience %v. %s", params.AudienceID, err))
+	}
+	r, err := mapAudience(getDB(), a)
+	if err != nil {
+		return audience.NewGetAudienceDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+	return audience.NewGetAudienceOK().WithPayload(r)
+}
+
+var putAudienceHandler = func(params audience.PutAudienceParams) middleware.Responder {
+	a := &entity.Audience{}
+	if err := entity.NewAudienceQuerySet(getDB()).IDEq(uint(params.AudienceID)).One(a); err != nil {
+		return audience.NewPutAudienceDefault(404).WithPayload(
+			ErrorMessage("cannot find audience %v. %s", params.AudienceID, err))
+	}
+	if params.Body.Description != nil {
+		a.Description = *params.Body.Description
+	}
+
+	tx := getDB().Begin()
+	err := tx.Save(a).Error
+	if err == nil && params.Body.Constraints != nil {
+		cs, e := mapAudienceConstraints(params.Body.Constraints)
+		if e != nil {
+			tx.Rollback()
+			return audience.NewPutAudienceDefault(e.StatusCode).WithPayload(ErrorMessage("%s", e))
+		}
+		err = replaceAudienceConstraints(tx, a.ID, cs)
+	}
+	if err == nil {
+		err = tx.Commit().Error
+	}
+	if err != nil {
+		tx.Rollback()
+		return audience.NewPutAudienceDefault(500).WithPayload(
+			ErrorMessage("cannot update audience %v. %s", params.AudienceID, err))
+	}
+
+	r, err := mapAudience(getDB(), a)
+	if err != nil {
+		return audience.NewPutAudienceDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+	if params.Body.Constraints != nil {
+		// the change propagates to the flags using the audience, which get new snapshots
+		saved := make(map[int64]bool)
+		for _, s := range r.Segments {
+			if !saved[*s.FlagID] {
+				saved[*s.FlagID] = true
+				entity.SaveFlagSnapshot(getDB(), uint(*s.FlagID), getSubjectFromRequest(params.HTTPRequest))
+			}
+		}
+	}
+	return audience.NewPutAudienceOK().WithPayload(r)
+}
+
+var deleteAudienceHandler = func(params audience.DeleteAudienceParams) middleware.Responder {
+	// the audience row is locked before checking its segments, so that no segment can start
+	// using it until it's deleted, see validateSegmentAudience
+	tx := getDB().Begin()
+	err := entity.NewAudienceQuerySet(entity.ForUpdate(tx)).IDEq(uint(params.AudienceID)).One(&entity.Audience{})
+	if err != nil && !gorm.IsRecordNotFoundError(err) {
+		tx.Rollback()
+		return audience.NewDeleteAudienceDefault(500).WithPayload(
+			ErrorMessage("cannot delete audience %v. %s", params.AudienceID, err))
+	}
+	ss, _, err := findAudienceSegments(tx, uint(params.AudienceID))
+	if err != nil {
+		tx.Rollback()
+		return audience.NewDeleteAudienceDefault(500).WithPayload(
+			ErrorMessage("cannot delete audience %v. %s", params.AudienceID, err))
+	}
+	if len(ss) > 0 {
+		tx.Rollback()
+		return audience.NewDeleteAudienceDefault(400).WithPayload(
+			ErrorMessage("cannot delete audience %v. there are still %d segments using it", params.AudienceID, len(ss)))
+	}
+
+	err = entity.NewConstraintQuerySet(tx).AudienceIDEq(uint(params.AudienceID)).Delete()
+	if err == nil {
+		err = entity.NewAudienceQuerySet(tx).IDEq(uint(params.AudienceID)).Delete()
+	}
+	if err == nil {
+		err = tx.Commit().Error
+	}
+	if err != nil {
+		tx.Rollback()
+		return audience.NewDeleteAudienceDefault(500).WithPayload(
+			ErrorMessage("cannot delete audience %v. %s", params.AudienceID, err))
+	}
+	return audience.NewDeleteAudienceOK()
+}
+
+// mapAudienceConstraints maps and validates the constraints of the audience
+func mapAudienceConstraints(rs []*models.CreateConstraintRequest) ([]entity.Constraint, *Error) {
+	cs := make([]entity.Constraint, 0, len(rs))
+	for _, r := range rs {
+		c := entity.Constraint{
+			Property:  util.SafeString(r.Property),
+			Operator:  util.SafeString(r.Operator),
+			Value:     util.SafeString(r.Value),
+			ValueType: util.SafeString(r.ValueType),
+		}
+		if err := c.Validate(); err != nil {
+			return nil, NewError(400, "%s", err)
+		}
+		if err := validateConstraintIDList(&c); err != nil {
+			return nil, err
+		}
+		cs = append(cs, c)
+	}
+	return cs, nil
+}
+
+func replaceAudienceConstraints(tx *gorm.DB, audienceID uint, cs []entity.Constraint) error {
+	if err := entity.NewConstraintQuerySet(tx).AudienceIDEq(audienceID).Delete(); err != nil {
+		return err
+	}
+	for i := range cs {
+		cs[i].AudienceID = audienceID
+		if err := cs[i].Create(tx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// findAudienceSegments finds the segments of the existing flags using the audience ordered
+// by flagID, and the keys of their flags
+func findAudienceSegments(db *gorm.DB, audienceID uint) ([]entity.Segment, map[uint]string, error) {
+	ss := []entity.Segment{}
+	err := entity.NewSegmentQuerySet(db).AudienceIDEq(audienceID).OrderAscByFlagID().OrderAscByID().All(&ss)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	flagKeys := make(map[uint]string)
+	if len(ss) == 0 {
+		return ss, flagKeys, nil
+	}
+	flagIDs := make([]uint, len(ss), len(ss))
+	for i, s := range ss {
+		flagIDs[i] = s.FlagID
+	}
+	fs := []entity.Flag{}
+	if err := entity.NewFlagQuerySet(db).IDIn(flagIDs...).All(&fs); err != nil {
+		return nil, nil, err
+	}
+	for _, f := range fs {
+		flagKeys[f.ID] = f.Key
+	}
+
+	// the segments of the deleted flags are left behind
+	ret := []entity.Segment{}
+	for _, s := range ss {
+		if _, ok := flagKeys[s.FlagID]; ok {
+			ret = append(ret, s)
+		}
+	}
+	return ret, flagKeys, nil
+}
+
+func mapAudience(db *gorm.DB, a *entity.Audience) (*models.Audience, error) {
+	if err := a.Preload(db); err != nil {
+		return nil, err
+	}
+	segments, flagKeys, err := findAudienceSegments(db, a.ID)
+	if err != nil {
+		return nil, err
+	}
+	return e2r.MapAudience(a, segments, flagKeys), nil
+}
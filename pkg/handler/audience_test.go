@@ -0,0 +1,148 @@
+package handler
+
+import (
+	"testing"
+
+	"github.com/checkr/flagr/pkg/entity"
+	"github.com/checkr/flagr/pkg/util"
+	"github.com/checkr/flagr/swagger_gen/models"
+	"github.com/checkr/flagr/swagger_gen/restapi/operations/audience"
+	"github.com/checkr/flagr/swagger_gen/restapi/operations/evaluation"
+	"github.com/checkr/flagr/swagger_gen/restapi/operations/segment"
+
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCrudAudiences(t *testing.T) {
+	db := entity.PopulateTestDB(entity.GenFixtureFlag())
+	defer db.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+
+	emailConstraint := func(value string) *models.CreateConstraintRequest {
+		return &models.CreateConstraintRequest{
+			Property: util.StringPtr("email"),
+			Operator: util.StringPtr(models.ConstraintOperatorEREG),
+			Value:    util.StringPtr(value),
+		}
+	}
+	putSegment := func(audienceID *int64) interface{} {
+		return NewCRUD().PutSegment(segment.PutSegmentParams{
+			FlagID:    100,
+			SegmentID: 200,
+			Body: &models.PutSegmentRequest{
+				Description:    util.StringPtr("employees in CA"),
+				RolloutPercent: util.Int64Ptr(100),
+				AudienceID:     audienceID,
+			},
+		})
+	}
+
+	t.Run("create audience", func(t *testing.T) {
+		res := createAudienceHandler(audience.CreateAudienceParams{
+			Body: &models.CreateAudienceRequest{
+				Key:         util.StringPtr("employees"),
+				Description: "internal employees",
+				Constraints: []*models.CreateConstraintRequest{emailConstraint(`"@example.com$"`)},
+			},
+		})
+		a := res.(*audience.CreateAudienceOK).Payload
+		assert.Equal(t, int64(1), *a.ID)
+		assert.Equal(t, "employees", *a.Key)
+		assert.Len(t, a.Constraints, 1)
+		assert.Len(t, a.Segments, 0)
+
+		res = createAudienceHandler(audience.CreateAudienceParams{
+			Body: &models.CreateAudienceRequest{Key: util.StringPtr("employees")},
+		})
+		assert.Contains(t, *res.(*audience.CreateAudienceDefault).Payload.Message, "already exists")
+
+		res = createAudienceHandler(audience.CreateAudienceParams{
+			Body: &models.CreateAudienceRequest{
+				Key:         util.StringPtr("beta_testers"),
+				Constraints: []*models.CreateConstraintRequest{emailConstraint(`"@example.com$"]`)},
+			},
+		})
+		assert.IsType(t, &audience.CreateAudienceDefault{}, res)
+	})
+
+	t.Run("segments use the audience", func(t *testing.T) {
+		res := putSegment(util.Int64Ptr(2))
+		assert.Contains(t, *res.(*segment.PutSegmentDefault).Payload.Message, "error finding audienceID 2")
+
+		s := putSegment(util.Int64Ptr(1)).(*segment.PutSegmentOK).Payload
+		assert.Equal(t, int64(1), s.AudienceID)
+
+		// the audience is kept if absent, e.g. when the UI updates the rollout
+		s = putSegment(nil).(*segment.PutSegmentOK).Payload
+		assert.Equal(t, int64(1), s.AudienceID)
+
+		a := getAudienceHandler(audience.GetAudienceParams{AudienceID: 1}).(*audience.GetAudienceOK).Payload
+		assert.Len(t, a.Segments, 1)
+		assert.Equal(t, int64(100), *a.Segments[0].FlagID)
+		assert.Equal(t, "flag_key_100", a.Segments[0].FlagKey)
+		assert.Equal(t, int64(200), *a.Segments[0].SegmentID)
+	})
+
+	t.Run("changes propagate to the flags", func(t *testing.T) {
+		ec := &EvalCache{}
+		defer gostub.StubFunc(&GetEvalCache, ec).Reset()
+		defer gostub.StubFunc(&logEvalResult).Reset()
+		eval := func(email string) *models.EvalResult {
+			return evalFlag(models.EvalContext{
+				EntityID:      "entity_1",
+				EntityContext: map[string]interface{}{"dl_state": "CA", "email": email},
+				FlagID:        100,
+			})
+		}
+
+		assert.NoError(t, ec.reloadMapCache())
+		assert.NotNil(t, eval("alice@example.com").VariantID)
+		assert.Nil(t, eval("alice@example.org").VariantID)
+
+		res := putAudienceHandler(audience.PutAudienceParams{
+			AudienceID: 1,
+			Body: &models.PutAudienceRequest{
+				Constraints: []*models.CreateConstraintRequest{emailConstraint(`"@example.org$"`)},
+			},
+		})
+		a := res.(*audience.PutAudienceOK).Payload
+		assert.Equal(t, "internal employees", a.Description)
+		assert.Equal(t, `"@example.org$"`, *a.Constraints[0].Value)
+
+		assert.NoError(t, ec.reloadMapCache())
+		assert.Nil(t, eval("alice@example.com").VariantID)
+		assert.NotNil(t, eval("alice@example.org").VariantID)
+
+		cfg := getEvaluationConfigHandler(evaluation.GetEvaluationConfigParams{}).(*evaluation.GetEvaluationConfigOK).Payload
+		assert.Equal(t, int64(1), cfg.Flags[0].Segments[0].AudienceID)
+		assert.Len(t, cfg.Flags[0].Segments[0].Constraints, 2)
+		assert.Equal(t, "email", *cfg.Flags[0].Segments[0].Constraints[0].Property)
+
+		// the audience applies to the segments without constraints of their own
+		f := ec.GetByFlagKeyOrID(100)
+		f.Segments[0].Constraints = entity.ConstraintArray{}
+		assert.NoError(t, f.PrepareEvaluation())
+		assert.Nil(t, evalFlag(models.EvalContext{
+			EntityID:      "entity_1",
+			EntityContext: map[string]interface{}{"email": "alice@example.com"},
+			FlagID:        100,
+		}).VariantID)
+
+		snapshots := []entity.FlagSnapshot{}
+		db.Order("id DESC").Find(&snapshots)
+		assert.Contains(t, string(snapshots[0].Flag), "@example.org$")
+	})
+
+	t.Run("delete audience", func(t *testing.T) {
+		res := deleteAudienceHandler(audience.DeleteAudienceParams{AudienceID: 1})
+		assert.Contains(t, *res.(*audience.DeleteAudienceDefault).Payload.Message, "there are still 1 segments using it")
+
+		putSegment(util.Int64Ptr(0))
+		res = deleteAudienceHandler(audience.DeleteAudienceParams{AudienceID: 1})
+		assert.IsType(t, &audience.DeleteAudienceOK{}, res)
+
+		as := findAudiencesHandler(audience.FindAudiencesParams{}).(*audience.FindAudiencesOK).Payload
+		assert.Len(t, as, 0)
+	})
+}
@@ -61,8 +61,10 @@ var (
 	e2rMapFlags         = e2r.MapFlags
 	e2rMapFlagSnapshots = e2r.MapFlagSnapshots
 
-	r2eMapAttachment    = r2e.MapAttachment
-	r2eMapDistributions = r2e.MapDistributions
+	r2eMapAttachment       = r2e.MapAttachment
+	r2eMapAttachmentSchema = r2e.MapAttachmentSchema
+	r2eMapDistributions    = r2e.MapDistributions
+	r2eMapConstraintGroup  = r2e.MapConstraintGroup
 )
 
 func (c *crud) FindFlags(params flag.FindFlagsParams) middleware.Responder {
@@ -187,9 +189,38 @@ func (c *crud) PutFlag(params flag.PutFlagParams) middleware.Responder {
 		}
 		u = u.SetKey(key)
 	}
+	if params.Body.Salt != nil {
+		u = u.SetSalt(*params.Body.Salt)
+	}
+	if params.Body.Hash != nil {
+		u = u.SetHash(*params.Body.Hash)
+	}
+	if params.Body.BucketBy != nil {
+		u = u.SetBucketBy(*params.Body.BucketBy)
+	}
+	if params.Body.StickyAssignments != nil {
+		u = u.SetStickyAssignments(*params.Body.StickyAssignments)
+	}
+	var schema entity.AttachmentSchema
+	if params.Body.AttachmentSchema != nil {
+		s, err := r2eMapAttachmentSchema(params.Body.AttachmentSchema)
+		if err != nil {
+			return flag.NewPutFlagDefault(400).WithPayload(ErrorMessage("%s", err))
+		}
+		if err := validatePutFlagAttachmentSchema(uint(params.FlagID), s); err != nil {
+			return flag.NewPutFlagDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+		}
+		schema = s
+	}
 	if err := u.Update(); err != nil {
 		return flag.NewPutFlagDefault(500).WithPayload(ErrorMessage("%s", err))
 	}
+	if schema != nil {
+		err := getDB().Model(&entity.Flag{}).Where("id = ?", params.FlagID).Update("attachment_schema", schema).Error
+		if err != nil {
+			return flag.NewPutFlagDefault(500).WithPayload(ErrorMessage("%s", err))
+		}
+	}
 
 	f := &entity.Flag{}
 	if err := q.IDEq(uint(params.FlagID)).One(f); err != nil {
@@ -231,10 +262,23 @@ func (c *crud) SetFlagEnabledState(params flag.SetFlagEnabledParams) middleware.
 }
 
 func (c *crud) DeleteFlag(params flag.DeleteFlagParams) middleware.Responder {
-	q := entity.NewFlagQuerySet(getDB()).IDEq(uint(params.FlagID))
+	// the flag.deleted event is enqueued in the same transaction, so that it's sent if and
+	// only if the flag is deleted
+	tx := getDB().Begin()
+	q := entity.NewFlagQuerySet(tx).IDEq(uint(params.FlagID))
+
+	f := &entity.Flag{}
+	found := q.One(f) == nil
 
 	err := q.Delete()
+	if err == nil && found {
+		err = entity.EnqueueFlagEvent(tx, entity.NewFlagDeletedEvent(f, getSubjectFromRequest(params.HTTPRequest)))
+	}
+	if err == nil {
+		err = tx.Commit().Error
+	}
 	if err != nil {
+		tx.Rollback()
 		return flag.NewDeleteFlagDefault(500).WithPayload(ErrorMessage("%s", err))
 	}
 	return flag.NewDeleteFlagOK()
@@ -244,11 +288,23 @@ func (c *crud) CreateSegment(params segment.CreateSegmentParams) middleware.Resp
 	s := &entity.Segment{}
 	s.FlagID = uint(params.FlagID)
 	s.RolloutPercent = uint(*params.Body.RolloutPercent)
+	s.RolloutBasisPoints = uint(params.Body.RolloutBasisPoints)
 	s.Description = util.SafeString(params.Body.Description)
 	s.Rank = entity.SegmentDefaultRank
+	s.AudienceID = uint(params.Body.AudienceID)
+
+	tx := getDB().Begin()
+	if err := validateSegmentAudience(tx, s.AudienceID); err != nil {
+		tx.Rollback()
+		return segment.NewCreateSegmentDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+	}
 
-	err := s.Create(getDB())
+	err := s.Create(tx)
+	if err == nil {
+		err = tx.Commit().Error
+	}
 	if err != nil {
+		tx.Rollback()
 		return segment.NewCreateSegmentDefault(500).WithPayload(ErrorMessage("%s", err))
 	}
 
@@ -280,10 +336,36 @@ func (c *crud) PutSegment(params segment.PutSegmentParams) middleware.Responder
 		return segment.NewPutSegmentDefault(500).WithPayload(ErrorMessage("%s", err))
 	}
 
-	s.RolloutPercent = util.SafeUint(params.Body.RolloutPercent)
+	rolloutPercent := util.SafeUint(params.Body.RolloutPercent)
+	if params.Body.RolloutBasisPoints != nil {
+		s.RolloutBasisPoints = uint(*params.Body.RolloutBasisPoints)
+	} else if rolloutPercent != s.RolloutPercent {
+		// the basis points would override the changed rolloutPercent
+		s.RolloutBasisPoints = 0
+	}
+	s.RolloutPercent = rolloutPercent
 	s.Description = util.SafeString(params.Body.Description)
+	if params.Body.AudienceID != nil {
+		s.AudienceID = uint(*params.Body.AudienceID)
+	}
+	if params.Body.ConstraintGroup != nil {
+		s.ConstraintGroup = r2eMapConstraintGroup(params.Body.ConstraintGroup)
+		if err := validateSegmentConstraintGroup(s.ID, s.ConstraintGroup); err != nil {
+			return segment.NewPutSegmentDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+		}
+	}
 
-	if err := getDB().Save(&s).Error; err != nil {
+	tx := getDB().Begin()
+	if err := validateSegmentAudience(tx, s.AudienceID); err != nil {
+		tx.Rollback()
+		return segment.NewPutSegmentDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+	}
+	err := tx.Save(&s).Error
+	if err == nil {
+		err = tx.Commit().Error
+	}
+	if err != nil {
+		tx.Rollback()
 		return segment.NewPutSegmentDefault(500).WithPayload(ErrorMessage("%s", err))
 	}
 
@@ -330,10 +412,14 @@ func (c *crud) CreateConstraint(params constraint.CreateConstraintParams) middle
 		cons.Property = util.SafeString(params.Body.Property)
 		cons.Operator = util.SafeString(params.Body.Operator)
 		cons.Value = util.SafeString(params.Body.Value)
+		cons.ValueType = util.SafeString(params.Body.ValueType)
 	}
 	if err := cons.Validate(); err != nil {
 		return constraint.NewCreateConstraintDefault(400).WithPayload(ErrorMessage("%s", err))
 	}
+	if err := validateConstraintIDList(cons); err != nil {
+		return constraint.NewCreateConstraintDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+	}
 	if err := cons.Create(getDB()); err != nil {
 		return constraint.NewCreateConstraintDefault(500).WithPayload(ErrorMessage("%s", err))
 	}
@@ -369,10 +455,16 @@ func (c *crud) PutConstraint(params constraint.PutConstraintParams) middleware.R
 		cons.Property = util.SafeString(params.Body.Property)
 		cons.Operator = util.SafeString(params.Body.Operator)
 		cons.Value = util.SafeString(params.Body.Value)
+		if params.Body.ValueType != nil {
+			cons.ValueType = *params.Body.ValueType
+		}
 	}
 	if err := cons.Validate(); err != nil {
 		return constraint.NewPutConstraintDefault(400).WithPayload(ErrorMessage("%s", err))
 	}
+	if err := validateConstraintIDList(&cons); err != nil {
+		return constraint.NewPutConstraintDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+	}
 
 	if err := getDB().Save(&cons).Error; err != nil {
 		return constraint.NewPutConstraintDefault(500).WithPayload(ErrorMessage("%s", err))
@@ -386,8 +478,26 @@ func (c *crud) PutConstraint(params constraint.PutConstraintParams) middleware.R
 }
 
 func (c *crud) DeleteConstraint(params constraint.DeleteConstraintParams) middleware.Responder {
-	err := entity.NewConstraintQuerySet(getDB()).IDEq(uint(params.ConstraintID)).Delete()
-	if err != nil {
+	s := entity.Segment{}
+	if err := entity.NewSegmentQuerySet(getDB()).IDEq(uint(params.SegmentID)).One(&s); err != nil {
+		return constraint.NewDeleteConstraintDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+
+	tx := getDB().Begin()
+	if err := entity.NewConstraintQuerySet(tx).IDEq(uint(params.ConstraintID)).Delete(); err != nil {
+		tx.Rollback()
+		return constraint.NewDeleteConstraintDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+	// the deleted constraint is removed from the constraint group of the segment
+	if !s.ConstraintGroup.IsEmpty() {
+		g := s.ConstraintGroup.Without(uint(params.ConstraintID))
+		if err := tx.Model(&s).Update("constraint_group", g).Error; err != nil {
+			tx.Rollback()
+			return constraint.NewDeleteConstraintDefault(500).WithPayload(ErrorMessage("%s", err))
+		}
+	}
+	if err := tx.Commit().Error; err != nil {
+		tx.Rollback()
 		return constraint.NewDeleteConstraintDefault(500).WithPayload(ErrorMessage("%s", err))
 	}
 
@@ -420,6 +530,14 @@ func (c *crud) PutDistributions(params distribution.PutDistributionsParams) midd
 			return distribution.NewPutDistributionsDefault(500).WithPayload(ErrorMessage("%s", err))
 		}
 	}
+
+	// the observed assignments no longer reflect the new distributions
+	err = entity.ResetAssignmentCounts(tx, segmentID)
+	if err != nil {
+		tx.Rollback()
+		return distribution.NewPutDistributionsDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+
 	err = tx.Commit().Error
 	if err != nil {
 		tx.Rollback()
@@ -461,6 +579,9 @@ func (c *crud) CreateVariant(params variant.CreateVariantParams) middleware.Resp
 	if err := v.Validate(); err != nil {
 		return variant.NewCreateVariantDefault(400).WithPayload(ErrorMessage("%s", err))
 	}
+	if err := validateVariantAttachment(v.FlagID, v.Attachment); err != nil {
+		return variant.NewCreateVariantDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+	}
 
 	if err := v.Create(getDB()); err != nil {
 		return variant.NewCreateVariantDefault(500).WithPayload(ErrorMessage("%s", err))
@@ -508,6 +629,9 @@ func (c *crud) PutVariant(params variant.PutVariantParams) middleware.Responder
 	if err := v.Validate(); err != nil {
 		return variant.NewPutVariantDefault(400).WithPayload(ErrorMessage("%s", err))
 	}
+	if err := validateVariantAttachment(v.FlagID, v.Attachment); err != nil {
+		return variant.NewPutVariantDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+	}
 
 	if err := getDB().Save(&v).Error; err != nil {
 		return variant.NewPutVariantDefault(500).WithPayload(ErrorMessage("%s", err))
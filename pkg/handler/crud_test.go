@@ -61,6 +61,27 @@ func TestCrudFlags(t *testing.T) {
 	assert.NotZero(t, res.(*flag.PutFlagOK).Payload.ID)
 	assert.Equal(t, "flag_key_1", res.(*flag.PutFlagOK).Payload.Key)
 
+	// step 4.1. it should be able to put the bucketing of the flag, and reset the salt
+	res = c.PutFlag(flag.PutFlagParams{
+		FlagID: int64(1),
+		Body: &models.PutFlagRequest{
+			Salt:              util.StringPtr("shared_salt"),
+			Hash:              util.StringPtr(entity.HashSHA1),
+			BucketBy:          util.StringPtr("company_id"),
+			StickyAssignments: util.BoolPtr(true),
+		}},
+	)
+	assert.True(t, res.(*flag.PutFlagOK).Payload.StickyAssignments)
+	assert.Equal(t, "shared_salt", res.(*flag.PutFlagOK).Payload.Salt)
+	assert.Equal(t, "company_id", res.(*flag.PutFlagOK).Payload.BucketBy)
+	assert.Equal(t, entity.HashSHA1, res.(*flag.PutFlagOK).Payload.Hash)
+	res = c.PutFlag(flag.PutFlagParams{
+		FlagID: int64(1),
+		Body:   &models.PutFlagRequest{Salt: util.StringPtr("")},
+	})
+	assert.Equal(t, "", res.(*flag.PutFlagOK).Payload.Salt)
+	assert.Equal(t, entity.HashSHA1, res.(*flag.PutFlagOK).Payload.Hash)
+
 	// step 5. it should be able to set the flag enabled state
 	res = c.SetFlagEnabledState(flag.SetFlagEnabledParams{
 		FlagID: int64(1),
@@ -382,6 +403,55 @@ func TestCrudSegments(t *testing.T) {
 	assert.NotZero(t, res.(*segment.DeleteSegmentOK))
 }
 
+func TestCrudSegmentsRolloutBasisPoints(t *testing.T) {
+	var res middleware.Responder
+	db := entity.NewTestDB()
+	c := &crud{}
+
+	defer db.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+
+	c.CreateFlag(flag.CreateFlagParams{
+		Body: &models.CreateFlagRequest{
+			Description: util.StringPtr("funny flag"),
+		},
+	})
+	c.CreateSegment(segment.CreateSegmentParams{
+		FlagID: int64(1),
+		Body: &models.CreateSegmentRequest{
+			Description:        util.StringPtr("segment1"),
+			RolloutPercent:     util.Int64Ptr(int64(0)),
+			RolloutBasisPoints: int64(25),
+		},
+	})
+	put := func(rolloutPercent int64, rolloutBasisPoints *int64) *models.Segment {
+		res = c.PutSegment(segment.PutSegmentParams{
+			FlagID:    int64(1),
+			SegmentID: int64(1),
+			Body: &models.PutSegmentRequest{
+				Description:        util.StringPtr("segment1"),
+				RolloutPercent:     util.Int64Ptr(rolloutPercent),
+				RolloutBasisPoints: rolloutBasisPoints,
+			},
+		})
+		return res.(*segment.PutSegmentOK).Payload
+	}
+
+	t.Run("it should keep the basis points when absent", func(t *testing.T) {
+		assert.Equal(t, int64(25), put(0, nil).RolloutBasisPoints)
+	})
+
+	t.Run("it should put the basis points", func(t *testing.T) {
+		assert.Equal(t, int64(50), put(0, util.Int64Ptr(50)).RolloutBasisPoints)
+	})
+
+	t.Run("it should reset the basis points when absent and rolloutPercent is changed", func(t *testing.T) {
+		s := put(10, nil)
+		assert.Equal(t, int64(10), *s.RolloutPercent)
+		assert.Equal(t, int64(0), s.RolloutBasisPoints)
+	})
+}
+
 func TestCrudSegmentsWithFailures(t *testing.T) {
 	var res middleware.Responder
 	db := entity.NewTestDB()
@@ -520,6 +590,210 @@ func TestCrudConstraints(t *testing.T) {
 	assert.NotZero(t, res.(*constraint.DeleteConstraintOK))
 }
 
+func TestCrudConstraintsWithValueType(t *testing.T) {
+	var res middleware.Responder
+	db := entity.NewTestDB()
+	c := &crud{}
+
+	defer db.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+
+	c.CreateFlag(flag.CreateFlagParams{
+		Body: &models.CreateFlagRequest{
+			Description: util.StringPtr("funny flag"),
+		},
+	})
+	c.CreateSegment(segment.CreateSegmentParams{
+		FlagID: int64(1),
+		Body: &models.CreateSegmentRequest{
+			Description:    util.StringPtr("segment1"),
+			RolloutPercent: util.Int64Ptr(int64(100)),
+		},
+	})
+
+	res = c.CreateConstraint(constraint.CreateConstraintParams{
+		FlagID:    int64(1),
+		SegmentID: int64(1),
+		Body: &models.CreateConstraintRequest{
+			Operator:  util.StringPtr("EQ"),
+			Property:  util.StringPtr("title"),
+			Value:     util.StringPtr(`say "hi"`),
+			ValueType: util.StringPtr(models.CreateConstraintRequestValueTypeSTRING),
+		},
+	})
+	cons := res.(*constraint.CreateConstraintOK).Payload
+	assert.Equal(t, `say "hi"`, *cons.Value)
+	assert.Equal(t, models.ConstraintValueTypeSTRING, cons.ValueType)
+
+	res = c.PutConstraint(constraint.PutConstraintParams{
+		FlagID:       int64(1),
+		SegmentID:    int64(1),
+		ConstraintID: cons.ID,
+		Body: &models.CreateConstraintRequest{
+			Operator:  util.StringPtr("IN"),
+			Property:  util.StringPtr("state"),
+			Value:     util.StringPtr(`CA, NY`),
+			ValueType: util.StringPtr(models.CreateConstraintRequestValueTypeSTRINGLIST),
+		},
+	})
+	assert.Equal(t, models.ConstraintValueTypeSTRINGLIST, res.(*constraint.PutConstraintOK).Payload.ValueType)
+
+	// an absent valueType keeps the stored one
+	res = c.PutConstraint(constraint.PutConstraintParams{
+		FlagID:       int64(1),
+		SegmentID:    int64(1),
+		ConstraintID: cons.ID,
+		Body: &models.CreateConstraintRequest{
+			Operator: util.StringPtr("IN"),
+			Property: util.StringPtr("state"),
+			Value:    util.StringPtr(`CA, NY, TX`),
+		},
+	})
+	assert.Equal(t, models.ConstraintValueTypeSTRINGLIST, res.(*constraint.PutConstraintOK).Payload.ValueType)
+
+	// an empty valueType makes the value a literal
+	res = c.PutConstraint(constraint.PutConstraintParams{
+		FlagID:       int64(1),
+		SegmentID:    int64(1),
+		ConstraintID: cons.ID,
+		Body: &models.CreateConstraintRequest{
+			Operator:  util.StringPtr("IN"),
+			Property:  util.StringPtr("state"),
+			Value:     util.StringPtr(`["CA", "NY"]`),
+			ValueType: util.StringPtr(models.CreateConstraintRequestValueTypeEmpty),
+		},
+	})
+	assert.Equal(t, "", res.(*constraint.PutConstraintOK).Payload.ValueType)
+
+	res = c.CreateConstraint(constraint.CreateConstraintParams{
+		FlagID:    int64(1),
+		SegmentID: int64(1),
+		Body: &models.CreateConstraintRequest{
+			Operator:  util.StringPtr("GT"),
+			Property:  util.StringPtr("age"),
+			Value:     util.StringPtr(`twenty`),
+			ValueType: util.StringPtr(models.CreateConstraintRequestValueTypeNUMBER),
+		},
+	})
+	assert.Contains(t, *res.(*constraint.CreateConstraintDefault).Payload.Message, "invalid number twenty")
+
+	res = c.CreateConstraint(constraint.CreateConstraintParams{
+		FlagID:    int64(1),
+		SegmentID: int64(1),
+		Body: &models.CreateConstraintRequest{
+			Operator:  util.StringPtr("GT"),
+			Property:  util.StringPtr("age"),
+			Value:     util.StringPtr(`20, 30`),
+			ValueType: util.StringPtr(models.CreateConstraintRequestValueTypeNUMBERLIST),
+		},
+	})
+	assert.Contains(t, *res.(*constraint.CreateConstraintDefault).Payload.Message, "not supported by operator GT")
+}
+
+func TestCrudConstraintGroup(t *testing.T) {
+	var res middleware.Responder
+	db := entity.NewTestDB()
+	c := &crud{}
+
+	defer db.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+
+	c.CreateFlag(flag.CreateFlagParams{
+		Body: &models.CreateFlagRequest{
+			Description: util.StringPtr("funny flag"),
+		},
+	})
+	c.CreateSegment(segment.CreateSegmentParams{
+		FlagID: int64(1),
+		Body: &models.CreateSegmentRequest{
+			Description:    util.StringPtr("segment1"),
+			RolloutPercent: util.Int64Ptr(int64(100)),
+		},
+	})
+	for _, state := range []string{`"NY"`, `"CA"`} {
+		c.CreateConstraint(constraint.CreateConstraintParams{
+			FlagID:    int64(1),
+			SegmentID: int64(1),
+			Body: &models.CreateConstraintRequest{
+				Operator: util.StringPtr("EQ"),
+				Property: util.StringPtr("state"),
+				Value:    util.StringPtr(state),
+			},
+		})
+	}
+
+	t.Run("it should reject a group referencing other constraints", func(t *testing.T) {
+		res = c.PutSegment(segment.PutSegmentParams{
+			FlagID:    int64(1),
+			SegmentID: int64(1),
+			Body: &models.PutSegmentRequest{
+				Description:    util.StringPtr("segment1"),
+				RolloutPercent: util.Int64Ptr(int64(100)),
+				ConstraintGroup: &models.ConstraintGroup{
+					Operator: "OR",
+					Children: []*models.ConstraintGroup{{ConstraintID: 1}, {ConstraintID: 3}},
+				},
+			},
+		})
+		assert.Contains(t, *res.(*segment.PutSegmentDefault).Payload.Message, "constraintID 3 is not a constraint of the segment")
+	})
+
+	t.Run("it should put a group and keep it when absent", func(t *testing.T) {
+		res = c.PutSegment(segment.PutSegmentParams{
+			FlagID:    int64(1),
+			SegmentID: int64(1),
+			Body: &models.PutSegmentRequest{
+				Description:    util.StringPtr("segment1"),
+				RolloutPercent: util.Int64Ptr(int64(100)),
+				ConstraintGroup: &models.ConstraintGroup{
+					Operator: "OR",
+					Children: []*models.ConstraintGroup{{ConstraintID: 1}, {ConstraintID: 2}},
+				},
+			},
+		})
+		assert.Len(t, res.(*segment.PutSegmentOK).Payload.ConstraintGroup.Children, 2)
+
+		res = c.PutSegment(segment.PutSegmentParams{
+			FlagID:    int64(1),
+			SegmentID: int64(1),
+			Body: &models.PutSegmentRequest{
+				Description:    util.StringPtr("segment1"),
+				RolloutPercent: util.Int64Ptr(int64(50)),
+			},
+		})
+		assert.Len(t, res.(*segment.PutSegmentOK).Payload.ConstraintGroup.Children, 2)
+	})
+
+	t.Run("it should remove a deleted constraint from the group", func(t *testing.T) {
+		res = c.DeleteConstraint(constraint.DeleteConstraintParams{
+			FlagID:       int64(1),
+			SegmentID:    int64(1),
+			ConstraintID: int64(2),
+		})
+		assert.NotNil(t, res.(*constraint.DeleteConstraintOK))
+
+		s := entity.Segment{}
+		db.First(&s, 1)
+		assert.Equal(t, entity.ConstraintGroup{
+			Operator: entity.ConstraintGroupOR,
+			Children: []entity.ConstraintGroup{{ConstraintID: 1}},
+		}, s.ConstraintGroup)
+	})
+
+	t.Run("it should clear the group when empty", func(t *testing.T) {
+		res = c.PutSegment(segment.PutSegmentParams{
+			FlagID:    int64(1),
+			SegmentID: int64(1),
+			Body: &models.PutSegmentRequest{
+				Description:     util.StringPtr("segment1"),
+				RolloutPercent:  util.Int64Ptr(int64(100)),
+				ConstraintGroup: &models.ConstraintGroup{},
+			},
+		})
+		assert.Nil(t, res.(*segment.PutSegmentOK).Payload.ConstraintGroup)
+	})
+}
+
 func TestCrudConstraintsFailures(t *testing.T) {
 	var res middleware.Responder
 	db := entity.NewTestDB()
@@ -762,10 +1036,8 @@ func TestCrudVariantsWithFailures(t *testing.T) {
 			FlagID:    int64(1),
 			VariantID: int64(1),
 			Body: &models.PutVariantRequest{
-				Key: util.StringPtr("another_control"),
-				Attachment: map[string]interface{}{
-					"invalid_int_value": 1,
-				},
+				Key:        util.StringPtr("another_control"),
+				Attachment: "not_a_json_object",
 			},
 		})
 		assert.NotZero(t, *res.(*variant.PutVariantDefault).Payload)
@@ -959,3 +1231,106 @@ func TestCrudDistributionsWithFailures(t *testing.T) {
 		db.Error = nil
 	})
 }
+
+func TestCrudAttachmentSchema(t *testing.T) {
+	var res middleware.Responder
+	db := entity.NewTestDB()
+	c := &crud{}
+
+	defer db.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+
+	c.CreateFlag(flag.CreateFlagParams{
+		Body: &models.CreateFlagRequest{
+			Description: util.StringPtr("funny flag"),
+		},
+	})
+	res = c.CreateVariant(variant.CreateVariantParams{
+		FlagID: int64(1),
+		Body: &models.CreateVariantRequest{
+			Key:        util.StringPtr("control"),
+			Attachment: map[string]interface{}{"color": "red", "limit": 10.0},
+		},
+	})
+	assert.Equal(t, entity.Attachment{"color": "red", "limit": 10.0}, res.(*variant.CreateVariantOK).Payload.Attachment)
+
+	putSchema := func(schema interface{}) middleware.Responder {
+		return c.PutFlag(flag.PutFlagParams{
+			FlagID: int64(1),
+			Body: &models.PutFlagRequest{
+				Description:      util.StringPtr("funny flag"),
+				AttachmentSchema: schema,
+			},
+		})
+	}
+	schema := map[string]interface{}{
+		"type":     "object",
+		"required": []interface{}{"limit"},
+		"properties": map[string]interface{}{
+			"limit": map[string]interface{}{"type": "integer", "minimum": 1.0},
+			"tags":  map[string]interface{}{"type": "array", "items": map[string]interface{}{"type": "string"}},
+		},
+	}
+
+	t.Run("it should reject invalid schemas", func(t *testing.T) {
+		res = putSchema("not_a_json_object")
+		assert.Contains(t, *res.(*flag.PutFlagDefault).Payload.Message, "should be a JSON object")
+
+		res = putSchema(map[string]interface{}{"type": "foo"})
+		assert.Contains(t, *res.(*flag.PutFlagDefault).Payload.Message, "invalid attachment schema")
+	})
+
+	t.Run("it should reject the schema that existing variants don't match", func(t *testing.T) {
+		res = putSchema(map[string]interface{}{"required": []interface{}{"size"}})
+		assert.Contains(t, *res.(*flag.PutFlagDefault).Payload.Message, "variant 'control'")
+	})
+
+	t.Run("it should validate the variant attachments against the schema", func(t *testing.T) {
+		res = putSchema(schema)
+		assert.Equal(t, entity.AttachmentSchema(schema), res.(*flag.PutFlagOK).Payload.AttachmentSchema)
+
+		res = c.CreateVariant(variant.CreateVariantParams{
+			FlagID: int64(1),
+			Body: &models.CreateVariantRequest{
+				Key:        util.StringPtr("treatment"),
+				Attachment: map[string]interface{}{"limit": 0.0, "tags": []interface{}{"a", 1.0}},
+			},
+		})
+		assert.Contains(t, *res.(*variant.CreateVariantDefault).Payload.Message, "limit in body should be greater than or equal to 1")
+		assert.Contains(t, *res.(*variant.CreateVariantDefault).Payload.Message, "tags in body must be of type string")
+
+		res = c.CreateVariant(variant.CreateVariantParams{
+			FlagID: int64(1),
+			Body: &models.CreateVariantRequest{
+				Key:        util.StringPtr("treatment"),
+				Attachment: map[string]interface{}{"limit": 5.0, "tags": []interface{}{"a"}},
+			},
+		})
+		assert.NotZero(t, res.(*variant.CreateVariantOK).Payload.ID)
+
+		res = c.PutVariant(variant.PutVariantParams{
+			FlagID:    int64(1),
+			VariantID: int64(1),
+			Body: &models.PutVariantRequest{
+				Key:        util.StringPtr("control"),
+				Attachment: map[string]interface{}{"color": "blue"},
+			},
+		})
+		assert.Contains(t, *res.(*variant.PutVariantDefault).Payload.Message, "limit in body is required")
+	})
+
+	t.Run("it should remove the schema with an empty object", func(t *testing.T) {
+		res = putSchema(map[string]interface{}{})
+		assert.Nil(t, res.(*flag.PutFlagOK).Payload.AttachmentSchema)
+
+		res = c.PutVariant(variant.PutVariantParams{
+			FlagID:    int64(1),
+			VariantID: int64(1),
+			Body: &models.PutVariantRequest{
+				Key:        util.StringPtr("control"),
+				Attachment: map[string]interface{}{"color": "blue"},
+			},
+		})
+		assert.NotZero(t, res.(*variant.PutVariantOK).Payload.ID)
+	})
+}
@@ -0,0 +1,77 @@
+package handler
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/checkr/flagr/pkg/config"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	singletonEnrichers     []Enricher
+	singletonEnrichersOnce sync.Once
+)
+
+// Enricher derives properties of the entity, e.g. $geo.country, into the entityContext before
+// the evaluation
+type Enricher interface {
+	// Enrich returns the properties derived from the entityContext and the evaluation request,
+	// nil if there's none. The request can be nil
+	Enrich(entityContext map[string]interface{}, r *http.Request) map[string]interface{}
+}
+
+// GetEnrichers gets the enabled enrichers, in the order they enrich the entityContext
+var GetEnrichers = func() []Enricher {
+	singletonEnrichersOnce.Do(func() {
+		if path := config.Config.GeoIPDBPath; path != "" {
+			e, err := newGeoEnricher(path)
+			if err != nil {
+				logrus.WithField("err", err).Errorf("failed to load the GeoIP DB %s, geo enrichment is disabled", path)
+			} else {
+				singletonEnrichers = append(singletonEnrichers, e)
+			}
+		}
+		if config.Config.UserAgentEnrichmentEnabled {
+			singletonEnrichers = append(singletonEnrichers, &userAgentEnricher{})
+		}
+	})
+	return singletonEnrichers
+}
+
+// enrichEntityContext returns a copy of the entityContext with the properties derived by the
+// enrichers. The properties that the entityContext already has are kept, so that clients can
+// override them
+func enrichEntityContext(entityContext interface{}, r *http.Request) interface{} {
+	enrichers := GetEnrichers()
+	if len(enrichers) == 0 {
+		return entityContext
+	}
+	m, ok := entityContext.(map[string]interface{})
+	if !ok && entityContext != nil {
+		return entityContext
+	}
+
+	var ret map[string]interface{}
+	for _, e := range enrichers {
+		for k, v := range e.Enrich(m, r) {
+			if _, ok := m[k]; ok {
+				continue
+			}
+			if _, ok := ret[k]; ok {
+				continue
+			}
+			if ret == nil {
+				ret = make(map[string]interface{}, len(m)+4)
+				for k, v := range m {
+					ret[k] = v
+				}
+			}
+			ret[k] = v
+		}
+	}
+	if ret == nil {
+		return entityContext
+	}
+	return ret
+}
@@ -4,6 +4,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/rand"
+	"net/http"
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/checkr/flagr/pkg/config"
@@ -15,13 +18,15 @@ import (
 	"github.com/bsm/ratelimit"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/go-openapi/runtime/middleware"
-	"github.com/zhouzhuojie/conditions"
 )
 
 // Eval is the Eval interface
 type Eval interface {
 	PostEvaluation(evaluation.PostEvaluationParams) middleware.Responder
 	PostEvaluationBatch(evaluation.PostEvaluationBatchParams) middleware.Responder
+	PostEvaluationCompact(evaluation.PostEvaluationCompactParams) middleware.Responder
+	PostEvaluationBatchCompact(evaluation.PostEvaluationBatchCompactParams) middleware.Responder
+	GetEvaluation(evaluation.GetEvaluationParams) middleware.Responder
 }
 
 // NewEval creates a new Eval instance
@@ -38,6 +43,7 @@ func (e *eval) PostEvaluation(params evaluation.PostEvaluationParams) middleware
 			ErrorMessage("empty body"))
 	}
 
+	evalContext.EntityContext = enrichEntityContext(evalContext.EntityContext, params.HTTPRequest)
 	evalResult := evalFlag(*evalContext)
 	resp := evaluation.NewPostEvaluationOK()
 	resp.SetPayload(evalResult)
@@ -45,42 +51,186 @@ func (e *eval) PostEvaluation(params evaluation.PostEvaluationParams) middleware
 }
 
 func (e *eval) PostEvaluationBatch(params evaluation.PostEvaluationBatchParams) middleware.Responder {
-	entities := params.Body.Entities
-	flagIDs := params.Body.FlagIds
-	flagKeys := params.Body.FlagKeys
 	results := &models.EvaluationBatchResponse{}
+	for _, entityResults := range evalBatch(params.Body, params.HTTPRequest) {
+		results.EvaluationResults = append(results.EvaluationResults, entityResults...)
+	}
+
+	resp := evaluation.NewPostEvaluationBatchOK()
+	resp.SetPayload(results)
+	return resp
+}
+
+func (e *eval) PostEvaluationCompact(params evaluation.PostEvaluationCompactParams) middleware.Responder {
+	evalContext := params.Body
+	if evalContext == nil {
+		return evaluation.NewPostEvaluationCompactDefault(400).WithPayload(
+			ErrorMessage("empty body"))
+	}
+
+	evalContext.EntityContext = enrichEntityContext(evalContext.EntityContext, params.HTTPRequest)
+	evalResult := evalFlag(*evalContext)
+	compact := compactEvalResults([]*models.EvalResult{evalResult})
+	resp := evaluation.NewPostEvaluationCompactOK()
+	resp.SetPayload(&compact)
+	return resp
+}
+
+func (e *eval) PostEvaluationBatchCompact(params evaluation.PostEvaluationBatchCompactParams) middleware.Responder {
+	results := &models.EvaluationBatchCompactResponse{EvaluationResults: []*models.EvaluationCompactResponse{}}
+	for _, entityResults := range evalBatch(params.Body, params.HTTPRequest) {
+		compact := compactEvalResults(entityResults)
+		results.EvaluationResults = append(results.EvaluationResults, &compact)
+	}
+
+	resp := evaluation.NewPostEvaluationBatchCompactOK()
+	resp.SetPayload(results)
+	return resp
+}
+
+// evalBatch evaluates the flags of the batch request for each entity, in the order of the entities
+func evalBatch(body *models.EvaluationBatchRequest, r *http.Request) [][]*models.EvalResult {
+	results := make([][]*models.EvalResult, 0, len(body.Entities))
 
 	// TODO make it concurrent
-	for _, entity := range entities {
-		for _, flagID := range flagIDs {
+	for _, entity := range body.Entities {
+		entityContext := enrichEntityContext(entity.EntityContext, r)
+		entityResults := []*models.EvalResult{}
+		for _, flagID := range body.FlagIds {
 			evalContext := models.EvalContext{
-				EnableDebug:   params.Body.EnableDebug,
-				EntityContext: entity.EntityContext,
+				EnableDebug:   body.EnableDebug,
+				EntityContext: entityContext,
 				EntityID:      entity.EntityID,
 				EntityType:    entity.EntityType,
 				FlagID:        flagID,
 			}
 			evalResult := evalFlag(evalContext)
-			results.EvaluationResults = append(results.EvaluationResults, evalResult)
+			entityResults = append(entityResults, evalResult)
 		}
-		for _, flagKey := range flagKeys {
+		for _, flagKey := range body.FlagKeys {
 			evalContext := models.EvalContext{
-				EnableDebug:   params.Body.EnableDebug,
-				EntityContext: entity.EntityContext,
+				EnableDebug:   body.EnableDebug,
+				EntityContext: entityContext,
 				EntityID:      entity.EntityID,
 				EntityType:    entity.EntityType,
 				FlagKey:       flagKey,
 			}
 			evalResult := evalFlag(evalContext)
-			results.EvaluationResults = append(results.EvaluationResults, evalResult)
+			entityResults = append(entityResults, evalResult)
 		}
+		results = append(results, entityResults)
 	}
+	return results
+}
 
-	resp := evaluation.NewPostEvaluationBatchOK()
-	resp.SetPayload(results)
+func (e *eval) GetEvaluation(params evaluation.GetEvaluationParams) middleware.Responder {
+	evalContext := models.EvalContext{
+		EnableDebug:   params.EnableDebug != nil && *params.EnableDebug,
+		EntityContext: map[string]interface{}{},
+		EntityID:      util.SafeString(params.EntityID),
+		EntityType:    util.StringPtr(util.SafeString(params.EntityType)),
+		FlagKey:       params.FlagKey,
+	}
+	if params.HTTPRequest != nil {
+		evalContext.EntityContext = queryEntityContext(params.HTTPRequest.URL.Query())
+	}
+	evalContext.EntityContext = enrichEntityContext(evalContext.EntityContext, params.HTTPRequest)
+
+	evalResult := evalFlag(evalContext)
+	resp := evaluation.NewGetEvaluationOK()
+	resp.SetPayload(evalResult)
+	if isContextFree(GetEvalCache().GetByFlagKeyOrID(params.FlagKey), evalContext) {
+		resp.SetCacheControl(fmt.Sprintf("public, max-age=%d", int(config.Config.EvalCacheControlMaxAge.Seconds())))
+	} else {
+		resp.SetCacheControl("no-cache")
+	}
 	return resp
 }
 
+// compactEvalResults maps the evaluation results to the variant keys and attachments keyed by flagKey
+func compactEvalResults(rs []*models.EvalResult) models.EvaluationCompactResponse {
+	m := models.EvaluationCompactResponse{}
+	for _, r := range rs {
+		flagKey := util.SafeString(r.FlagKey)
+		if flagKey == "" && r.EvalContext != nil {
+			flagKey = r.EvalContext.FlagKey
+		}
+		if flagKey == "" {
+			continue
+		}
+		c := models.CompactEvalResult{}
+		if r.VariantKey != nil {
+			c.VariantKey = *r.VariantKey
+			c.VariantAttachment = r.VariantAttachment
+		}
+		m[flagKey] = c
+	}
+	return m
+}
+
+// queryEntityContextPrefix is the prefix of the query parameters mapped into the entity context
+const queryEntityContextPrefix = "ctx."
+
+// queryEntityContext maps the query parameters prefixed with ctx. into the entity context,
+// inferring numbers and booleans from the values the way they would be decoded from JSON
+func queryEntityContext(query url.Values) map[string]interface{} {
+	m := make(map[string]interface{})
+	for k, vs := range query {
+		if !strings.HasPrefix(k, queryEntityContextPrefix) || len(vs) == 0 {
+			continue
+		}
+		v := vs[len(vs)-1]
+		var i interface{}
+		switch v {
+		case "true":
+			i = true
+		case "false":
+			i = false
+		default:
+			var f float64
+			if err := json.Unmarshal([]byte(v), &f); err == nil {
+				i = f
+			} else {
+				i = v
+			}
+		}
+		m[strings.TrimPrefix(k, queryEntityContextPrefix)] = i
+	}
+	return m
+}
+
+// isContextFree checks whether the evaluation result of the flag is determined by
+// the flag and the entityID alone, so that it's the same for the same request
+func isContextFree(f *entity.Flag, evalContext models.EvalContext) bool {
+	if f == nil || !f.Enabled || len(f.Segments) == 0 {
+		return true
+	}
+	if evalContext.EntityID == "" || f.BucketBy != "" {
+		return false
+	}
+	for _, s := range f.Segments {
+		if len(s.Constraints) != 0 || s.AudienceID != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// bucketEntityID returns the ID that the entity is bucketed by, which is the value of the bucketBy
+// property of the flag in the entityContext, or the entityID if the flag has no bucketBy or the value
+// is missing. The message explains the fallback
+func bucketEntityID(f *entity.Flag, evalContext models.EvalContext) (bucketID string, msg string) {
+	if f.BucketBy == "" {
+		return evalContext.EntityID, ""
+	}
+	m, _ := evalContext.EntityContext.(map[string]interface{})
+	if v, ok := m[f.BucketBy]; ok && v != nil && util.SafeString(v) != "" {
+		return util.SafeString(v), ""
+	}
+	return evalContext.EntityID, fmt.Sprintf(
+		"bucketBy %s is missing in the entityContext, bucketed by the entityID. ", f.BucketBy)
+}
+
 // BlankResult creates a blank result
 func BlankResult(f *entity.Flag, evalContext models.EvalContext, msg string) *models.EvalResult {
 	flagID := uint(0)
@@ -123,20 +273,38 @@ var evalFlag = func(evalContext models.EvalContext) *models.EvalResult {
 		return BlankResult(f, evalContext, fmt.Sprintf("flagID %v is not enabled", f.ID))
 	}
 
+	if r := evalOverride(f, evalContext); r != nil {
+		logEvalResult(r, f.DataRecordsEnabled)
+		return r
+	}
+
 	if len(f.Segments) == 0 {
 		return BlankResult(f, evalContext, fmt.Sprintf("flagID %v has no segments", f.ID))
 	}
 
-	if evalContext.EntityID == "" {
+	entityIDProvided := evalContext.EntityID != ""
+	if !entityIDProvided {
 		evalContext.EntityID = fmt.Sprintf("randomly_generated_%d", rand.Int31())
 	}
 
+	// the segments bucket the entity by the bucketID, the evalContext is still logged with the entityID
+	bucketID, bucketMsg := bucketEntityID(f, evalContext)
+	bucketContext := evalContext
+	bucketContext.EntityID = bucketID
+
+	if ok, num := f.InLayerBuckets(bucketID); !ok {
+		return BlankResult(f, evalContext, bucketMsg+fmt.Sprintf(
+			"entity is in bucket %v of layerID %v, out of the buckets [%v, %v) of flagID %v",
+			num, f.LayerID, f.LayerBucketStart, f.LayerBucketEnd, f.ID,
+		))
+	}
+
 	logs := []*models.SegmentDebugLog{}
 	var vID *int64
 	var sID *int64
 
 	for _, segment := range f.Segments {
-		variantID, log := evalSegment(f.ID, evalContext, segment)
+		variantID, log := evalSegment(f.Bucketing(), bucketContext, segment)
 		if evalContext.EnableDebug {
 			logs = append(logs, log)
 		}
@@ -146,7 +314,12 @@ var evalFlag = func(evalContext models.EvalContext) *models.EvalResult {
 			break
 		}
 	}
-	evalResult := BlankResult(f, evalContext, "")
+	stickyMsg := ""
+	if f.StickyAssignments && (entityIDProvided || bucketID != evalContext.EntityID) {
+		vID, stickyMsg = stickyAssign(f, bucketID, sID, vID)
+	}
+
+	evalResult := BlankResult(f, evalContext, bucketMsg+stickyMsg)
 	evalResult.EvalDebugLog.SegmentDebugLogs = logs
 	evalResult.SegmentID = sID
 	evalResult.VariantID = vID
@@ -156,10 +329,40 @@ var evalFlag = func(evalContext models.EvalContext) *models.EvalResult {
 		evalResult.VariantKey = util.StringPtr(v.Key)
 	}
 
+	if config.Config.SRMEnabled && vID != nil {
+		GetAssignmentCounter().Incr(f.ID, util.SafeUint(sID), util.SafeUint(vID))
+	}
+	if config.Config.AnalysisEnabled && f.DataRecordsEnabled && entityIDProvided && vID != nil {
+		GetAssignmentRecorder().Record(f.ID, evalContext.EntityID, util.SafeUint(sID), util.SafeUint(vID))
+	}
+
 	logEvalResult(evalResult, f.DataRecordsEnabled)
 	return evalResult
 }
 
+// evalOverride evaluates the override of the entity, which takes precedence over the
+// layer and the segments. It's nil if the entity has no override
+func evalOverride(f *entity.Flag, evalContext models.EvalContext) *models.EvalResult {
+	if evalContext.EntityID == "" {
+		return nil
+	}
+	o := f.GetOverride(util.SafeString(evalContext.EntityType), evalContext.EntityID, time.Now())
+	if o == nil {
+		return nil
+	}
+	v := f.FlagEvaluation.VariantsMap[o.VariantID]
+	if v == nil {
+		return nil
+	}
+
+	r := BlankResult(f, evalContext, fmt.Sprintf(
+		"override applied. overrideID %v of entityID %s, variantID %v. ", o.ID, o.EntityID, o.VariantID))
+	r.VariantID = util.Int64Ptr(int64(v.ID))
+	r.VariantKey = util.StringPtr(v.Key)
+	r.VariantAttachment = v.Attachment
+	return r
+}
+
 var logEvalResult = func(r *models.EvalResult, dataRecordsEnabled bool) {
 	if config.Config.EvalLoggingEnabled {
 		rateLimitPerFlagConsoleLogging(r)
@@ -173,14 +376,14 @@ var logEvalResult = func(r *models.EvalResult, dataRecordsEnabled bool) {
 }
 
 var evalSegment = func(
-	flagID uint,
+	bucketing entity.Bucketing,
 	evalContext models.EvalContext,
 	segment entity.Segment,
 ) (
 	vID *uint, // returns VariantID
 	log *models.SegmentDebugLog,
 ) {
-	if len(segment.Constraints) != 0 {
+	if segment.SegmentEvaluation.ConditionsExpr != nil {
 		m, ok := evalContext.EntityContext.(map[string]interface{})
 		if !ok {
 			log = &models.SegmentDebugLog{
@@ -190,28 +393,21 @@ var evalSegment = func(
 			return nil, log
 		}
 
-		expr := segment.SegmentEvaluation.ConditionsExpr
-		match, err := conditions.Evaluate(expr, m)
-		if err != nil {
-			log = &models.SegmentDebugLog{
-				Msg:       err.Error(),
-				SegmentID: int64(segment.ID),
-			}
-			return nil, log
-		}
+		se := segment.SegmentEvaluation
+		match, errs := entity.EvaluateConditions(se.ConditionsExpr, se.ConditionsMatchers, m)
 		if !match {
 			log = &models.SegmentDebugLog{
-				Msg:       debugConstraintMsg(expr, m),
+				Msg:       debugConstraintMsg(se.ConditionsDescription, m, errs),
 				SegmentID: int64(segment.ID),
 			}
 			return nil, log
 		}
 	}
 
-	vID, debugMsg := segment.SegmentEvaluation.DistributionArray.Rollout(
+	vID, debugMsg := segment.SegmentEvaluation.DistributionArray.RolloutWithBucketing(
 		evalContext.EntityID,
-		fmt.Sprint(flagID), // default use the flagID as salt
-		segment.RolloutPercent,
+		bucketing,
+		segment.RolloutInBasisPoints(),
 	)
 
 	log = &models.SegmentDebugLog{
@@ -222,8 +418,12 @@ var evalSegment = func(
 	return vID, log
 }
 
-func debugConstraintMsg(expr conditions.Expr, m map[string]interface{}) string {
-	return fmt.Sprintf("constraint not match. constraint: %s, entity_context: %+v.", expr, m)
+func debugConstraintMsg(desc string, m map[string]interface{}, errs []error) string {
+	msg := fmt.Sprintf("constraint not match. constraint: %s, entity_context: %+v.", desc, m)
+	for _, err := range errs {
+		msg += fmt.Sprintf(" %s.", err)
+	}
+	return msg
 }
 
 var rateLimitMap = make(map[uint]*ratelimit.RateLimiter)
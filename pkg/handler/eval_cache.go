@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"sort"
 	"sync"
 	"time"
 
@@ -21,10 +22,27 @@ type EvalCache struct {
 	mapCache     map[string]*entity.Flag
 	mapCacheLock sync.RWMutex
 
+	idLists     map[string]*cachedIDList
+	idListsLock sync.RWMutex
+
 	refreshTimeout  time.Duration
 	refreshInterval time.Duration
 }
 
+// cachedIDList is the IDs of an ID list in the EvalCache, reloaded when the list is updated
+type cachedIDList struct {
+	id      uint
+	version uint
+	set     entity.IDListSet
+}
+
+// the IN_LIST constraints look up the ID lists in the EvalCache
+func init() {
+	entity.IDListLookup = func(key string) (entity.IDListSet, bool) {
+		return GetEvalCache().GetIDList(key)
+	}
+}
+
 // GetEvalCache gets the EvalCache
 var GetEvalCache = func() *EvalCache {
 	singletonEvalCacheOnce.Do(func() {
@@ -62,6 +80,63 @@ func (ec *EvalCache) GetByFlagKeyOrID(keyOrID interface{}) *entity.Flag {
 	return f
 }
 
+// GetFlags gets all the flags in the cache ordered by ID
+func (ec *EvalCache) GetFlags() []*entity.Flag {
+	ec.mapCacheLock.RLock()
+	fs := make([]*entity.Flag, 0, len(ec.mapCache)/2)
+	for k, f := range ec.mapCache {
+		if k == util.SafeString(f.ID) {
+			fs = append(fs, f)
+		}
+	}
+	ec.mapCacheLock.RUnlock()
+	sort.Slice(fs, func(i, j int) bool { return fs[i].ID < fs[j].ID })
+	return fs
+}
+
+// GetIDList gets the IDs of the ID list by key
+func (ec *EvalCache) GetIDList(key string) (entity.IDListSet, bool) {
+	ec.idListsLock.RLock()
+	l, ok := ec.idLists[key]
+	ec.idListsLock.RUnlock()
+	if !ok {
+		return nil, false
+	}
+	return l.set, true
+}
+
+// reloadIDLists reloads the IDs of the ID lists updated since the last reload, the others
+// are kept as they are because the lists can be large
+func (ec *EvalCache) reloadIDLists() error {
+	ls := []entity.IDList{}
+	if err := entity.NewIDListQuerySet(getDB()).All(&ls); err != nil {
+		return err
+	}
+
+	ec.idListsLock.RLock()
+	old := ec.idLists
+	ec.idListsLock.RUnlock()
+
+	m := make(map[string]*cachedIDList, len(ls))
+	for i := range ls {
+		l := &ls[i]
+		if c, ok := old[l.Key]; ok && c.id == l.ID && c.version == l.Version {
+			m[l.Key] = c
+			continue
+		}
+		set, err := l.IDSet(getDB())
+		if err != nil {
+			return err
+		}
+		m[l.Key] = &cachedIDList{id: l.ID, version: l.Version, set: set}
+	}
+
+	ec.idListsLock.Lock()
+	ec.idLists = m
+	ec.idListsLock.Unlock()
+	return nil
+}
+
 var fetchAllFlags = func() ([]entity.Flag, error) {
 	// Use eager loading to avoid N+1 problem
 	// doc: http://jinzhu.me/gorm/crud.html#preloading-eager-loading
@@ -72,8 +147,33 @@ var fetchAllFlags = func() ([]entity.Flag, error) {
 		}).Preload("Constraints", func(db *gorm.DB) *gorm.DB {
 			return db.Order("created_at ASC")
 		}).Order("rank ASC").Order("id ASC")
-	}).Preload("Variants").Find(&fs).Error
-	return fs, err
+	}).Preload("Variants").Preload("Overrides", func(db *gorm.DB) *gorm.DB {
+		return db.Order("id ASC")
+	}).Find(&fs).Error
+	if err != nil {
+		return fs, err
+	}
+
+	// the audiences are shared by the segments, so they are loaded once and attached
+	as := []entity.Audience{}
+	err = getDB().Preload("Constraints", func(db *gorm.DB) *gorm.DB {
+		return db.Order("created_at ASC")
+	}).Find(&as).Error
+	if err != nil {
+		return fs, err
+	}
+	am := make(map[uint]*entity.Audience)
+	for i := range as {
+		am[as[i].ID] = &as[i]
+	}
+	for i := range fs {
+		for j := range fs[i].Segments {
+			if s := &fs[i].Segments[j]; s.AudienceID != 0 {
+				s.Audience = am[s.AudienceID]
+			}
+		}
+	}
+	return fs, nil
 }
 
 func (ec *EvalCache) reloadMapCache() error {
@@ -81,10 +181,30 @@ func (ec *EvalCache) reloadMapCache() error {
 		defer config.Global.NewrelicApp.StartTransaction("eval_cache_reload", nil, nil).End()
 	}
 
+	if err := ec.reloadIDLists(); err != nil {
+		return err
+	}
+
 	fs, err := fetchAllFlags()
 	if err != nil {
 		return err
 	}
+	// a flag that can't be evaluated, e.g. its segment uses a deleted audience, is left out
+	// with an error log, so that it doesn't block the reload of all the other flags
+	prepared := fs[:0]
+	for i := range fs {
+		if err := fs[i].PrepareEvaluation(); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"err":     err,
+				"flagID":  fs[i].ID,
+				"flagKey": fs[i].Key,
+			}).Error("failed to prepare the flag for evaluation, skipping it in the eval cache")
+			continue
+		}
+		prepared = append(prepared, fs[i])
+	}
+	fs = prepared
+
 	m := make(map[string]*entity.Flag)
 	for i := range fs {
 		ptr := &fs[i]
@@ -96,15 +216,13 @@ func (ec *EvalCache) reloadMapCache() error {
 		}
 	}
 
-	for _, f := range m {
-		err := f.PrepareEvaluation()
-		if err != nil {
-			return err
-		}
-	}
-
 	ec.mapCacheLock.Lock()
+	old := ec.mapCache
 	ec.mapCache = m
 	ec.mapCacheLock.Unlock()
+
+	if s := GetFlagStream(); s.hasSubscribers() {
+		s.publish(flagStreamChanges(old, fs))
+	}
 	return nil
 }
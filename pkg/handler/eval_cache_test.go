@@ -20,3 +20,22 @@ func TestGetByFlagKeyOrID(t *testing.T) {
 	f := ec.GetByFlagKeyOrID(fixtureFlag.ID)
 	assert.Equal(t, f.ID, fixtureFlag.ID)
 }
+
+func TestReloadMapCacheSkipsInvalidFlags(t *testing.T) {
+	fixtureFlag := entity.GenFixtureFlag()
+	invalidFlag := entity.GenFixtureFlag()
+	invalidFlag.ID = 101
+	invalidFlag.Key = "flag_key_101"
+	invalidFlag.Segments[0].Constraints[0].Operator = "INVALID"
+	db := entity.NewTestDB()
+	defer db.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+	defer gostub.StubFunc(&fetchAllFlags, []entity.Flag{fixtureFlag, invalidFlag}, nil).Reset()
+
+	ec := &EvalCache{}
+	assert.NoError(t, ec.reloadMapCache())
+	assert.NotNil(t, ec.GetByFlagKeyOrID(fixtureFlag.ID))
+	assert.NotNil(t, ec.GetByFlagKeyOrID(fixtureFlag.Key))
+	assert.Nil(t, ec.GetByFlagKeyOrID(invalidFlag.ID))
+	assert.Nil(t, ec.GetByFlagKeyOrID(invalidFlag.Key))
+}
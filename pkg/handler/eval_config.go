@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"crypto/sha1"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/checkr/flagr/pkg/entity"
+	"github.com/checkr/flagr/pkg/mapper/entity_restapi/e2r"
+	"github.com/checkr/flagr/swagger_gen/models"
+	"github.com/checkr/flagr/swagger_gen/restapi/operations/evaluation"
+	"github.com/go-openapi/runtime/middleware"
+)
+
+var getEvaluationConfigHandler = func(params evaluation.GetEvaluationConfigParams) middleware.Responder {
+	cfg := &models.EvaluationConfig{Flags: []*models.EvaluationConfigFlag{}}
+	for _, f := range evaluationConfigFlags(params.FlagIds, params.FlagKeys) {
+		cfg.Flags = append(cfg.Flags, e2r.MapEvaluationConfigFlag(f))
+	}
+
+	b, err := json.Marshal(cfg)
+	if err != nil {
+		return evaluation.NewGetEvaluationConfigDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+	etag := fmt.Sprintf(`"%x"`, sha1.Sum(b))
+
+	if params.HTTPRequest != nil && etagMatch(params.HTTPRequest.Header.Get("If-None-Match"), etag) {
+		return evaluation.NewGetEvaluationConfigNotModified()
+	}
+	return evaluation.NewGetEvaluationConfigOK().WithEtag(etag).WithPayload(cfg)
+}
+
+// evaluationConfigFlags gets the flags from the EvalCache matching any of the given IDs or keys,
+// or all the flags if none is given
+func evaluationConfigFlags(flagIDs []int64, flagKeys []string) []*entity.Flag {
+	fs := GetEvalCache().GetFlags()
+	if len(flagIDs) == 0 && len(flagKeys) == 0 {
+		return fs
+	}
+
+	ids := make(map[uint]bool)
+	for _, id := range flagIDs {
+		ids[uint(id)] = true
+	}
+	keys := make(map[string]bool)
+	for _, key := range flagKeys {
+		keys[key] = true
+	}
+
+	ret := []*entity.Flag{}
+	for _, f := range fs {
+		if ids[f.ID] || keys[f.Key] {
+			ret = append(ret, f)
+		}
+	}
+	return ret
+}
+
+// etagMatch checks whether the If-None-Match header matches the etag, weak
+// comparison is used as the etag is only for caching
+func etagMatch(ifNoneMatch string, etag string) bool {
+	for _, t := range strings.Split(ifNoneMatch, ",") {
+		t = strings.TrimPrefix(strings.TrimSpace(t), "W/")
+		if t == "*" || t == etag {
+			return true
+		}
+	}
+	return false
+}
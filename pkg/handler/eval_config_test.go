@@ -0,0 +1,119 @@
+package handler
+
+import (
+	"fmt"
+	"hash/crc32"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/checkr/flagr/pkg/entity"
+	"github.com/checkr/flagr/pkg/util"
+	"github.com/checkr/flagr/swagger_gen/models"
+	"github.com/checkr/flagr/swagger_gen/restapi/operations/evaluation"
+
+	"github.com/go-openapi/runtime"
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/assert"
+)
+
+func getEvaluationConfig(t *testing.T, params evaluation.GetEvaluationConfigParams, ifNoneMatch string) *httptest.ResponseRecorder {
+	params.HTTPRequest = httptest.NewRequest("GET", "/api/v1/evaluation/config", nil)
+	if ifNoneMatch != "" {
+		params.HTTPRequest.Header.Set("If-None-Match", ifNoneMatch)
+	}
+	rec := httptest.NewRecorder()
+	getEvaluationConfigHandler(params).WriteResponse(rec, runtime.JSONProducer())
+	return rec
+}
+
+func TestGetEvaluationConfig(t *testing.T) {
+	defer gostub.StubFunc(&GetEvalCache, GenFixtureEvalCache()).Reset()
+
+	t.Run("all flags", func(t *testing.T) {
+		res := getEvaluationConfigHandler(evaluation.GetEvaluationConfigParams{})
+		cfg := res.(*evaluation.GetEvaluationConfigOK).Payload
+		assert.Len(t, cfg.Flags, 1)
+
+		f := cfg.Flags[0]
+		assert.Equal(t, int64(100), *f.ID)
+		assert.Equal(t, "flag_key_100", *f.Key)
+		assert.Equal(t, "100", *f.Salt)
+		assert.Len(t, f.Variants, 2)
+		assert.Len(t, f.Segments, 1)
+		assert.Equal(t, int64(200), *f.Segments[0].ID)
+		assert.Len(t, f.Segments[0].Constraints, 1)
+		assert.Len(t, f.Segments[0].Distributions, 2)
+		assert.Equal(t, int64(300), *f.Segments[0].Distributions[0].VariantID)
+		assert.Equal(t, int64(50), *f.Segments[0].Distributions[0].Percent)
+	})
+
+	t.Run("filter by keys and IDs", func(t *testing.T) {
+		res := getEvaluationConfigHandler(evaluation.GetEvaluationConfigParams{FlagKeys: []string{"flag_key_100"}})
+		assert.Len(t, res.(*evaluation.GetEvaluationConfigOK).Payload.Flags, 1)
+
+		res = getEvaluationConfigHandler(evaluation.GetEvaluationConfigParams{FlagIds: []int64{100}})
+		assert.Len(t, res.(*evaluation.GetEvaluationConfigOK).Payload.Flags, 1)
+
+		res = getEvaluationConfigHandler(evaluation.GetEvaluationConfigParams{FlagKeys: []string{"flag_key_999"}, FlagIds: []int64{999}})
+		assert.Len(t, res.(*evaluation.GetEvaluationConfigOK).Payload.Flags, 0)
+	})
+
+	t.Run("etag", func(t *testing.T) {
+		rec := getEvaluationConfig(t, evaluation.GetEvaluationConfigParams{}, "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		etag := rec.Header().Get("ETag")
+		assert.NotEmpty(t, etag)
+
+		rec = getEvaluationConfig(t, evaluation.GetEvaluationConfigParams{}, etag)
+		assert.Equal(t, http.StatusNotModified, rec.Code)
+		assert.Empty(t, rec.Body.String())
+
+		rec = getEvaluationConfig(t, evaluation.GetEvaluationConfigParams{}, `"other", W/`+etag)
+		assert.Equal(t, http.StatusNotModified, rec.Code)
+
+		rec = getEvaluationConfig(t, evaluation.GetEvaluationConfigParams{}, `"other"`)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		rec = getEvaluationConfig(t, evaluation.GetEvaluationConfigParams{FlagKeys: []string{"flag_key_999"}}, etag)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.NotEqual(t, etag, rec.Header().Get("ETag"))
+	})
+}
+
+// evalConfigLocally buckets the entity the way the clients evaluate with the config
+func evalConfigLocally(f *models.EvaluationConfigFlag, entityID string) *int64 {
+	s := f.Segments[0]
+	num := int64(crc32.ChecksumIEEE([]byte(*f.Salt+entityID)) % uint32(entity.TotalBucketNum))
+	min := int64(0)
+	for _, d := range s.Distributions {
+		max := min + *d.Percent*int64(entity.PercentMultiplier)
+		if num < max {
+			if *s.RolloutPercent == 100 || 100*(num-min) <= (max-min-1)**s.RolloutPercent {
+				return d.VariantID
+			}
+			return nil
+		}
+		min = max
+	}
+	return nil
+}
+
+func TestEvaluationConfigMatchesEval(t *testing.T) {
+	defer gostub.StubFunc(&GetEvalCache, GenFixtureEvalCache()).Reset()
+	defer gostub.StubFunc(&logEvalResult).Reset()
+
+	res := getEvaluationConfigHandler(evaluation.GetEvaluationConfigParams{})
+	f := res.(*evaluation.GetEvaluationConfigOK).Payload.Flags[0]
+
+	for i := 0; i < 100; i++ {
+		entityID := fmt.Sprintf("entity_%d", i)
+		r := evalFlag(models.EvalContext{
+			EntityID:      entityID,
+			EntityContext: map[string]interface{}{"dl_state": "CA"},
+			FlagID:        100,
+		})
+		assert.NotNil(t, r.VariantID)
+		assert.Equal(t, util.SafeUint(r.VariantID), util.SafeUint(evalConfigLocally(f, entityID)))
+	}
+}
@@ -0,0 +1,222 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+
+	"github.com/checkr/flagr/pkg/config"
+	"github.com/checkr/flagr/pkg/flagrpb"
+	"github.com/checkr/flagr/pkg/util"
+	"github.com/checkr/flagr/swagger_gen/models"
+
+	_struct "github.com/golang/protobuf/ptypes/struct"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// startGRPCServer serves the gRPC evaluation on its own port, next to the REST API
+func startGRPCServer() {
+	addr := net.JoinHostPort(config.Config.Host, fmt.Sprint(config.Config.GRPCPort))
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		logrus.WithField("err", err).Fatalf("failed to listen on %s for the gRPC evaluation server", addr)
+	}
+	logrus.Infof("serving the gRPC evaluation at %s", addr)
+	go func() {
+		if err := newGRPCServer().Serve(lis); err != nil {
+			logrus.WithField("err", err).Error("the gRPC evaluation server stopped")
+		}
+	}()
+}
+
+func newGRPCServer() *grpc.Server {
+	s := grpc.NewServer()
+	flagrpb.RegisterEvaluationServer(s, &evalGRPCServer{})
+	return s
+}
+
+// evalGRPCServer serves the Evaluation service with evalFlag, the same as the REST evaluation endpoints
+type evalGRPCServer struct{}
+
+func (s *evalGRPCServer) Evaluate(ctx context.Context, req *flagrpb.EvalContext) (*flagrpb.EvalResult, error) {
+	evalContext := models.EvalContext{
+		EnableDebug:   req.EnableDebug,
+		EntityContext: enrichEntityContext(structToMap(req.EntityContext), grpcHTTPRequest(ctx)),
+		EntityID:      req.EntityId,
+		EntityType:    util.StringPtr(req.EntityType),
+		FlagID:        req.FlagId,
+		FlagKey:       req.FlagKey,
+	}
+	return grpcEvalResult(evalFlag(evalContext)), nil
+}
+
+// EvaluateBatch streams the results entity by entity, so that clients can consume them before the whole
+// batch is evaluated
+func (s *evalGRPCServer) EvaluateBatch(req *flagrpb.EvaluationBatchRequest, stream flagrpb.Evaluation_EvaluateBatchServer) error {
+	r := grpcHTTPRequest(stream.Context())
+	for _, e := range req.Entities {
+		evalContext := models.EvalContext{
+			EnableDebug:   req.EnableDebug,
+			EntityContext: enrichEntityContext(structToMap(e.EntityContext), r),
+			EntityID:      e.EntityId,
+			EntityType:    util.StringPtr(e.EntityType),
+		}
+		for _, flagID := range req.FlagIds {
+			evalContext.FlagID, evalContext.FlagKey = flagID, ""
+			if err := stream.Send(grpcEvalResult(evalFlag(evalContext))); err != nil {
+				return err
+			}
+		}
+		for _, flagKey := range req.FlagKeys {
+			evalContext.FlagID, evalContext.FlagKey = 0, flagKey
+			if err := stream.Send(grpcEvalResult(evalFlag(evalContext))); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// grpcHTTPRequest carries the client address of the gRPC call for the enrichers, which is the peer
+// address or the x-forwarded-for metadata if the peer is a trusted proxy, see clientIP
+func grpcHTTPRequest(ctx context.Context) *http.Request {
+	r := &http.Request{Header: http.Header{}}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		r.RemoteAddr = p.Addr.String()
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for _, v := range md.Get("x-forwarded-for") {
+			r.Header.Add("X-Forwarded-For", v)
+		}
+	}
+	return r
+}
+
+func grpcEvalResult(r *models.EvalResult) *flagrpb.EvalResult {
+	ret := &flagrpb.EvalResult{
+		FlagId:            int64Value(r.FlagID),
+		FlagKey:           util.SafeString(r.FlagKey),
+		FlagSnapshotId:    r.FlagSnapshotID,
+		SegmentId:         int64Value(r.SegmentID),
+		VariantId:         int64Value(r.VariantID),
+		VariantKey:        util.SafeString(r.VariantKey),
+		VariantAttachment: mapToStruct(r.VariantAttachment),
+		Timestamp:         util.SafeString(r.Timestamp),
+	}
+	if c := r.EvalContext; c != nil {
+		ret.EvalContext = &flagrpb.EvalContext{
+			EntityId:      c.EntityID,
+			EntityType:    util.SafeString(c.EntityType),
+			EntityContext: mapToStruct(c.EntityContext),
+			EnableDebug:   c.EnableDebug,
+			FlagId:        c.FlagID,
+			FlagKey:       c.FlagKey,
+		}
+	}
+	if l := r.EvalDebugLog; l != nil {
+		ret.EvalDebugLog = &flagrpb.EvalDebugLog{Msg: l.Msg}
+		for _, sl := range l.SegmentDebugLogs {
+			ret.EvalDebugLog.SegmentDebugLogs = append(ret.EvalDebugLog.SegmentDebugLogs, &flagrpb.SegmentDebugLog{
+				SegmentId: sl.SegmentID,
+				Msg:       sl.Msg,
+			})
+		}
+	}
+	return ret
+}
+
+func int64Value(p *int64) int64 {
+	if p == nil {
+		return 0
+	}
+	return *p
+}
+
+// structToMap converts the Struct into the JSON-like map of the REST entityContext, nil if it's nil
+func structToMap(s *_struct.Struct) map[string]interface{} {
+	if s == nil {
+		return nil
+	}
+	m := make(map[string]interface{}, len(s.Fields))
+	for k, v := range s.Fields {
+		m[k] = structValue(v)
+	}
+	return m
+}
+
+func structValue(v *_struct.Value) interface{} {
+	switch k := v.GetKind().(type) {
+	case *_struct.Value_NumberValue:
+		return k.NumberValue
+	case *_struct.Value_StringValue:
+		return k.StringValue
+	case *_struct.Value_BoolValue:
+		return k.BoolValue
+	case *_struct.Value_StructValue:
+		return structToMap(k.StructValue)
+	case *_struct.Value_ListValue:
+		l := make([]interface{}, len(k.ListValue.GetValues()))
+		for i, e := range k.ListValue.GetValues() {
+			l[i] = structValue(e)
+		}
+		return l
+	}
+	return nil
+}
+
+// mapToStruct converts the JSON-like object into a Struct, nil if it's not an object
+func mapToStruct(v interface{}) *_struct.Struct {
+	if v == nil {
+		return nil
+	}
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		// e.g. the attachments of the variants are decoded into their own types
+		b, err := json.Marshal(v)
+		if err != nil || json.Unmarshal(b, &m) != nil || m == nil {
+			return nil
+		}
+	}
+	s := &_struct.Struct{Fields: make(map[string]*_struct.Value, len(m))}
+	for k, e := range m {
+		s.Fields[k] = toStructValue(e)
+	}
+	return s
+}
+
+func toStructValue(v interface{}) *_struct.Value {
+	switch t := v.(type) {
+	case nil:
+		return &_struct.Value{Kind: &_struct.Value_NullValue{}}
+	case bool:
+		return &_struct.Value{Kind: &_struct.Value_BoolValue{BoolValue: t}}
+	case string:
+		return &_struct.Value{Kind: &_struct.Value_StringValue{StringValue: t}}
+	case float64:
+		return &_struct.Value{Kind: &_struct.Value_NumberValue{NumberValue: t}}
+	case map[string]interface{}:
+		return &_struct.Value{Kind: &_struct.Value_StructValue{StructValue: mapToStruct(t)}}
+	case []interface{}:
+		l := &_struct.ListValue{Values: make([]*_struct.Value, len(t))}
+		for i, e := range t {
+			l.Values[i] = toStructValue(e)
+		}
+		return &_struct.Value{Kind: &_struct.Value_ListValue{ListValue: l}}
+	}
+
+	// the other numbers and slices are normalized by JSON, like in the REST responses
+	b, err := json.Marshal(v)
+	if err != nil {
+		return &_struct.Value{Kind: &_struct.Value_NullValue{}}
+	}
+	var j interface{}
+	if err := json.Unmarshal(b, &j); err != nil {
+		return &_struct.Value{Kind: &_struct.Value_NullValue{}}
+	}
+	return toStructValue(j)
+}
@@ -0,0 +1,122 @@
+package handler
+
+import (
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/checkr/flagr/pkg/flagrpb"
+
+	_struct "github.com/golang/protobuf/ptypes/struct"
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func newTestGRPCClient(t *testing.T) (flagrpb.EvaluationClient, func()) {
+	lis := bufconn.Listen(1024 * 1024)
+	s := newGRPCServer()
+	go s.Serve(lis)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithDialer(func(string, time.Duration) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithInsecure(),
+	)
+	assert.NoError(t, err)
+	return flagrpb.NewEvaluationClient(conn), func() {
+		conn.Close()
+		s.Stop()
+	}
+}
+
+func TestGRPCEvaluate(t *testing.T) {
+	defer gostub.StubFunc(&GetEvalCache, GenFixtureEvalCache()).Reset()
+	defer gostub.StubFunc(&logEvalResult).Reset()
+	client, stop := newTestGRPCClient(t)
+	defer stop()
+
+	r, err := client.Evaluate(context.Background(), &flagrpb.EvalContext{
+		EntityId:    "entityID1",
+		EntityType:  "entityType1",
+		EnableDebug: true,
+		EntityContext: &_struct.Struct{Fields: map[string]*_struct.Value{
+			"dl_state": {Kind: &_struct.Value_StringValue{StringValue: "CA"}},
+		}},
+		FlagKey: "flag_key_100",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(100), r.FlagId)
+	assert.Equal(t, int64(200), r.SegmentId)
+	assert.NotZero(t, r.VariantId)
+	assert.Contains(t, []string{"control", "treatment"}, r.VariantKey)
+	assert.Equal(t, "CA", r.EvalContext.EntityContext.Fields["dl_state"].GetStringValue())
+	assert.NotEmpty(t, r.EvalDebugLog.SegmentDebugLogs)
+
+	r, err = client.Evaluate(context.Background(), &flagrpb.EvalContext{
+		EntityId: "entityID1",
+		EntityContext: &_struct.Struct{Fields: map[string]*_struct.Value{
+			"dl_state": {Kind: &_struct.Value_StringValue{StringValue: "NY"}},
+		}},
+		FlagId: 100,
+	})
+	assert.NoError(t, err)
+	assert.Zero(t, r.VariantId)
+	assert.Empty(t, r.VariantKey)
+}
+
+func TestGRPCEvaluateBatch(t *testing.T) {
+	defer gostub.StubFunc(&GetEvalCache, GenFixtureEvalCache()).Reset()
+	defer gostub.StubFunc(&logEvalResult).Reset()
+	client, stop := newTestGRPCClient(t)
+	defer stop()
+
+	entityContext := func(state string) *_struct.Struct {
+		return &_struct.Struct{Fields: map[string]*_struct.Value{
+			"dl_state": {Kind: &_struct.Value_StringValue{StringValue: state}},
+		}}
+	}
+	stream, err := client.EvaluateBatch(context.Background(), &flagrpb.EvaluationBatchRequest{
+		Entities: []*flagrpb.EvaluationEntity{
+			{EntityId: "entityID1", EntityContext: entityContext("CA")},
+			{EntityId: "entityID2", EntityContext: entityContext("NY")},
+		},
+		FlagIds:  []int64{100},
+		FlagKeys: []string{"flag_key_999"},
+	})
+	assert.NoError(t, err)
+
+	results := []*flagrpb.EvalResult{}
+	for {
+		r, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		assert.NoError(t, err)
+		results = append(results, r)
+	}
+	assert.Len(t, results, 4)
+	assert.Equal(t, "entityID1", results[0].EvalContext.EntityId)
+	assert.NotZero(t, results[0].VariantId)
+	assert.Equal(t, "flag_key_999", results[1].EvalContext.FlagKey)
+	assert.Zero(t, results[1].VariantId)
+	assert.Equal(t, "entityID2", results[2].EvalContext.EntityId)
+	assert.Zero(t, results[2].VariantId)
+}
+
+func TestStructConversion(t *testing.T) {
+	m := map[string]interface{}{
+		"s": "a",
+		"n": float64(1),
+		"b": true,
+		"z": nil,
+		"l": []interface{}{"x", float64(2)},
+		"o": map[string]interface{}{"k": "v"},
+	}
+	assert.Equal(t, m, structToMap(mapToStruct(m)))
+	assert.Equal(t, map[string]interface{}{"i": float64(3)}, structToMap(mapToStruct(map[string]interface{}{"i": 3})))
+	assert.Nil(t, structToMap(nil))
+	assert.Nil(t, mapToStruct("not an object"))
+}
@@ -1,6 +1,11 @@
 package handler
 
 import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"testing"
 
 	"github.com/checkr/flagr/pkg/entity"
@@ -9,6 +14,7 @@ import (
 	"github.com/checkr/flagr/swagger_gen/restapi/operations/evaluation"
 	"github.com/jinzhu/gorm"
 
+	"github.com/go-openapi/runtime"
 	"github.com/prashantv/gostub"
 	"github.com/stretchr/testify/assert"
 )
@@ -16,7 +22,7 @@ import (
 func TestEvalSegment(t *testing.T) {
 	t.Run("test empty evalContext", func(t *testing.T) {
 		s := entity.GenFixtureSegment()
-		vID, log := evalSegment(100, models.EvalContext{}, s)
+		vID, log := evalSegment(entity.Bucketing{Salt: "100"}, models.EvalContext{}, s)
 
 		assert.Nil(t, vID)
 		assert.NotEmpty(t, log)
@@ -25,7 +31,7 @@ func TestEvalSegment(t *testing.T) {
 	t.Run("test happy code path", func(t *testing.T) {
 		s := entity.GenFixtureSegment()
 		s.RolloutPercent = uint(100)
-		vID, log := evalSegment(100, models.EvalContext{
+		vID, log := evalSegment(entity.Bucketing{Salt: "100"}, models.EvalContext{
 			EnableDebug:   true,
 			EntityContext: map[string]interface{}{"dl_state": "CA"},
 			EntityID:      "entityID1",
@@ -40,7 +46,7 @@ func TestEvalSegment(t *testing.T) {
 	t.Run("test constraint evaluation error", func(t *testing.T) {
 		s := entity.GenFixtureSegment()
 		s.RolloutPercent = uint(100)
-		vID, log := evalSegment(100, models.EvalContext{
+		vID, log := evalSegment(entity.Bucketing{Salt: "100"}, models.EvalContext{
 			EnableDebug:   true,
 			EntityContext: map[string]interface{}{},
 			EntityID:      "entityID1",
@@ -55,7 +61,7 @@ func TestEvalSegment(t *testing.T) {
 	t.Run("test constraint not match", func(t *testing.T) {
 		s := entity.GenFixtureSegment()
 		s.RolloutPercent = uint(100)
-		vID, log := evalSegment(100, models.EvalContext{
+		vID, log := evalSegment(entity.Bucketing{Salt: "100"}, models.EvalContext{
 			EnableDebug:   true,
 			EntityContext: map[string]interface{}{"dl_state": "NY"},
 			EntityID:      "entityID1",
@@ -70,7 +76,7 @@ func TestEvalSegment(t *testing.T) {
 	t.Run("test evalContext wrong format", func(t *testing.T) {
 		s := entity.GenFixtureSegment()
 		s.RolloutPercent = uint(100)
-		vID, log := evalSegment(100, models.EvalContext{
+		vID, log := evalSegment(entity.Bucketing{Salt: "100"}, models.EvalContext{
 			EnableDebug:   true,
 			EntityContext: nil,
 			EntityID:      "entityID1",
@@ -81,6 +87,65 @@ func TestEvalSegment(t *testing.T) {
 		assert.Nil(t, vID)
 		assert.NotEmpty(t, log)
 	})
+
+	t.Run("test constraint group with a missing property", func(t *testing.T) {
+		s := entity.GenFixtureSegment()
+		s.Constraints = append(s.Constraints, entity.Constraint{
+			Model:     gorm.Model{ID: 501},
+			SegmentID: 200,
+			Property:  "plan",
+			Operator:  models.ConstraintOperatorEQ,
+			Value:     `"enterprise"`,
+		})
+		s.ConstraintGroup = entity.ConstraintGroup{
+			Operator: entity.ConstraintGroupOR,
+			Children: []entity.ConstraintGroup{{ConstraintID: 500}, {ConstraintID: 501}},
+		}
+		s.PrepareEvaluation()
+
+		vID, _ := evalSegment(entity.Bucketing{Salt: "100"}, models.EvalContext{
+			EnableDebug:   true,
+			EntityContext: map[string]interface{}{"dl_state": "CA"},
+			EntityID:      "entityID1",
+			FlagID:        int64(100),
+		}, s)
+		assert.NotNil(t, vID)
+
+		vID, log := evalSegment(entity.Bucketing{Salt: "100"}, models.EvalContext{
+			EnableDebug:   true,
+			EntityContext: map[string]interface{}{"dl_state": "NY"},
+			EntityID:      "entityID1",
+			FlagID:        int64(100),
+		}, s)
+		assert.Nil(t, vID)
+		assert.Contains(t, log.Msg, `(({dl_state} == "CA") OR ({plan} == "enterprise"))`)
+		assert.Contains(t, log.Msg, "argument: plan not found")
+	})
+
+	t.Run("test constraint with a custom operator", func(t *testing.T) {
+		s := entity.GenFixtureSegment()
+		s.Constraints[0].Operator = models.ConstraintOperatorENDSWITH
+		s.Constraints[0].Property = "email"
+		s.Constraints[0].Value = "@ourcompany.com"
+		s.PrepareEvaluation()
+
+		vID, _ := evalSegment(entity.Bucketing{Salt: "100"}, models.EvalContext{
+			EnableDebug:   true,
+			EntityContext: map[string]interface{}{"email": "jane@ourcompany.com"},
+			EntityID:      "entityID1",
+			FlagID:        int64(100),
+		}, s)
+		assert.NotNil(t, vID)
+
+		vID, log := evalSegment(entity.Bucketing{Salt: "100"}, models.EvalContext{
+			EnableDebug:   true,
+			EntityContext: map[string]interface{}{"email": "jane@example.com"},
+			EntityID:      "entityID1",
+			FlagID:        int64(100),
+		}, s)
+		assert.Nil(t, vID)
+		assert.Contains(t, log.Msg, "({email} ENDS WITH @ourcompany.com)")
+	})
 }
 
 func TestEvalFlag(t *testing.T) {
@@ -220,6 +285,87 @@ func TestEvalFlag(t *testing.T) {
 	})
 }
 
+func TestEvalFlagWithBucketing(t *testing.T) {
+	defer gostub.StubFunc(&logEvalResult).Reset()
+
+	f1 := entity.GenFixtureFlag()
+	f1.Salt = "shared_salt"
+	f2 := entity.GenFixtureFlag()
+	f2.Model.ID = 999 // e.g. migrated to another database
+	f2.Key = "flag_key_999"
+	f2.Salt = "shared_salt"
+	defer gostub.StubFunc(&GetEvalCache, &EvalCache{
+		mapCache: map[string]*entity.Flag{"100": &f1, "999": &f2},
+	}).Reset()
+
+	t.Run("the same salt buckets the same", func(t *testing.T) {
+		for i := 0; i < 100; i++ {
+			evalContext := models.EvalContext{
+				EntityID:      fmt.Sprintf("entity_%d", i),
+				EntityContext: map[string]interface{}{"dl_state": "CA"},
+			}
+			evalContext.FlagID = 100
+			r1 := evalFlag(evalContext)
+			evalContext.FlagID = 999
+			r2 := evalFlag(evalContext)
+			assert.Equal(t, *r1.VariantID, *r2.VariantID)
+		}
+	})
+
+	t.Run("rollout in basis points with the high resolution hash", func(t *testing.T) {
+		f1.Hash = entity.HashSHA1
+		f1.Segments[0].RolloutBasisPoints = 50
+		defer func() {
+			f1.Hash = ""
+			f1.Segments[0].RolloutBasisPoints = 0
+		}()
+
+		n := 0
+		for i := 0; i < 20000; i++ {
+			r := evalFlag(models.EvalContext{
+				EntityID:      fmt.Sprintf("entity_%d", i),
+				EntityContext: map[string]interface{}{"dl_state": "CA"},
+				FlagID:        100,
+			})
+			if r.VariantID != nil {
+				n++
+			}
+		}
+		assert.InDelta(t, 100, n, 40) // 0.5% of 20000
+	})
+
+	t.Run("bucket by an entityContext property", func(t *testing.T) {
+		f1.BucketBy = "company_id"
+		defer func() { f1.BucketBy = "" }()
+
+		variants := map[int64]bool{}
+		for i := 0; i < 100; i++ {
+			r := evalFlag(models.EvalContext{
+				EntityID:      fmt.Sprintf("entity_%d", i),
+				EntityContext: map[string]interface{}{"dl_state": "CA", "company_id": 42},
+				FlagID:        100,
+			})
+			assert.Equal(t, fmt.Sprintf("entity_%d", i), r.EvalContext.EntityID)
+			assert.Empty(t, r.EvalDebugLog.Msg)
+			variants[*r.VariantID] = true
+		}
+		assert.Len(t, variants, 1)
+
+		r := evalFlag(models.EvalContext{
+			EntityID:      "entity_1",
+			EntityContext: map[string]interface{}{"dl_state": "CA", "company_id": 42},
+			FlagID:        100,
+		})
+		byCompany := evalFlag(models.EvalContext{
+			EntityID:      "42",
+			EntityContext: map[string]interface{}{"dl_state": "CA"},
+			FlagID:        100,
+		})
+		assert.Equal(t, *byCompany.VariantID, *r.VariantID)
+		assert.Contains(t, byCompany.EvalDebugLog.Msg, "bucketBy company_id is missing in the entityContext")
+	})
+}
+
 func TestPostEvaluation(t *testing.T) {
 	t.Run("test empty body", func(t *testing.T) {
 		defer gostub.StubFunc(&evalFlag, &models.EvalResult{}).Reset()
@@ -266,6 +412,137 @@ func TestPostEvaluationBatch(t *testing.T) {
 	})
 }
 
+func TestCompactEvaluation(t *testing.T) {
+	defer gostub.StubFunc(&GetEvalCache, GenFixtureEvalCache()).Reset()
+	defer gostub.StubFunc(&logEvalResult).Reset()
+	e := NewEval()
+
+	t.Run("test compact evaluation", func(t *testing.T) {
+		resp := e.PostEvaluationCompact(evaluation.PostEvaluationCompactParams{
+			Body: &models.EvalContext{
+				EntityContext: map[string]interface{}{"dl_state": "CA"},
+				EntityID:      "entityID1",
+				EntityType:    util.StringPtr("entityType1"),
+				FlagKey:       "flag_key_100",
+			},
+		})
+		rec := httptest.NewRecorder()
+		resp.WriteResponse(rec, runtime.JSONProducer())
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		m := models.EvaluationCompactResponse{}
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &m))
+		assert.Len(t, m, 1)
+		assert.Contains(t, []string{"control", "treatment"}, m["flag_key_100"].VariantKey)
+	})
+
+	t.Run("test compact evaluation batch", func(t *testing.T) {
+		resp := e.PostEvaluationBatchCompact(evaluation.PostEvaluationBatchCompactParams{
+			Body: &models.EvaluationBatchRequest{
+				Entities: []*models.EvaluationEntity{
+					{
+						EntityContext: map[string]interface{}{"dl_state": "CA"},
+						EntityID:      "entityID1",
+						EntityType:    util.StringPtr("entityType1"),
+					},
+					{
+						EntityContext: map[string]interface{}{"dl_state": "NY"},
+						EntityID:      "entityID2",
+						EntityType:    util.StringPtr("entityType1"),
+					},
+				},
+				FlagIds:  []int64{100},
+				FlagKeys: []string{"flag_key_999"},
+			},
+		})
+		compact := resp.(*evaluation.PostEvaluationBatchCompactOK).Payload
+		assert.Len(t, compact.EvaluationResults, 2)
+
+		r := *compact.EvaluationResults[0]
+		assert.Len(t, r, 2)
+		assert.NotEmpty(t, r["flag_key_100"].VariantKey)
+		assert.Equal(t, models.CompactEvalResult{}, r["flag_key_999"])
+
+		r = *compact.EvaluationResults[1]
+		assert.Equal(t, models.CompactEvalResult{}, r["flag_key_100"])
+	})
+
+	t.Run("test compact evaluation with empty body", func(t *testing.T) {
+		resp := e.PostEvaluationCompact(evaluation.PostEvaluationCompactParams{})
+		assert.IsType(t, &evaluation.PostEvaluationCompactDefault{}, resp)
+	})
+}
+
+func TestGetEvaluation(t *testing.T) {
+	defer gostub.StubFunc(&GetEvalCache, GenFixtureEvalCache()).Reset()
+	defer gostub.StubFunc(&logEvalResult).Reset()
+	e := NewEval()
+
+	t.Run("test entity context from query", func(t *testing.T) {
+		resp := e.GetEvaluation(evaluation.GetEvaluationParams{
+			EntityID:    util.StringPtr("entityID1"),
+			FlagKey:     "flag_key_100",
+			HTTPRequest: httptest.NewRequest("GET", "/api/v1/evaluation/flags/flag_key_100?entityID=entityID1&ctx.dl_state=CA", nil),
+		}).(*evaluation.GetEvaluationOK)
+		assert.NotNil(t, resp.Payload.VariantID)
+		assert.Equal(t, "no-cache", resp.CacheControl)
+		assert.Equal(t, map[string]interface{}{"dl_state": "CA"}, resp.Payload.EvalContext.EntityContext)
+
+		resp = e.GetEvaluation(evaluation.GetEvaluationParams{
+			EntityID:    util.StringPtr("entityID1"),
+			FlagKey:     "100",
+			HTTPRequest: httptest.NewRequest("GET", "/api/v1/evaluation/flags/100?entityID=entityID1&ctx.dl_state=NY", nil),
+		}).(*evaluation.GetEvaluationOK)
+		assert.Nil(t, resp.Payload.VariantID)
+	})
+
+	t.Run("test cacheable results", func(t *testing.T) {
+		resp := e.GetEvaluation(evaluation.GetEvaluationParams{
+			FlagKey: "flag_key_999",
+		}).(*evaluation.GetEvaluationOK)
+		assert.Nil(t, resp.Payload.VariantID)
+		assert.Equal(t, "public, max-age=3", resp.CacheControl)
+	})
+}
+
+func TestQueryEntityContext(t *testing.T) {
+	m := queryEntityContext(url.Values{
+		"ctx.country": {"US"},
+		"ctx.age":     {"21"},
+		"ctx.score":   {"0.5"},
+		"ctx.zip":     {"02134"},
+		"ctx.premium": {"true"},
+		"ctx.trial":   {"false"},
+		"ctx.tier":    {"1", "2"},
+		"entityID":    {"entityID1"},
+	})
+	assert.Equal(t, map[string]interface{}{
+		"country": "US",
+		"age":     float64(21),
+		"score":   float64(0.5),
+		"zip":     "02134",
+		"premium": true,
+		"trial":   false,
+		"tier":    float64(2),
+	}, m)
+}
+
+func TestIsContextFree(t *testing.T) {
+	f := entity.GenFixtureFlag()
+	assert.True(t, isContextFree(nil, models.EvalContext{}))
+	assert.False(t, isContextFree(&f, models.EvalContext{EntityID: "entityID1"}))
+
+	f.Segments[0].Constraints = nil
+	assert.True(t, isContextFree(&f, models.EvalContext{EntityID: "entityID1"}))
+	assert.False(t, isContextFree(&f, models.EvalContext{}))
+
+	f.BucketBy = "company_id"
+	assert.False(t, isContextFree(&f, models.EvalContext{EntityID: "entityID1"}))
+
+	f.Enabled = false
+	assert.True(t, isContextFree(&f, models.EvalContext{}))
+}
+
 func TestRateLimitPerFlagConsoleLogging(t *testing.T) {
 	r := &models.EvalResult{FlagID: util.Int64Ptr(int64(1))}
 	t.Run("running fast triggers rate limiting", func(t *testing.T) {
@@ -0,0 +1,278 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/checkr/flagr/pkg/config"
+	"github.com/checkr/flagr/pkg/entity"
+	"github.com/checkr/flagr/pkg/mapper/entity_restapi/e2r"
+	"github.com/checkr/flagr/swagger_gen/restapi/operations/flag"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	flagStreamEventUpdated = "flag-updated"
+	flagStreamEventDeleted = "flag-deleted"
+
+	// a subscriber that falls behind by more events is disconnected,
+	// and it's expected to reconnect with the Last-Event-ID to catch up
+	flagStreamBufferSize = 256
+)
+
+var (
+	singletonFlagStream     *FlagStream
+	singletonFlagStreamOnce sync.Once
+)
+
+type flagStreamEvent struct {
+	id    uint // the snapshot ID of the flag, 0 means no ID, e.g. for the deleted flags
+	event string
+	data  []byte
+}
+
+// FlagStream broadcasts the flag changes picked up by the EvalCache to the SSE subscribers
+type FlagStream struct {
+	subscribers     map[chan flagStreamEvent]struct{}
+	subscribersLock sync.Mutex
+
+	keepaliveInterval time.Duration
+	maxDuration       time.Duration
+}
+
+// GetFlagStream gets the FlagStream
+var GetFlagStream = func() *FlagStream {
+	singletonFlagStreamOnce.Do(func() {
+		singletonFlagStream = &FlagStream{
+			subscribers:       make(map[chan flagStreamEvent]struct{}),
+			keepaliveInterval: config.Config.FlagStreamKeepaliveInterval,
+			maxDuration:       config.Config.FlagStreamMaxDuration,
+		}
+	})
+	return singletonFlagStream
+}
+
+func (fs *FlagStream) subscribe() chan flagStreamEvent {
+	ch := make(chan flagStreamEvent, flagStreamBufferSize)
+	fs.subscribersLock.Lock()
+	fs.subscribers[ch] = struct{}{}
+	fs.subscribersLock.Unlock()
+	return ch
+}
+
+func (fs *FlagStream) unsubscribe(ch chan flagStreamEvent) {
+	fs.subscribersLock.Lock()
+	if _, ok := fs.subscribers[ch]; ok {
+		delete(fs.subscribers, ch)
+		close(ch)
+	}
+	fs.subscribersLock.Unlock()
+}
+
+func (fs *FlagStream) hasSubscribers() bool {
+	fs.subscribersLock.Lock()
+	defer fs.subscribersLock.Unlock()
+	return len(fs.subscribers) > 0
+}
+
+func (fs *FlagStream) publish(events []flagStreamEvent) {
+	fs.subscribersLock.Lock()
+	defer fs.subscribersLock.Unlock()
+	for ch := range fs.subscribers {
+		if !sendFlagStreamEvents(ch, events) {
+			delete(fs.subscribers, ch)
+			close(ch)
+		}
+	}
+}
+
+// sendFlagStreamEvents sends the events without blocking, it returns false if the buffer is full
+func sendFlagStreamEvents(ch chan flagStreamEvent, events []flagStreamEvent) bool {
+	for _, e := range events {
+		select {
+		case ch <- e:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// flagStreamChanges returns the events of the flags that got a new snapshot or got deleted
+// between the old and the new EvalCache, ordered by the snapshot IDs
+func flagStreamChanges(old map[string]*entity.Flag, fs []entity.Flag) []flagStreamEvent {
+	events := []flagStreamEvent{}
+
+	ids := make(map[uint]bool)
+	updated := []*entity.Flag{}
+	for i := range fs {
+		f := &fs[i]
+		ids[f.ID] = true
+		prev := old[strconv.FormatUint(uint64(f.ID), 10)]
+		if prev == nil || prev.SnapshotID != f.SnapshotID {
+			updated = append(updated, f)
+		}
+	}
+	sort.Slice(updated, func(i, j int) bool { return updated[i].SnapshotID < updated[j].SnapshotID })
+	for _, f := range updated {
+		if e, err := newFlagUpdatedEvent(f); err == nil {
+			events = append(events, e)
+		}
+	}
+
+	for k, f := range old {
+		if k == strconv.FormatUint(uint64(f.ID), 10) && !ids[f.ID] {
+			events = append(events, newFlagDeletedEvent(f))
+		}
+	}
+	return events
+}
+
+func newFlagUpdatedEvent(f *entity.Flag) (flagStreamEvent, error) {
+	r, err := e2r.MapFlag(f, false)
+	if err != nil {
+		return flagStreamEvent{}, err
+	}
+	data, err := json.Marshal(r)
+	if err != nil {
+		return flagStreamEvent{}, err
+	}
+	return flagStreamEvent{id: f.SnapshotID, event: flagStreamEventUpdated, data: data}, nil
+}
+
+func newFlagDeletedEvent(f *entity.Flag) flagStreamEvent {
+	data, _ := json.Marshal(map[string]interface{}{"id": f.ID, "key": f.Key})
+	return flagStreamEvent{event: flagStreamEventDeleted, data: data}
+}
+
+// flagStreamResumeEvents returns the events since the given snapshot ID, that is the flags
+// in the EvalCache with newer snapshots, and the flags deleted after the snapshot was taken
+var flagStreamResumeEvents = func(lastEventID uint) ([]flagStreamEvent, error) {
+	events := []flagStreamEvent{}
+
+	updated := []*entity.Flag{}
+	for _, f := range GetEvalCache().GetFlags() {
+		if f.SnapshotID > lastEventID {
+			updated = append(updated, f)
+		}
+	}
+	sort.Slice(updated, func(i, j int) bool { return updated[i].SnapshotID < updated[j].SnapshotID })
+	for _, f := range updated {
+		e, err := newFlagUpdatedEvent(f)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, e)
+	}
+
+	if lastEventID == 0 {
+		return events, nil
+	}
+	s := &entity.FlagSnapshot{}
+	if err := entity.NewFlagSnapshotQuerySet(getDB()).IDEq(lastEventID).One(s); err != nil {
+		return events, nil
+	}
+	deleted := []entity.Flag{}
+	if err := getDB().Unscoped().Where("deleted_at > ?", s.CreatedAt).Find(&deleted).Error; err != nil {
+		return nil, err
+	}
+	for i := range deleted {
+		events = append(events, newFlagDeletedEvent(&deleted[i]))
+	}
+	return events, nil
+}
+
+var getFlagsStreamHandler = func(params flag.GetFlagsStreamParams) middleware.Responder {
+	return &flagStreamResponder{request: params.HTTPRequest, stream: GetFlagStream()}
+}
+
+type flagStreamResponder struct {
+	request *http.Request
+	stream  *FlagStream
+}
+
+// WriteResponse streams the events until the client goes away, or until the max duration
+// of the stream, so that it ends between two events before the write timeout of the server
+// cuts it. The clients reconnect with the Last-Event-ID to resume
+func (fr *flagStreamResponder) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+	flusher, ok := rw.(http.Flusher)
+	if !ok {
+		flag.NewGetFlagsStreamDefault(500).
+			WithPayload(ErrorMessage("streaming is not supported by the response writer")).
+			WriteResponse(rw, producer)
+		return
+	}
+
+	ch := fr.stream.subscribe()
+	defer fr.stream.unsubscribe(ch)
+
+	resume := []flagStreamEvent{}
+	if h := fr.request.Header.Get("Last-Event-ID"); h != "" {
+		lastEventID, err := strconv.ParseUint(h, 10, 64)
+		if err != nil {
+			flag.NewGetFlagsStreamDefault(400).
+				WithPayload(ErrorMessage("invalid Last-Event-ID %s. %s", h, err)).
+				WriteResponse(rw, producer)
+			return
+		}
+		resume, err = flagStreamResumeEvents(uint(lastEventID))
+		if err != nil {
+			flag.NewGetFlagsStreamDefault(500).
+				WithPayload(ErrorMessage("cannot resume from Last-Event-ID %s. %s", h, err)).
+				WriteResponse(rw, producer)
+			return
+		}
+	}
+
+	rw.Header().Set("Content-Type", "text/event-stream")
+	rw.Header().Set("Cache-Control", "no-cache")
+	rw.Header().Set("Connection", "keep-alive")
+	rw.Header().Set("X-Accel-Buffering", "no")
+	rw.WriteHeader(http.StatusOK)
+	for _, e := range resume {
+		writeFlagStreamEvent(rw, e)
+	}
+	flusher.Flush()
+
+	keepalive := time.NewTicker(fr.stream.keepaliveInterval)
+	defer keepalive.Stop()
+	var expired <-chan time.Time
+	if fr.stream.maxDuration > 0 {
+		t := time.NewTimer(fr.stream.maxDuration)
+		defer t.Stop()
+		expired = t.C
+	}
+	for {
+		select {
+		case <-fr.request.Context().Done():
+			return
+		case <-expired:
+			return
+		case e, ok := <-ch:
+			if !ok {
+				logrus.Warn("flag stream subscriber fell behind and got disconnected")
+				return
+			}
+			writeFlagStreamEvent(rw, e)
+			flusher.Flush()
+		case <-keepalive.C:
+			io.WriteString(rw, ": keepalive\n\n")
+			flusher.Flush()
+		}
+	}
+}
+
+func writeFlagStreamEvent(w io.Writer, e flagStreamEvent) {
+	if e.id != 0 {
+		fmt.Fprintf(w, "id: %d\n", e.id)
+	}
+	fmt.Fprintf(w, "event: %s\ndata: %s\n\n", e.event, e.data)
+}
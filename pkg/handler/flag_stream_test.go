@@ -0,0 +1,188 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/checkr/flagr/pkg/entity"
+	"github.com/checkr/flagr/pkg/util"
+	"github.com/checkr/flagr/swagger_gen/restapi/operations/flag"
+
+	"github.com/go-openapi/runtime"
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/assert"
+)
+
+// syncRecorder is a thread-safe http.ResponseWriter and http.Flusher for the streaming tests
+type syncRecorder struct {
+	header http.Header
+	code   int
+	body   bytes.Buffer
+	lock   sync.Mutex
+}
+
+func (r *syncRecorder) Header() http.Header  { return r.header }
+func (r *syncRecorder) WriteHeader(code int) { r.code = code }
+func (r *syncRecorder) Flush()               {}
+
+func (r *syncRecorder) Write(b []byte) (int, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.body.Write(b)
+}
+
+func (r *syncRecorder) String() string {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.body.String()
+}
+
+func waitForBody(r *syncRecorder, substr string) bool {
+	for i := 0; i < 100; i++ {
+		if strings.Contains(r.String(), substr) {
+			return true
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return false
+}
+
+func newTestFlagStream() *FlagStream {
+	return &FlagStream{
+		subscribers:       make(map[chan flagStreamEvent]struct{}),
+		keepaliveInterval: 20 * time.Millisecond,
+	}
+}
+
+func TestFlagStreamChanges(t *testing.T) {
+	f := entity.GenFixtureFlag()
+	f.SnapshotID = 10
+	old := map[string]*entity.Flag{"100": &f, f.Key: &f}
+
+	t.Run("no changes", func(t *testing.T) {
+		assert.Empty(t, flagStreamChanges(old, []entity.Flag{f}))
+	})
+
+	t.Run("updated and deleted", func(t *testing.T) {
+		f2 := entity.GenFixtureFlag()
+		f2.ID = 101
+		f2.Key = "flag_key_101"
+		f2.SnapshotID = 12
+		f3 := entity.GenFixtureFlag()
+		f3.ID = 102
+		f3.Key = "flag_key_102"
+		f3.SnapshotID = 11
+
+		events := flagStreamChanges(old, []entity.Flag{f2, f3})
+		assert.Len(t, events, 3)
+		assert.Equal(t, uint(11), events[0].id)
+		assert.Equal(t, flagStreamEventUpdated, events[0].event)
+		assert.Contains(t, string(events[0].data), `"key":"flag_key_102"`)
+		assert.Equal(t, uint(12), events[1].id)
+		assert.Equal(t, flagStreamEventDeleted, events[2].event)
+		assert.Equal(t, `{"id":100,"key":"flag_key_100"}`, string(events[2].data))
+	})
+}
+
+func TestFlagStreamPublish(t *testing.T) {
+	fs := newTestFlagStream()
+	assert.False(t, fs.hasSubscribers())
+
+	ch := fs.subscribe()
+	assert.True(t, fs.hasSubscribers())
+
+	fs.publish([]flagStreamEvent{{id: 1, event: flagStreamEventUpdated}})
+	assert.Equal(t, uint(1), (<-ch).id)
+
+	t.Run("slow subscriber gets disconnected", func(t *testing.T) {
+		events := make([]flagStreamEvent, flagStreamBufferSize+1)
+		fs.publish(events)
+		assert.False(t, fs.hasSubscribers())
+		for range ch {
+		}
+		fs.unsubscribe(ch)
+	})
+}
+
+func TestGetFlagsStream(t *testing.T) {
+	f := entity.GenFixtureFlag()
+	db := entity.PopulateTestDB(f)
+	defer db.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+
+	ec := &EvalCache{mapCache: make(map[string]*entity.Flag)}
+	defer gostub.StubFunc(&GetEvalCache, ec).Reset()
+	fs := newTestFlagStream()
+	defer gostub.StubFunc(&GetFlagStream, fs).Reset()
+
+	entity.SaveFlagSnapshot(db, f.ID, "flagr-test@example.com")
+	assert.NoError(t, ec.reloadMapCache())
+	snapshotID := ec.GetByFlagKeyOrID(f.ID).SnapshotID
+
+	stream := func(lastEventID string) (*syncRecorder, context.CancelFunc, chan struct{}) {
+		ctx, cancel := context.WithCancel(context.Background())
+		req, _ := http.NewRequest("GET", "/api/v1/flags/stream", nil)
+		req = req.WithContext(ctx)
+		if lastEventID != "" {
+			req.Header.Set("Last-Event-ID", lastEventID)
+		}
+		rw := &syncRecorder{header: make(http.Header)}
+		done := make(chan struct{})
+		go func() {
+			getFlagsStreamHandler(flag.GetFlagsStreamParams{HTTPRequest: req}).WriteResponse(rw, runtime.JSONProducer())
+			close(done)
+		}()
+		return rw, cancel, done
+	}
+
+	t.Run("pushes the changes picked up by the eval cache", func(t *testing.T) {
+		rw, cancel, done := stream("")
+		defer func() { cancel(); <-done }()
+
+		assert.True(t, waitForBody(rw, ": keepalive"))
+		assert.Equal(t, "text/event-stream", rw.Header().Get("Content-Type"))
+		assert.Equal(t, http.StatusOK, rw.code)
+
+		entity.SaveFlagSnapshot(db, f.ID, "flagr-test@example.com")
+		assert.NoError(t, ec.reloadMapCache())
+		assert.True(t, waitForBody(rw, "id: "+util.SafeString(snapshotID+1)+"\nevent: flag-updated\ndata: {"))
+
+		entity.NewFlagQuerySet(db).IDEq(f.ID).Delete()
+		assert.NoError(t, ec.reloadMapCache())
+		assert.True(t, waitForBody(rw, "event: flag-deleted\ndata: {\"id\":100,\"key\":\"flag_key_100\"}\n\n"))
+	})
+
+	t.Run("resumes from the Last-Event-ID", func(t *testing.T) {
+		rw, cancel, done := stream(util.SafeString(snapshotID))
+		defer func() { cancel(); <-done }()
+
+		assert.True(t, waitForBody(rw, "event: flag-deleted\ndata: {\"id\":100"))
+		assert.True(t, fs.hasSubscribers())
+	})
+
+	t.Run("ends the stream after the max duration", func(t *testing.T) {
+		defer gostub.Stub(&fs.maxDuration, 50*time.Millisecond).Reset()
+		_, cancel, done := stream("")
+		defer cancel()
+
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			assert.Fail(t, "the stream didn't end after the max duration")
+		}
+		assert.False(t, fs.hasSubscribers())
+	})
+
+	t.Run("invalid Last-Event-ID", func(t *testing.T) {
+		rw, cancel, done := stream("abc")
+		defer cancel()
+		<-done
+		assert.Equal(t, 400, rw.code)
+		assert.False(t, fs.hasSubscribers())
+	})
+}
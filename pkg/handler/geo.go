@@ -0,0 +1,115 @@
+package handler
+
+import (
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/checkr/flagr/pkg/config"
+	"github.com/checkr/flagr/pkg/geoip"
+	"github.com/checkr/flagr/pkg/util"
+	"github.com/sirupsen/logrus"
+)
+
+// the properties derived from the GeoIP DB into the entityContext
+const (
+	GeoCountryProperty   = "$geo.country"
+	GeoRegionProperty    = "$geo.region"
+	GeoCityProperty      = "$geo.city"
+	GeoContinentProperty = "$geo.continent"
+)
+
+// geoEnricher derives the location of the entity's IP, which is the GeoIPIPProperty of the
+// entityContext or the client IP of the request
+type geoEnricher struct {
+	db *geoip.DB
+}
+
+func newGeoEnricher(path string) (*geoEnricher, error) {
+	db, err := geoip.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	logrus.Infof("loaded the GeoIP DB %s of type %s", path, db.DatabaseType())
+	return &geoEnricher{db: db}, nil
+}
+
+func (e *geoEnricher) Enrich(entityContext map[string]interface{}, r *http.Request) map[string]interface{} {
+	ip := net.ParseIP(strings.TrimSpace(util.SafeString(entityContext[config.Config.GeoIPIPProperty])))
+	if ip == nil {
+		ip = clientIP(r)
+	}
+	if ip == nil {
+		return nil
+	}
+	l, err := e.db.Lookup(ip)
+	if err != nil {
+		logrus.WithField("err", err).Debugf("failed to look up the location of %s", ip)
+		return nil
+	}
+	if l == nil {
+		return nil
+	}
+
+	ret := make(map[string]interface{}, 4)
+	for k, v := range map[string]string{
+		GeoCountryProperty:   l.Country,
+		GeoRegionProperty:    l.Region,
+		GeoCityProperty:      l.City,
+		GeoContinentProperty: l.Continent,
+	} {
+		if v != "" {
+			ret[k] = v
+		}
+	}
+	return ret
+}
+
+// clientIP returns the client IP of the request, or nil if there's none. X-Forwarded-For is
+// honored only if the request comes from the TrustedProxies, and its rightmost address that's
+// not a trusted proxy is the client, the ones on its left can be forged by the client
+func clientIP(r *http.Request) net.IP {
+	if r == nil {
+		return nil
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !isTrustedProxy(ip) {
+		return ip
+	}
+
+	hops := strings.Split(strings.Join(r.Header["X-Forwarded-For"], ","), ",")
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := net.ParseIP(strings.TrimSpace(hops[i]))
+		if hop == nil {
+			break
+		}
+		ip = hop
+		if !isTrustedProxy(ip) {
+			break
+		}
+	}
+	return ip
+}
+
+func isTrustedProxy(ip net.IP) bool {
+	for _, p := range config.Config.TrustedProxies {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		if !strings.Contains(p, "/") {
+			if proxy := net.ParseIP(p); proxy != nil && proxy.Equal(ip) {
+				return true
+			}
+			continue
+		}
+		if _, n, err := net.ParseCIDR(p); err == nil && n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
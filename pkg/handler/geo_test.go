@@ -0,0 +1,151 @@
+package handler
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/checkr/flagr/pkg/config"
+	"github.com/checkr/flagr/pkg/entity"
+	"github.com/checkr/flagr/pkg/util"
+	"github.com/checkr/flagr/swagger_gen/models"
+	"github.com/checkr/flagr/swagger_gen/restapi/operations/evaluation"
+
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/assert"
+)
+
+func stubGeoEnricher(t *testing.T) *gostub.Stubs {
+	e, err := newGeoEnricher("../geoip/testdata/test-city.mmdb")
+	assert.NoError(t, err)
+	return gostub.StubFunc(&GetEnrichers, []Enricher{e})
+}
+
+func TestGeoEnricher(t *testing.T) {
+	t.Run("disabled", func(t *testing.T) {
+		m := map[string]interface{}{"ip": "81.2.69.160"}
+		assert.Equal(t, m, enrichEntityContext(m, nil))
+	})
+
+	defer stubGeoEnricher(t).Reset()
+
+	t.Run("from the ip property", func(t *testing.T) {
+		m := map[string]interface{}{"ip": "216.160.83.60"}
+		assert.Equal(t, map[string]interface{}{
+			"ip":             "216.160.83.60",
+			"$geo.country":   "US",
+			"$geo.region":    "WA",
+			"$geo.city":      "Milton",
+			"$geo.continent": "NA",
+		}, enrichEntityContext(m, httptest.NewRequest("POST", "/api/v1/evaluation", nil)))
+		assert.Len(t, m, 1)
+	})
+
+	t.Run("from the client IP", func(t *testing.T) {
+		r := httptest.NewRequest("POST", "/api/v1/evaluation", nil)
+		r.RemoteAddr = "81.2.69.160:54321"
+		ret := enrichEntityContext(nil, r).(map[string]interface{})
+		assert.Equal(t, "GB", ret[GeoCountryProperty])
+
+		r.RemoteAddr = "10.0.0.2:54321"
+		r.Header.Set("X-Forwarded-For", "89.160.20.113, 10.0.0.1")
+		defer gostub.Stub(&config.Config.TrustedProxies, []string{"10.0.0.0/8"}).Reset()
+		ret = enrichEntityContext(map[string]interface{}{"ip": "not an ip"}, r).(map[string]interface{})
+		assert.Equal(t, "SE", ret[GeoCountryProperty])
+		assert.Equal(t, "Linköping", ret[GeoCityProperty])
+	})
+
+	t.Run("forged X-Forwarded-For", func(t *testing.T) {
+		r := httptest.NewRequest("POST", "/api/v1/evaluation", nil)
+		r.RemoteAddr = "81.2.69.160:54321"
+		r.Header.Set("X-Forwarded-For", "89.160.20.113")
+		ret := enrichEntityContext(nil, r).(map[string]interface{})
+		assert.Equal(t, "GB", ret[GeoCountryProperty], "not from a trusted proxy")
+
+		defer gostub.Stub(&config.Config.TrustedProxies, []string{"10.0.0.0/8", "192.168.1.1"}).Reset()
+		r.RemoteAddr = "192.168.1.1:54321"
+		r.Header.Set("X-Forwarded-For", "89.160.20.113, 81.2.69.160, 10.0.0.1")
+		ret = enrichEntityContext(nil, r).(map[string]interface{})
+		assert.Equal(t, "GB", ret[GeoCountryProperty], "the rightmost untrusted hop")
+	})
+
+	t.Run("client IP", func(t *testing.T) {
+		defer gostub.Stub(&config.Config.TrustedProxies, []string{"", "10.0.0.0/8", "invalid"}).Reset()
+		for _, c := range []struct {
+			remoteAddr string
+			xff        []string
+			expected   string
+		}{
+			{"81.2.69.160:54321", nil, "81.2.69.160"},
+			{"81.2.69.160", nil, "81.2.69.160"},
+			{"81.2.69.160:54321", []string{"10.0.0.1"}, "81.2.69.160"},
+			{"10.0.0.2:54321", nil, "10.0.0.2"},
+			{"10.0.0.2:54321", []string{"10.0.0.1"}, "10.0.0.1"},
+			{"10.0.0.2:54321", []string{"1.1.1.1", "89.160.20.113, 10.0.0.1"}, "89.160.20.113"},
+			{"10.0.0.2:54321", []string{"89.160.20.113, not an ip, 10.0.0.1"}, "10.0.0.1"},
+			{"not an ip", []string{"89.160.20.113"}, ""},
+		} {
+			r := httptest.NewRequest("POST", "/api/v1/evaluation", nil)
+			r.RemoteAddr = c.remoteAddr
+			r.Header["X-Forwarded-For"] = c.xff
+			ip := clientIP(r)
+			if c.expected == "" {
+				assert.Nil(t, ip)
+				continue
+			}
+			assert.Equal(t, c.expected, ip.String(), "%s %v", c.remoteAddr, c.xff)
+		}
+		assert.Nil(t, clientIP(nil))
+	})
+
+	t.Run("keep the properties of the client", func(t *testing.T) {
+		ret := enrichEntityContext(map[string]interface{}{"ip": "81.2.69.160", "$geo.country": "IE"}, nil).(map[string]interface{})
+		assert.Equal(t, "IE", ret[GeoCountryProperty])
+		assert.Equal(t, "London", ret[GeoCityProperty])
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		m := map[string]interface{}{"ip": "127.0.0.1"}
+		assert.Equal(t, m, enrichEntityContext(m, nil))
+		assert.Equal(t, "invalid", enrichEntityContext("invalid", nil))
+	})
+}
+
+func TestEvalGeoConstraints(t *testing.T) {
+	defer stubGeoEnricher(t).Reset()
+	defer gostub.StubFunc(&logEvalResult).Reset()
+
+	f := entity.GenFixtureFlag()
+	f.Segments[0].Constraints = []entity.Constraint{{
+		Property:  GeoCountryProperty,
+		Operator:  models.ConstraintOperatorIN,
+		Value:     `["US", "CA"]`,
+		ValueType: models.ConstraintValueTypeSTRINGLIST,
+	}}
+	assert.NoError(t, f.PrepareEvaluation())
+	defer gostub.StubFunc(&GetEvalCache, &EvalCache{
+		mapCache: map[string]*entity.Flag{util.SafeString(f.ID): &f},
+	}).Reset()
+
+	eval := func(ip string) *models.EvalResult {
+		r := httptest.NewRequest("POST", "/api/v1/evaluation", nil)
+		r.RemoteAddr = ip + ":54321"
+		resp := NewEval().PostEvaluation(evaluation.PostEvaluationParams{
+			HTTPRequest: r,
+			Body: &models.EvalContext{
+				EnableDebug:   true,
+				EntityContext: map[string]interface{}{},
+				EntityID:      "entityID1",
+				EntityType:    util.StringPtr("entityType1"),
+				FlagID:        int64(f.ID),
+			},
+		})
+		return resp.(*evaluation.PostEvaluationOK).Payload
+	}
+
+	assert.NotNil(t, eval("216.160.83.60").VariantID)
+
+	r := eval("81.2.69.160")
+	assert.Nil(t, r.VariantID)
+	assert.Contains(t, r.EvalDebugLog.SegmentDebugLogs[0].Msg, `({$geo.country} IN ["US","CA"])`)
+	assert.Contains(t, r.EvalDebugLog.SegmentDebugLogs[0].Msg, "$geo.country:GB")
+}
@@ -1,16 +1,23 @@
 package handler
 
 import (
+	"github.com/checkr/flagr/pkg/config"
 	"github.com/checkr/flagr/pkg/entity"
 	"github.com/checkr/flagr/swagger_gen/restapi/operations"
+	"github.com/checkr/flagr/swagger_gen/restapi/operations/analysis"
+	"github.com/checkr/flagr/swagger_gen/restapi/operations/audience"
 	"github.com/checkr/flagr/swagger_gen/restapi/operations/constraint"
 	"github.com/checkr/flagr/swagger_gen/restapi/operations/distribution"
 	"github.com/checkr/flagr/swagger_gen/restapi/operations/evaluation"
 	"github.com/checkr/flagr/swagger_gen/restapi/operations/export"
 	"github.com/checkr/flagr/swagger_gen/restapi/operations/flag"
 	"github.com/checkr/flagr/swagger_gen/restapi/operations/health"
+	"github.com/checkr/flagr/swagger_gen/restapi/operations/idlist"
+	"github.com/checkr/flagr/swagger_gen/restapi/operations/layer"
+	"github.com/checkr/flagr/swagger_gen/restapi/operations/override"
 	"github.com/checkr/flagr/swagger_gen/restapi/operations/segment"
 	"github.com/checkr/flagr/swagger_gen/restapi/operations/variant"
+	"github.com/checkr/flagr/swagger_gen/restapi/operations/webhook"
 	"github.com/go-openapi/runtime/middleware"
 )
 
@@ -22,6 +29,13 @@ func Setup(api *operations.FlagrAPI) {
 	setupEvaluation(api)
 	setupHealth(api)
 	setupExport(api)
+	setupSRM(api)
+	setupAnalysis(api)
+	setupWebhook(api)
+	setupLayer(api)
+	setupOverride(api)
+	setupAudience(api)
+	setupIDList(api)
 }
 
 func setupCRUD(api *operations.FlagrAPI) {
@@ -34,6 +48,8 @@ func setupCRUD(api *operations.FlagrAPI) {
 	api.FlagDeleteFlagHandler = flag.DeleteFlagHandlerFunc(c.DeleteFlag)
 	api.FlagSetFlagEnabledHandler = flag.SetFlagEnabledHandlerFunc(c.SetFlagEnabledState)
 	api.FlagGetFlagSnapshotsHandler = flag.GetFlagSnapshotsHandlerFunc(c.GetFlagSnapshots)
+	api.FlagGetFlagsStreamHandler = flag.GetFlagsStreamHandlerFunc(getFlagsStreamHandler)
+	api.FlagDeleteFlagAssignmentsHandler = flag.DeleteFlagAssignmentsHandlerFunc(deleteFlagAssignmentsHandler)
 
 	// segments
 	api.SegmentCreateSegmentHandler = segment.CreateSegmentHandlerFunc(c.CreateSegment)
@@ -66,6 +82,14 @@ func setupEvaluation(api *operations.FlagrAPI) {
 	e := NewEval()
 	api.EvaluationPostEvaluationHandler = evaluation.PostEvaluationHandlerFunc(e.PostEvaluation)
 	api.EvaluationPostEvaluationBatchHandler = evaluation.PostEvaluationBatchHandlerFunc(e.PostEvaluationBatch)
+	api.EvaluationPostEvaluationCompactHandler = evaluation.PostEvaluationCompactHandlerFunc(e.PostEvaluationCompact)
+	api.EvaluationPostEvaluationBatchCompactHandler = evaluation.PostEvaluationBatchCompactHandlerFunc(e.PostEvaluationBatchCompact)
+	api.EvaluationGetEvaluationHandler = evaluation.GetEvaluationHandlerFunc(e.GetEvaluation)
+	api.EvaluationGetEvaluationConfigHandler = evaluation.GetEvaluationConfigHandlerFunc(getEvaluationConfigHandler)
+
+	if config.Config.GRPCEnabled {
+		startGRPCServer()
+	}
 }
 
 func setupHealth(api *operations.FlagrAPI) {
@@ -77,3 +101,68 @@ func setupHealth(api *operations.FlagrAPI) {
 func setupExport(api *operations.FlagrAPI) {
 	api.ExportGetExportSqliteHandler = export.GetExportSqliteHandlerFunc(exportSQLiteHandler)
 }
+
+func setupSRM(api *operations.FlagrAPI) {
+	api.FlagGetFlagStatsHandler = flag.GetFlagStatsHandlerFunc(getFlagStatsHandler)
+
+	if config.Config.SRMEnabled {
+		GetAssignmentCounter().Start()
+		NewSRMChecker().Start()
+	}
+}
+
+func setupAnalysis(api *operations.FlagrAPI) {
+	api.AnalysisPostConversionEventsHandler = analysis.PostConversionEventsHandlerFunc(postConversionEventsHandler)
+	api.AnalysisGetFlagAnalysisHandler = analysis.GetFlagAnalysisHandlerFunc(getFlagAnalysisHandler)
+
+	if config.Config.AnalysisEnabled {
+		GetAssignmentRecorder().Start()
+	}
+}
+
+func setupWebhook(api *operations.FlagrAPI) {
+	api.WebhookFindWebhooksHandler = webhook.FindWebhooksHandlerFunc(findWebhooksHandler)
+	api.WebhookCreateWebhookHandler = webhook.CreateWebhookHandlerFunc(createWebhookHandler)
+	api.WebhookGetWebhookHandler = webhook.GetWebhookHandlerFunc(getWebhookHandler)
+	api.WebhookPutWebhookHandler = webhook.PutWebhookHandlerFunc(putWebhookHandler)
+	api.WebhookDeleteWebhookHandler = webhook.DeleteWebhookHandlerFunc(deleteWebhookHandler)
+	api.WebhookFindWebhookDeliveriesHandler = webhook.FindWebhookDeliveriesHandlerFunc(findWebhookDeliveriesHandler)
+
+	if config.Config.WebhookEnabled {
+		NewWebhookDispatcher().Start()
+	}
+}
+
+func setupLayer(api *operations.FlagrAPI) {
+	api.LayerFindLayersHandler = layer.FindLayersHandlerFunc(findLayersHandler)
+	api.LayerCreateLayerHandler = layer.CreateLayerHandlerFunc(createLayerHandler)
+	api.LayerGetLayerHandler = layer.GetLayerHandlerFunc(getLayerHandler)
+	api.LayerPutLayerHandler = layer.PutLayerHandlerFunc(putLayerHandler)
+	api.LayerDeleteLayerHandler = layer.DeleteLayerHandlerFunc(deleteLayerHandler)
+	api.LayerSetFlagLayerHandler = layer.SetFlagLayerHandlerFunc(setFlagLayerHandler)
+}
+
+func setupOverride(api *operations.FlagrAPI) {
+	api.OverrideFindOverridesHandler = override.FindOverridesHandlerFunc(findOverridesHandler)
+	api.OverrideCreateOverrideHandler = override.CreateOverrideHandlerFunc(createOverrideHandler)
+	api.OverridePutOverrideHandler = override.PutOverrideHandlerFunc(putOverrideHandler)
+	api.OverrideDeleteOverrideHandler = override.DeleteOverrideHandlerFunc(deleteOverrideHandler)
+}
+
+func setupAudience(api *operations.FlagrAPI) {
+	api.AudienceFindAudiencesHandler = audience.FindAudiencesHandlerFunc(findAudiencesHandler)
+	api.AudienceCreateAudienceHandler = audience.CreateAudienceHandlerFunc(createAudienceHandler)
+	api.AudienceGetAudienceHandler = audience.GetAudienceHandlerFunc(getAudienceHandler)
+	api.AudiencePutAudienceHandler = audience.PutAudienceHandlerFunc(putAudienceHandler)
+	api.AudienceDeleteAudienceHandler = audience.DeleteAudienceHandlerFunc(deleteAudienceHandler)
+}
+
+func setupIDList(api *operations.FlagrAPI) {
+	api.IdlistFindIDListsHandler = idlist.FindIDListsHandlerFunc(findIDListsHandler)
+	api.IdlistCreateIDListHandler = idlist.CreateIDListHandlerFunc(createIDListHandler)
+	api.IdlistGetIDListHandler = idlist.GetIDListHandlerFunc(getIDListHandler)
+	api.IdlistPutIDListHandler = idlist.PutIDListHandlerFunc(putIDListHandler)
+	api.IdlistDeleteIDListHandler = idlist.DeleteIDListHandlerFunc(deleteIDListHandler)
+	api.IdlistGetIDListIdsHandler = idlist.GetIDListIdsHandlerFunc(getIDListIDsHandler)
+	api.IdlistPutIDListIdsHandler = idlist.PutIDListIdsHandlerFunc(putIDListIDsHandler)
+}
@@ -6,6 +6,8 @@ import (
 	"github.com/checkr/flagr/pkg/entity"
 	"github.com/checkr/flagr/swagger_gen/restapi/operations"
 
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/runtime/middleware"
 	"github.com/prashantv/gostub"
 	"github.com/stretchr/testify/assert"
 )
@@ -16,3 +18,27 @@ func TestSetup(t *testing.T) {
 		Setup(&operations.FlagrAPI{})
 	})
 }
+
+func TestEvaluationRoutes(t *testing.T) {
+	doc, err := loads.Spec("../../docs/api_docs/bundle.yaml")
+	assert.NoError(t, err)
+	api := operations.NewFlagrAPI(doc)
+	api.Init()
+	router := middleware.DefaultRouter(doc, api)
+
+	// the flag keys can't collide with the static evaluation routes
+	for path, operationID := range map[string]string{
+		"/api/v1/evaluation/config":          "getEvaluationConfig",
+		"/api/v1/evaluation/flags/config":    "getEvaluation",
+		"/api/v1/evaluation/flags/compact":   "getEvaluation",
+		"/api/v1/evaluation/flags/batch":     "getEvaluation",
+		"/api/v1/evaluation/flags/flag_key1": "getEvaluation",
+	} {
+		route, ok := router.Lookup("GET", path)
+		if assert.True(t, ok, path) {
+			assert.Equal(t, operationID, route.Operation.ID, path)
+		}
+	}
+	_, ok := router.Lookup("GET", "/api/v1/evaluation/flag_key1")
+	assert.False(t, ok)
+}
@@ -0,0 +1,231 @@
+package handler
+
+import (
+	"github.com/checkr/flagr/pkg/entity"
+	"github.com/checkr/flagr/pkg/mapper/entity_restapi/e2r"
+	"github.com/checkr/flagr/pkg/util"
+	"github.com/checkr/flagr/swagger_gen/models"
+	"github.com/checkr/flagr/swagger_gen/restapi/operations/idlist"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/jinzhu/gorm"
+)
+
+var findIDListsHandler = func(params idlist.FindIDListsParams) middleware.Responder {
+	ls := []entity.IDList{}
+	if err := entity.NewIDListQuerySet(getDB()).OrderAscByID().All(&ls); err != nil {
+		return idlist.NewFindIDListsDefault(500).WithPayload(
+			ErrorMessage("cannot query all ID lists. %s", err))
+	}
+
+	ret := make([]*models.IDList, len(ls), len(ls))
+	for i := range ls {
+		ret[i] = e2r.MapIDList(&ls[i])
+	}
+	return idlist.NewFindIDListsOK().WithPayload(ret)
+}
+
+var createIDListHandler = func(params idlist.CreateIDListParams) middleware.Responder {
+	key := util.SafeString(params.Body.Key)
+	if ok, reason := util.IsSafeKey(key); !ok {
+		return idlist.NewCreateIDListDefault(400).WithPayload(
+			ErrorMessage("cannot create ID list due to invalid key. reason: %s", reason))
+	}
+	if n, _ := entity.NewIDListQuerySet(getDB()).KeyEq(key).Count(); n > 0 {
+		return idlist.NewCreateIDListDefault(400).WithPayload(
+			ErrorMessage("cannot create ID list. key %s already exists", key))
+	}
+	ids, err := entity.ParseIDs(params.Body.Ids)
+	if err != nil {
+		return idlist.NewCreateIDListDefault(400).WithPayload(
+			ErrorMessage("cannot create ID list. %s", err))
+	}
+
+	l := &entity.IDList{Key: key, Description: params.Body.Description}
+	tx := getDB().Begin()
+	err = l.Create(tx)
+	if err == nil {
+		err = l.ReplaceIDs(tx, ids)
+	}
+	if err == nil {
+		err = tx.Commit().Error
+	}
+	if err != nil {
+		tx.Rollback()
+		return idlist.NewCreateIDListDefault(500).WithPayload(
+			ErrorMessage("cannot create ID list. %s", err))
+	}
+	return idlist.NewCreateIDListOK().WithPayload(e2r.MapIDList(l))
+}
+
+var getIDListHandler = func(params idlist.GetIDListParams) middleware.Responder {
+	l := &entity.IDList{}
+	if err := entity.NewIDListQuerySet(getDB()).IDEq(uint(params.IDListID)).One(l); err != nil {
+		return idlist.NewGetIDListDefault(404).WithPayload(
+			ErrorMessage("cannot find ID list %v. %s", params.IDListID, err))
+	}
+	return idlist.NewGetIDListOK().WithPayload(e2r.MapIDList(l))
+}
+
+var putIDListHandler = func(params idlist.PutIDListParams) middleware.Responder {
+	l := &entity.IDList{}
+	if err := entity.NewIDListQuerySet(getDB()).IDEq(uint(params.IDListID)).One(l); err != nil {
+		return idlist.NewPutIDListDefault(404).WithPayload(
+			ErrorMessage("cannot find ID list %v. %s", params.IDListID, err))
+	}
+	if params.Body.Description != nil {
+		l.Description = *params.Body.Description
+	}
+	if err := getDB().Save(l).Error; err != nil {
+		return idlist.NewPutIDListDefault(500).WithPayload(
+			ErrorMessage("cannot update ID list %v. %s", params.IDListID, err))
+	}
+	return idlist.NewPutIDListOK().WithPayload(e2r.MapIDList(l))
+}
+
+var deleteIDListHandler = func(params idlist.DeleteIDListParams) middleware.Responder {
+	l := &entity.IDList{}
+	if err := entity.NewIDListQuerySet(getDB()).IDEq(uint(params.IDListID)).One(l); err != nil {
+		return idlist.NewDeleteIDListDefault(404).WithPayload(
+			ErrorMessage("cannot find ID list %v. %s", params.IDListID, err))
+	}
+	cs, err := findIDListConstraints(getDB(), l.Key)
+	if err != nil {
+		return idlist.NewDeleteIDListDefault(500).WithPayload(
+			ErrorMessage("cannot delete ID list %v. %s", params.IDListID, err))
+	}
+	if len(cs) > 0 {
+		return idlist.NewDeleteIDListDefault(400).WithPayload(
+			ErrorMessage("cannot delete ID list %v. there are still %d constraints using it", params.IDListID, len(cs)))
+	}
+
+	// the ID list is deleted for good, so that its key can be reused
+	tx := getDB().Begin()
+	err = entity.NewIDListItemQuerySet(tx).IDListIDEq(l.ID).Delete()
+	if err == nil {
+		err = entity.NewIDListQuerySet(tx.Unscoped()).IDEq(l.ID).Delete()
+	}
+	if err == nil {
+		err = tx.Commit().Error
+	}
+	if err != nil {
+		tx.Rollback()
+		return idlist.NewDeleteIDListDefault(500).WithPayload(
+			ErrorMessage("cannot delete ID list %v. %s", params.IDListID, err))
+	}
+	return idlist.NewDeleteIDListOK()
+}
+
+var getIDListIDsHandler = func(params idlist.GetIDListIdsParams) middleware.Responder {
+	l := &entity.IDList{}
+	if err := entity.NewIDListQuerySet(getDB()).IDEq(uint(params.IDListID)).One(l); err != nil {
+		return idlist.NewGetIDListIdsDefault(404).WithPayload(
+			ErrorMessage("cannot find ID list %v. %s", params.IDListID, err))
+	}
+	ids, err := l.IDs(getDB())
+	if err != nil {
+		return idlist.NewGetIDListIdsDefault(500).WithPayload(
+			ErrorMessage("cannot query the IDs of ID list %v. %s", params.IDListID, err))
+	}
+	return idlist.NewGetIDListIdsOK().WithPayload(ids)
+}
+
+var putIDListIDsHandler = func(params idlist.PutIDListIdsParams) middleware.Responder {
+	l := &entity.IDList{}
+	if err := entity.NewIDListQuerySet(getDB()).IDEq(uint(params.IDListID)).One(l); err != nil {
+		return idlist.NewPutIDListIdsDefault(404).WithPayload(
+			ErrorMessage("cannot find ID list %v. %s", params.IDListID, err))
+	}
+	ids, err := entity.ParseIDs(util.SafeString(params.Body.Ids))
+	if err != nil {
+		return idlist.NewPutIDListIdsDefault(400).WithPayload(
+			ErrorMessage("cannot update the IDs of ID list %v. %s", params.IDListID, err))
+	}
+
+	tx := getDB().Begin()
+	err = l.ReplaceIDs(tx, ids)
+	if err == nil {
+		err = tx.Commit().Error
+	}
+	if err != nil {
+		tx.Rollback()
+		return idlist.NewPutIDListIdsDefault(500).WithPayload(
+			ErrorMessage("cannot update the IDs of ID list %v. %s", params.IDListID, err))
+	}
+	return idlist.NewPutIDListIdsOK().WithPayload(e2r.MapIDList(l))
+}
+
+// findIDListConstraints finds the constraints referencing the ID list, which belong to the
+// segments of the existing flags or to the existing audiences
+func findIDListConstraints(db *gorm.DB, key string) ([]entity.Constraint, error) {
+	all := []entity.Constraint{}
+	err := entity.NewConstraintQuerySet(db).
+		OperatorIn(models.ConstraintOperatorINLIST, models.ConstraintOperatorNOTINLIST).
+		OrderAscByID().
+		All(&all)
+	if err != nil {
+		return nil, err
+	}
+
+	cs := []entity.Constraint{}
+	segmentIDs := []uint{}
+	audienceIDs := []uint{}
+	for _, c := range all {
+		if k, _ := c.IDListKey(); k != key {
+			continue
+		}
+		cs = append(cs, c)
+		if c.SegmentID != 0 {
+			segmentIDs = append(segmentIDs, c.SegmentID)
+		}
+		if c.AudienceID != 0 {
+			audienceIDs = append(audienceIDs, c.AudienceID)
+		}
+	}
+	if len(cs) == 0 {
+		return cs, nil
+	}
+
+	// the constraints of the deleted segments, flags and audiences are left behind
+	existingSegments := make(map[uint]bool)
+	if len(segmentIDs) > 0 {
+		ss := []entity.Segment{}
+		if err := entity.NewSegmentQuerySet(db).IDIn(segmentIDs...).All(&ss); err != nil {
+			return nil, err
+		}
+		flagIDs := []uint{}
+		for _, s := range ss {
+			flagIDs = append(flagIDs, s.FlagID)
+		}
+		existingFlags := make(map[uint]bool)
+		if len(flagIDs) > 0 {
+			fs := []entity.Flag{}
+			if err := entity.NewFlagQuerySet(db).IDIn(flagIDs...).All(&fs); err != nil {
+				return nil, err
+			}
+			for _, f := range fs {
+				existingFlags[f.ID] = true
+			}
+		}
+		for _, s := range ss {
+			existingSegments[s.ID] = existingFlags[s.FlagID]
+		}
+	}
+	existingAudiences := make(map[uint]bool)
+	if len(audienceIDs) > 0 {
+		as := []entity.Audience{}
+		if err := entity.NewAudienceQuerySet(db).IDIn(audienceIDs...).All(&as); err != nil {
+			return nil, err
+		}
+		for _, a := range as {
+			existingAudiences[a.ID] = true
+		}
+	}
+
+	ret := []entity.Constraint{}
+	for _, c := range cs {
+		if existingSegments[c.SegmentID] || existingAudiences[c.AudienceID] {
+			ret = append(ret, c)
+		}
+	}
+	return ret, nil
+}
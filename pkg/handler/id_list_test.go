@@ -0,0 +1,147 @@
+package handler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/checkr/flagr/pkg/entity"
+	"github.com/checkr/flagr/pkg/util"
+	"github.com/checkr/flagr/swagger_gen/models"
+	"github.com/checkr/flagr/swagger_gen/restapi/operations/constraint"
+	"github.com/checkr/flagr/swagger_gen/restapi/operations/idlist"
+
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCrudIDLists(t *testing.T) {
+	db := entity.PopulateTestDB(entity.GenFixtureFlag())
+	defer db.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+
+	createConstraint := func(key string) interface{} {
+		return NewCRUD().CreateConstraint(constraint.CreateConstraintParams{
+			FlagID:    100,
+			SegmentID: 200,
+			Body: &models.CreateConstraintRequest{
+				Property: util.StringPtr("account_id"),
+				Operator: util.StringPtr(models.ConstraintOperatorINLIST),
+				Value:    util.StringPtr(key),
+			},
+		})
+	}
+	var constraintID int64
+
+	t.Run("create ID list", func(t *testing.T) {
+		res := createIDListHandler(idlist.CreateIDListParams{
+			Body: &models.CreateIDListRequest{
+				Key:         util.StringPtr("customers_beta"),
+				Description: "the customers in the beta",
+				Ids:         "id,\na1, a2\r\n\"a3\"\na1\n\n",
+			},
+		})
+		l := res.(*idlist.CreateIDListOK).Payload
+		assert.Equal(t, int64(1), *l.ID)
+		assert.Equal(t, "customers_beta", *l.Key)
+		assert.Equal(t, int64(4), *l.Size)
+
+		res = createIDListHandler(idlist.CreateIDListParams{
+			Body: &models.CreateIDListRequest{Key: util.StringPtr("customers_beta")},
+		})
+		assert.Contains(t, *res.(*idlist.CreateIDListDefault).Payload.Message, "already exists")
+
+		res = createIDListHandler(idlist.CreateIDListParams{
+			Body: &models.CreateIDListRequest{Key: util.StringPtr("customers beta")},
+		})
+		assert.IsType(t, &idlist.CreateIDListDefault{}, res)
+
+		ls := findIDListsHandler(idlist.FindIDListsParams{}).(*idlist.FindIDListsOK).Payload
+		assert.Len(t, ls, 1)
+
+		ids := getIDListIDsHandler(idlist.GetIDListIdsParams{IDListID: 1}).(*idlist.GetIDListIdsOK).Payload
+		assert.Equal(t, []string{"id", "a1", "a2", "a3"}, ids)
+	})
+
+	t.Run("constraints reference the ID list", func(t *testing.T) {
+		res := createConstraint("customers_alpha")
+		assert.Contains(t, *res.(*constraint.CreateConstraintDefault).Payload.Message, "error finding ID list customers_alpha")
+
+		c := createConstraint("customers_beta").(*constraint.CreateConstraintOK).Payload
+		constraintID = c.ID
+	})
+
+	t.Run("uploads propagate to the evaluation", func(t *testing.T) {
+		ec := &EvalCache{}
+		defer gostub.StubFunc(&GetEvalCache, ec).Reset()
+		defer gostub.StubFunc(&logEvalResult).Reset()
+		eval := func(accountID string) *models.EvalResult {
+			return evalFlag(models.EvalContext{
+				EntityID:      "entity_1",
+				EntityContext: map[string]interface{}{"dl_state": "CA", "account_id": accountID},
+				FlagID:        100,
+			})
+		}
+
+		assert.NoError(t, ec.reloadMapCache())
+		assert.NotNil(t, eval("a2").VariantID)
+		assert.Nil(t, eval("a9").VariantID)
+
+		res := putIDListIDsHandler(idlist.PutIDListIdsParams{
+			IDListID: 1,
+			Body:     &models.PutIDListIdsRequest{Ids: util.StringPtr("a9")},
+		})
+		assert.Equal(t, int64(1), *res.(*idlist.PutIDListIdsOK).Payload.Size)
+
+		assert.NoError(t, ec.reloadMapCache())
+		assert.Nil(t, eval("a2").VariantID)
+		assert.NotNil(t, eval("a9").VariantID)
+
+		set, ok := ec.GetIDList("customers_beta")
+		assert.True(t, ok)
+		assert.Len(t, set, 1)
+
+		// a re-upload of the same size within the same second, as stored by mysql
+		updatedAt := time.Now().Truncate(time.Second)
+		entity.NewIDListQuerySet(db).IDEq(1).GetUpdater().SetUpdatedAt(updatedAt).Update()
+		assert.NoError(t, ec.reloadMapCache())
+		putIDListIDsHandler(idlist.PutIDListIdsParams{
+			IDListID: 1,
+			Body:     &models.PutIDListIdsRequest{Ids: util.StringPtr("a8")},
+		})
+		entity.NewIDListQuerySet(db).IDEq(1).GetUpdater().SetUpdatedAt(updatedAt).Update()
+		assert.NoError(t, ec.reloadMapCache())
+		assert.Nil(t, eval("a9").VariantID)
+		assert.NotNil(t, eval("a8").VariantID)
+	})
+
+	t.Run("update ID list", func(t *testing.T) {
+		res := putIDListHandler(idlist.PutIDListParams{
+			IDListID: 1,
+			Body:     &models.PutIDListRequest{Description: util.StringPtr("beta")},
+		})
+		l := res.(*idlist.PutIDListOK).Payload
+		assert.Equal(t, "beta", l.Description)
+		assert.Equal(t, int64(1), *l.Size)
+
+		res = putIDListHandler(idlist.PutIDListParams{IDListID: 2, Body: &models.PutIDListRequest{}})
+		assert.IsType(t, &idlist.PutIDListDefault{}, res)
+	})
+
+	t.Run("delete ID list", func(t *testing.T) {
+		res := deleteIDListHandler(idlist.DeleteIDListParams{IDListID: 1})
+		assert.Contains(t, *res.(*idlist.DeleteIDListDefault).Payload.Message, "there are still 1 constraints using it")
+
+		NewCRUD().DeleteConstraint(constraint.DeleteConstraintParams{FlagID: 100, SegmentID: 200, ConstraintID: constraintID})
+		res = deleteIDListHandler(idlist.DeleteIDListParams{IDListID: 1})
+		assert.IsType(t, &idlist.DeleteIDListOK{}, res)
+
+		res = getIDListHandler(idlist.GetIDListParams{IDListID: 1})
+		assert.IsType(t, &idlist.GetIDListDefault{}, res)
+
+		// the key can be reused
+		res = createIDListHandler(idlist.CreateIDListParams{
+			Body: &models.CreateIDListRequest{Key: util.StringPtr("customers_beta")},
+		})
+		assert.Equal(t, int64(0), *res.(*idlist.CreateIDListOK).Payload.Size)
+	})
+}
@@ -0,0 +1,195 @@
+package handler
+
+import (
+	"sync"
+
+	"github.com/checkr/flagr/pkg/entity"
+	"github.com/checkr/flagr/pkg/mapper/entity_restapi/e2r"
+	"github.com/checkr/flagr/pkg/util"
+	"github.com/checkr/flagr/swagger_gen/models"
+	"github.com/checkr/flagr/swagger_gen/restapi/operations/layer"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/jinzhu/gorm"
+)
+
+var findLayersHandler = func(params layer.FindLayersParams) middleware.Responder {
+	ls := []entity.Layer{}
+	if err := entity.NewLayerQuerySet(getDB()).OrderAscByID().All(&ls); err != nil {
+		return layer.NewFindLayersDefault(500).WithPayload(
+			ErrorMessage("cannot query all layers. %s", err))
+	}
+
+	ret := make([]*models.Layer, len(ls), len(ls))
+	for i, l := range ls {
+		fs, err := findLayerFlags(getDB(), l.ID)
+		if err != nil {
+			return layer.NewFindLayersDefault(500).WithPayload(
+				ErrorMessage("cannot query the flags of layer %v. %s", l.ID, err))
+		}
+		ret[i] = e2r.MapLayer(&l, fs)
+	}
+	return layer.NewFindLayersOK().WithPayload(ret)
+}
+
+var createLayerHandler = func(params layer.CreateLayerParams) middleware.Responder {
+	key := util.SafeString(params.Body.Key)
+	if ok, reason := util.IsSafeKey(key); !ok {
+		return layer.NewCreateLayerDefault(400).WithPayload(
+			ErrorMessage("cannot create layer due to invalid key. reason: %s", reason))
+	}
+	if n, _ := entity.NewLayerQuerySet(getDB()).KeyEq(key).Count(); n > 0 {
+		return layer.NewCreateLayerDefault(400).WithPayload(
+			ErrorMessage("cannot create layer. key %s already exists", key))
+	}
+
+	l := &entity.Layer{Key: key, Description: params.Body.Description}
+	if err := l.Create(getDB()); err != nil {
+		return layer.NewCreateLayerDefault(500).WithPayload(
+			ErrorMessage("cannot create layer. %s", err))
+	}
+	return layer.NewCreateLayerOK().WithPayload(e2r.MapLayer(l, []entity.Flag{}))
+}
+
+var getLayerHandler = func(params layer.GetLayerParams) middleware.Responder {
+	l := &entity.Layer{}
+	if err := entity.NewLayerQuerySet(getDB()).IDEq(uint(params.LayerID)).One(l); err != nil {
+		return layer.NewGetLayerDefault(404).WithPayload(
+			ErrorMessage("cannot find layer %v. %s", params.LayerID, err))
+	}
+	fs, err := findLayerFlags(getDB(), l.ID)
+	if err != nil {
+		return layer.NewGetLayerDefault(500).WithPayload(
+			ErrorMessage("cannot query the flags of layer %v. %s", params.LayerID, err))
+	}
+	return layer.NewGetLayerOK().WithPayload(e2r.MapLayer(l, fs))
+}
+
+var putLayerHandler = func(params layer.PutLayerParams) middleware.Responder {
+	l := &entity.Layer{}
+	if err := entity.NewLayerQuerySet(getDB()).IDEq(uint(params.LayerID)).One(l); err != nil {
+		return layer.NewPutLayerDefault(404).WithPayload(
+			ErrorMessage("cannot find layer %v. %s", params.LayerID, err))
+	}
+	if params.Body.Description != nil {
+		l.Description = *params.Body.Description
+	}
+	if err := getDB().Save(l).Error; err != nil {
+		return layer.NewPutLayerDefault(500).WithPayload(
+			ErrorMessage("cannot update layer %v. %s", params.LayerID, err))
+	}
+
+	fs, err := findLayerFlags(getDB(), l.ID)
+	if err != nil {
+		return layer.NewPutLayerDefault(500).WithPayload(
+			ErrorMessage("cannot query the flags of layer %v. %s", params.LayerID, err))
+	}
+	return layer.NewPutLayerOK().WithPayload(e2r.MapLayer(l, fs))
+}
+
+var deleteLayerHandler = func(params layer.DeleteLayerParams) middleware.Responder {
+	n, err := entity.NewFlagQuerySet(getDB()).LayerIDEq(uint(params.LayerID)).Count()
+	if err != nil {
+		return layer.NewDeleteLayerDefault(500).WithPayload(
+			ErrorMessage("cannot delete layer %v. %s", params.LayerID, err))
+	}
+	if n > 0 {
+		return layer.NewDeleteLayerDefault(400).WithPayload(
+			ErrorMessage("cannot delete layer %v. there are still %d flags in it", params.LayerID, n))
+	}
+
+	if err := entity.NewLayerQuerySet(getDB()).IDEq(uint(params.LayerID)).Delete(); err != nil {
+		return layer.NewDeleteLayerDefault(500).WithPayload(
+			ErrorMessage("cannot delete layer %v. %s", params.LayerID, err))
+	}
+	return layer.NewDeleteLayerOK()
+}
+
+// layerAllocationLock serializes the bucket allocations of this instance. The other instances
+// are serialized by the lock of the layer row, see allocateFlagLayerBuckets
+var layerAllocationLock sync.Mutex
+
+var setFlagLayerHandler = func(params layer.SetFlagLayerParams) middleware.Responder {
+	flagID := uint(params.FlagID)
+	layerID := uint(util.SafeUint(params.Body.LayerID))
+
+	layerAllocationLock.Lock()
+	defer layerAllocationLock.Unlock()
+
+	tx := getDB().Begin()
+	f := &entity.Flag{}
+	if err := entity.NewFlagQuerySet(tx).IDEq(flagID).One(f); err != nil {
+		tx.Rollback()
+		return layer.NewSetFlagLayerDefault(404).WithPayload(
+			ErrorMessage("cannot find flag %v. %s", params.FlagID, err))
+	}
+
+	start, end := uint(0), uint(0)
+	if layerID != 0 {
+		var err *Error
+		start, end, err = allocateFlagLayerBuckets(tx, f, layerID, uint(params.Body.Percent))
+		if err != nil {
+			tx.Rollback()
+			return layer.NewSetFlagLayerDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+		}
+	}
+
+	err := entity.NewFlagQuerySet(tx).IDEq(flagID).GetUpdater().
+		SetLayerID(layerID).
+		SetLayerBucketStart(start).
+		SetLayerBucketEnd(end).
+		Update()
+	if err == nil {
+		err = tx.Commit().Error
+	}
+	if err != nil {
+		tx.Rollback()
+		return layer.NewSetFlagLayerDefault(500).WithPayload(
+			ErrorMessage("cannot set the layer of flag %v. %s", params.FlagID, err))
+	}
+
+	if err := entity.NewFlagQuerySet(getDB()).IDEq(flagID).One(f); err != nil {
+		return layer.NewSetFlagLayerDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+	payload, err := e2rMapFlag(f, true)
+	if err != nil {
+		return layer.NewSetFlagLayerDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+
+	entity.SaveFlagSnapshot(getDB(), flagID, getSubjectFromRequest(params.HTTPRequest))
+	return layer.NewSetFlagLayerOK().WithPayload(payload)
+}
+
+// allocateFlagLayerBuckets allocates percent of the layer's buckets to the flag. A flag
+// staying in the same layer keeps its first bucket when possible, so that its entities
+// are not reshuffled. The layer row is locked until the transaction ends, so that the
+// concurrent allocations in the layer don't see the same free buckets
+func allocateFlagLayerBuckets(tx *gorm.DB, f *entity.Flag, layerID uint, percent uint) (start uint, end uint, err *Error) {
+	if percent == 0 {
+		return 0, 0, NewError(400, "percent is required to join a layer")
+	}
+	if err := entity.NewLayerQuerySet(entity.ForUpdate(tx)).IDEq(layerID).One(&entity.Layer{}); err != nil {
+		return 0, 0, NewError(400, "cannot find layer %v", layerID)
+	}
+
+	others := []entity.Flag{}
+	if err := entity.NewFlagQuerySet(tx).LayerIDEq(layerID).IDNe(f.ID).All(&others); err != nil {
+		return 0, 0, NewError(500, "%s", err)
+	}
+
+	size := percent * entity.PercentMultiplier
+	if f.LayerID == layerID && entity.LayerBucketsFree(others, f.LayerBucketStart, size) {
+		return f.LayerBucketStart, f.LayerBucketStart + size, nil
+	}
+	start, e := entity.AllocateLayerBuckets(others, size)
+	if e != nil {
+		return 0, 0, NewError(400, "%s", e)
+	}
+	return start, start + size, nil
+}
+
+// findLayerFlags finds the flags in the layer ordered by their buckets
+func findLayerFlags(db *gorm.DB, layerID uint) ([]entity.Flag, error) {
+	fs := []entity.Flag{}
+	err := entity.NewFlagQuerySet(db).LayerIDEq(layerID).OrderAscByLayerBucketStart().All(&fs)
+	return fs, err
+}
@@ -0,0 +1,243 @@
+package handler
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/checkr/flagr/pkg/entity"
+	"github.com/checkr/flagr/pkg/util"
+	"github.com/checkr/flagr/swagger_gen/models"
+	"github.com/checkr/flagr/swagger_gen/restapi/operations/evaluation"
+	"github.com/checkr/flagr/swagger_gen/restapi/operations/layer"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/jinzhu/gorm"
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCrudLayers(t *testing.T) {
+	db := entity.NewTestDB()
+	defer db.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+
+	for i := 1; i <= 3; i++ {
+		db.Create(&entity.Flag{Key: fmt.Sprintf("flag_%d", i)})
+	}
+
+	setFlagLayer := func(flagID int64, layerID int64, percent int64) middleware.Responder {
+		return setFlagLayerHandler(layer.SetFlagLayerParams{
+			FlagID: flagID,
+			Body:   &models.SetFlagLayerRequest{LayerID: util.Int64Ptr(layerID), Percent: percent},
+		})
+	}
+
+	t.Run("create layer", func(t *testing.T) {
+		res := createLayerHandler(layer.CreateLayerParams{
+			Body: &models.CreateLayerRequest{Key: util.StringPtr("checkout_page"), Description: "checkout page"},
+		})
+		l := res.(*layer.CreateLayerOK).Payload
+		assert.Equal(t, int64(1), *l.ID)
+		assert.Equal(t, "checkout_page", *l.Key)
+		assert.Len(t, l.Flags, 0)
+
+		res = createLayerHandler(layer.CreateLayerParams{
+			Body: &models.CreateLayerRequest{Key: util.StringPtr("checkout_page")},
+		})
+		assert.IsType(t, &layer.CreateLayerDefault{}, res)
+
+		res = createLayerHandler(layer.CreateLayerParams{
+			Body: &models.CreateLayerRequest{Key: util.StringPtr("a,b")},
+		})
+		assert.IsType(t, &layer.CreateLayerDefault{}, res)
+	})
+
+	t.Run("flags join the layer", func(t *testing.T) {
+		f := setFlagLayer(1, 1, 30).(*layer.SetFlagLayerOK).Payload
+		assert.Equal(t, int64(1), f.LayerID)
+		assert.Equal(t, int64(0), f.LayerBucketStart)
+		assert.Equal(t, int64(300), f.LayerBucketEnd)
+
+		f = setFlagLayer(2, 1, 50).(*layer.SetFlagLayerOK).Payload
+		assert.Equal(t, int64(300), f.LayerBucketStart)
+		assert.Equal(t, int64(800), f.LayerBucketEnd)
+
+		res := setFlagLayer(3, 1, 30)
+		assert.Contains(t, *res.(*layer.SetFlagLayerDefault).Payload.Message, "not 300 free buckets")
+
+		res = setFlagLayer(3, 1, 0)
+		assert.Contains(t, *res.(*layer.SetFlagLayerDefault).Payload.Message, "percent is required")
+
+		res = setFlagLayer(3, 999, 10)
+		assert.Contains(t, *res.(*layer.SetFlagLayerDefault).Payload.Message, "cannot find layer")
+
+		res = setFlagLayer(999, 1, 10)
+		assert.IsType(t, &layer.SetFlagLayerDefault{}, res)
+	})
+
+	t.Run("resize keeps the first bucket", func(t *testing.T) {
+		f := setFlagLayer(2, 1, 70).(*layer.SetFlagLayerOK).Payload
+		assert.Equal(t, int64(300), f.LayerBucketStart)
+		assert.Equal(t, int64(1000), f.LayerBucketEnd)
+
+		f = setFlagLayer(2, 1, 50).(*layer.SetFlagLayerOK).Payload
+		assert.Equal(t, int64(300), f.LayerBucketStart)
+		assert.Equal(t, int64(800), f.LayerBucketEnd)
+	})
+
+	t.Run("get and find layers", func(t *testing.T) {
+		l := getLayerHandler(layer.GetLayerParams{LayerID: 1}).(*layer.GetLayerOK).Payload
+		assert.Len(t, l.Flags, 2)
+		assert.Equal(t, "flag_1", l.Flags[0].FlagKey)
+		assert.Equal(t, int64(300), *l.Flags[1].BucketStart)
+
+		ls := findLayersHandler(layer.FindLayersParams{}).(*layer.FindLayersOK).Payload
+		assert.Len(t, ls, 1)
+
+		res := getLayerHandler(layer.GetLayerParams{LayerID: 999})
+		assert.IsType(t, &layer.GetLayerDefault{}, res)
+	})
+
+	t.Run("put layer", func(t *testing.T) {
+		res := putLayerHandler(layer.PutLayerParams{
+			LayerID: 1,
+			Body:    &models.PutLayerRequest{Description: util.StringPtr("new description")},
+		})
+		assert.Equal(t, "new description", res.(*layer.PutLayerOK).Payload.Description)
+	})
+
+	t.Run("delete layer", func(t *testing.T) {
+		res := deleteLayerHandler(layer.DeleteLayerParams{LayerID: 1})
+		assert.Contains(t, *res.(*layer.DeleteLayerDefault).Payload.Message, "2 flags")
+
+		f := setFlagLayer(1, 0, 0).(*layer.SetFlagLayerOK).Payload
+		assert.Equal(t, int64(0), f.LayerID)
+		assert.Equal(t, int64(0), f.LayerBucketEnd)
+		setFlagLayer(2, 0, 0)
+
+		res = deleteLayerHandler(layer.DeleteLayerParams{LayerID: 1})
+		assert.IsType(t, &layer.DeleteLayerOK{}, res)
+	})
+}
+
+// genFixtureLayerEvalCache generates two copies of the fixture flag splitting layer 1
+func genFixtureLayerEvalCache() *EvalCache {
+	f1 := entity.GenFixtureFlag()
+	f1.LayerID, f1.LayerBucketStart, f1.LayerBucketEnd = 1, 0, 400
+	f2 := entity.GenFixtureFlag()
+	f2.Model = gorm.Model{ID: 101}
+	f2.Key = "flag_key_101"
+	f2.LayerID, f2.LayerBucketStart, f2.LayerBucketEnd = 1, 400, 1000
+	return &EvalCache{
+		mapCache: map[string]*entity.Flag{
+			"100": &f1, f1.Key: &f1,
+			"101": &f2, f2.Key: &f2,
+		},
+	}
+}
+
+func TestSetFlagLayerConcurrently(t *testing.T) {
+	// a file DB, so that the concurrent transactions use their own connections
+	dir, _ := ioutil.TempDir("", "flagr")
+	defer os.RemoveAll(dir)
+	db, err := gorm.Open("sqlite3", filepath.Join(dir, "flagr.sqlite")+"?_busy_timeout=5000")
+	assert.NoError(t, err)
+	defer db.Close()
+	db.AutoMigrate(entity.AutoMigrateTables...)
+	defer gostub.StubFunc(&getDB, db).Reset()
+
+	db.Create(&entity.Layer{Key: "checkout_page"})
+	for i := 1; i <= 5; i++ {
+		db.Create(&entity.Flag{Key: fmt.Sprintf("flag_%d", i)})
+	}
+
+	codes := make(chan int, 5)
+	var wg sync.WaitGroup
+	for i := 1; i <= 5; i++ {
+		wg.Add(1)
+		go func(flagID int64) {
+			defer wg.Done()
+			res := setFlagLayerHandler(layer.SetFlagLayerParams{
+				FlagID: flagID,
+				Body:   &models.SetFlagLayerRequest{LayerID: util.Int64Ptr(1), Percent: 30},
+			})
+			rec := httptest.NewRecorder()
+			res.WriteResponse(rec, runtime.JSONProducer())
+			codes <- rec.Code
+		}(int64(i))
+	}
+	wg.Wait()
+	close(codes)
+
+	joined := 0
+	for code := range codes {
+		if code == 200 {
+			joined++
+		} else {
+			assert.Equal(t, 400, code)
+		}
+	}
+	assert.Equal(t, 3, joined)
+
+	fs, err := findLayerFlags(db, 1)
+	assert.NoError(t, err)
+	assert.Len(t, fs, 3)
+	for i := 1; i < len(fs); i++ {
+		assert.True(t, fs[i-1].LayerBucketEnd <= fs[i].LayerBucketStart, "the buckets of the flags overlap")
+	}
+}
+
+func TestEvalFlagWithLayer(t *testing.T) {
+	defer gostub.StubFunc(&GetEvalCache, genFixtureLayerEvalCache()).Reset()
+	defer gostub.StubFunc(&logEvalResult).Reset()
+
+	counts := map[int64]int{}
+	for i := 0; i < 1000; i++ {
+		entityID := fmt.Sprintf("entity_%d", i)
+		in := 0
+		for _, flagID := range []int64{100, 101} {
+			r := evalFlag(models.EvalContext{
+				EntityID:      entityID,
+				EntityContext: map[string]interface{}{"dl_state": "CA"},
+				FlagID:        flagID,
+			})
+			if r.VariantID != nil {
+				in++
+				counts[flagID]++
+			}
+		}
+		assert.Equal(t, 1, in)
+	}
+	assert.InDelta(t, 400, counts[100], 50)
+	assert.InDelta(t, 600, counts[101], 50)
+
+	t.Run("debug message", func(t *testing.T) {
+		for i := 0; i < 10; i++ {
+			r := evalFlag(models.EvalContext{EntityID: fmt.Sprintf("entity_%d", i), FlagID: 100})
+			if ok, _ := GetEvalCache().GetByFlagKeyOrID(uint(100)).InLayerBuckets(fmt.Sprintf("entity_%d", i)); !ok {
+				assert.Contains(t, r.EvalDebugLog.Msg, "out of the buckets [0, 400) of flagID 100")
+				return
+			}
+		}
+		t.Fatal("no entity out of the buckets")
+	})
+}
+
+func TestEvaluationConfigWithLayer(t *testing.T) {
+	defer gostub.StubFunc(&GetEvalCache, genFixtureLayerEvalCache()).Reset()
+
+	res := getEvaluationConfigHandler(evaluation.GetEvaluationConfigParams{})
+	fs := res.(*evaluation.GetEvaluationConfigOK).Payload.Flags
+	assert.Len(t, fs, 2)
+	assert.Equal(t, int64(1), fs[0].LayerID)
+	assert.Equal(t, entity.LayerSalt(1), fs[0].LayerSalt)
+	assert.Equal(t, int64(400), fs[0].LayerBucketEnd)
+	assert.Equal(t, int64(400), fs[1].LayerBucketStart)
+}
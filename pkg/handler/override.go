@@ -0,0 +1,117 @@
+package handler
+
+import (
+	"time"
+
+	"github.com/checkr/flagr/pkg/entity"
+	"github.com/checkr/flagr/pkg/mapper/entity_restapi/e2r"
+	"github.com/checkr/flagr/pkg/util"
+	"github.com/checkr/flagr/swagger_gen/restapi/operations/override"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+)
+
+var findOverridesHandler = func(params override.FindOverridesParams) middleware.Responder {
+	ovs := []entity.Override{}
+	err := entity.NewOverrideQuerySet(getDB()).FlagIDEq(uint(params.FlagID)).OrderAscByID().All(&ovs)
+	if err != nil {
+		return override.NewFindOverridesDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+	vs, err := findFlagVariants(uint(params.FlagID))
+	if err != nil {
+		return override.NewFindOverridesDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+	return override.NewFindOverridesOK().WithPayload(e2r.MapOverrides(ovs, vs))
+}
+
+var createOverrideHandler = func(params override.CreateOverrideParams) middleware.Responder {
+	o := &entity.Override{
+		FlagID:     uint(params.FlagID),
+		EntityType: params.Body.EntityType,
+		EntityID:   util.SafeString(params.Body.EntityID),
+		ExpiresAt:  mapOverrideExpiresAt(params.Body.ExpiresAt),
+	}
+
+	n, err := entity.NewOverrideQuerySet(getDB()).FlagIDEq(o.FlagID).
+		EntityTypeEq(o.EntityType).EntityIDEq(o.EntityID).Count()
+	if err != nil {
+		return override.NewCreateOverrideDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+	if n > 0 {
+		return override.NewCreateOverrideDefault(400).WithPayload(ErrorMessage(
+			"cannot create override. entityID %s of entityType '%s' already has an override", o.EntityID, o.EntityType))
+	}
+
+	vs, e := validateOverrideVariant(o, util.SafeString(params.Body.VariantKey))
+	if e != nil {
+		return override.NewCreateOverrideDefault(e.StatusCode).WithPayload(ErrorMessage("%s", e))
+	}
+	if err := o.Create(getDB()); err != nil {
+		return override.NewCreateOverrideDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+
+	entity.SaveFlagSnapshot(getDB(), o.FlagID, getSubjectFromRequest(params.HTTPRequest))
+	return override.NewCreateOverrideOK().WithPayload(e2r.MapOverride(o, vs))
+}
+
+var putOverrideHandler = func(params override.PutOverrideParams) middleware.Responder {
+	o := &entity.Override{}
+	err := entity.NewOverrideQuerySet(getDB()).IDEq(uint(params.OverrideID)).FlagIDEq(uint(params.FlagID)).One(o)
+	if err != nil {
+		return override.NewPutOverrideDefault(404).WithPayload(
+			ErrorMessage("cannot find override %v of flag %v. %s", params.OverrideID, params.FlagID, err))
+	}
+
+	vs, e := validateOverrideVariant(o, util.SafeString(params.Body.VariantKey))
+	if e != nil {
+		return override.NewPutOverrideDefault(e.StatusCode).WithPayload(ErrorMessage("%s", e))
+	}
+	o.ExpiresAt = mapOverrideExpiresAt(params.Body.ExpiresAt)
+	if err := getDB().Save(o).Error; err != nil {
+		return override.NewPutOverrideDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+
+	entity.SaveFlagSnapshot(getDB(), o.FlagID, getSubjectFromRequest(params.HTTPRequest))
+	return override.NewPutOverrideOK().WithPayload(e2r.MapOverride(o, vs))
+}
+
+var deleteOverrideHandler = func(params override.DeleteOverrideParams) middleware.Responder {
+	err := entity.NewOverrideQuerySet(getDB()).IDEq(uint(params.OverrideID)).FlagIDEq(uint(params.FlagID)).Delete()
+	if err != nil {
+		return override.NewDeleteOverrideDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+
+	entity.SaveFlagSnapshot(getDB(), uint(params.FlagID), getSubjectFromRequest(params.HTTPRequest))
+	return override.NewDeleteOverrideOK()
+}
+
+// validateOverrideVariant sets the variant of the override by its key, and returns the
+// variants of the flag for the mapping
+var validateOverrideVariant = func(o *entity.Override, variantKey string) ([]entity.Variant, *Error) {
+	vs, err := findFlagVariants(o.FlagID)
+	if err != nil {
+		return nil, NewError(500, "%s", err)
+	}
+	for _, v := range vs {
+		if v.Key == variantKey {
+			o.VariantID = v.ID
+			return vs, nil
+		}
+	}
+	return nil, NewError(400, "cannot find variant %s in flag %v", variantKey, o.FlagID)
+}
+
+func findFlagVariants(flagID uint) ([]entity.Variant, error) {
+	vs := []entity.Variant{}
+	err := entity.NewVariantQuerySet(getDB()).FlagIDEq(flagID).OrderAscByID().All(&vs)
+	return vs, err
+}
+
+// mapOverrideExpiresAt maps the expiresAt of the request, the zero time means it never expires
+func mapOverrideExpiresAt(t strfmt.DateTime) *time.Time {
+	if time.Time(t).IsZero() {
+		return nil
+	}
+	expiresAt := time.Time(t)
+	return &expiresAt
+}
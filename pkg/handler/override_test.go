@@ -0,0 +1,172 @@
+package handler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/checkr/flagr/pkg/entity"
+	"github.com/checkr/flagr/pkg/util"
+	"github.com/checkr/flagr/swagger_gen/models"
+	"github.com/checkr/flagr/swagger_gen/restapi/operations/evaluation"
+	"github.com/checkr/flagr/swagger_gen/restapi/operations/override"
+	"github.com/checkr/flagr/swagger_gen/restapi/operations/variant"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/jinzhu/gorm"
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCrudOverrides(t *testing.T) {
+	db := entity.NewTestDB()
+	defer db.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+
+	db.Create(&entity.Flag{Key: "flag_1"})
+	db.Create(&entity.Variant{FlagID: 1, Key: "control"})
+	db.Create(&entity.Variant{FlagID: 1, Key: "treatment"})
+
+	expiresAt := strfmt.DateTime(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	t.Run("create override", func(t *testing.T) {
+		res := createOverrideHandler(override.CreateOverrideParams{
+			FlagID: 1,
+			Body: &models.CreateOverrideRequest{
+				EntityID:   util.StringPtr("qa_1"),
+				EntityType: "user",
+				VariantKey: util.StringPtr("treatment"),
+			},
+		})
+		o := res.(*override.CreateOverrideOK).Payload
+		assert.Equal(t, int64(1), o.ID)
+		assert.Equal(t, "user", *o.EntityType)
+		assert.Equal(t, int64(2), *o.VariantID)
+		assert.Equal(t, "treatment", *o.VariantKey)
+		assert.True(t, time.Time(o.ExpiresAt).IsZero())
+
+		res = createOverrideHandler(override.CreateOverrideParams{
+			FlagID: 1,
+			Body: &models.CreateOverrideRequest{
+				EntityID:   util.StringPtr("qa_1"),
+				EntityType: "user",
+				VariantKey: util.StringPtr("control"),
+			},
+		})
+		assert.Contains(t, *res.(*override.CreateOverrideDefault).Payload.Message, "already has an override")
+
+		res = createOverrideHandler(override.CreateOverrideParams{
+			FlagID: 1,
+			Body: &models.CreateOverrideRequest{
+				EntityID:   util.StringPtr("qa_2"),
+				VariantKey: util.StringPtr("treatment2"),
+			},
+		})
+		assert.Contains(t, *res.(*override.CreateOverrideDefault).Payload.Message, "cannot find variant treatment2")
+	})
+
+	t.Run("put override", func(t *testing.T) {
+		res := putOverrideHandler(override.PutOverrideParams{
+			FlagID:     1,
+			OverrideID: 1,
+			Body:       &models.PutOverrideRequest{VariantKey: util.StringPtr("control"), ExpiresAt: expiresAt},
+		})
+		o := res.(*override.PutOverrideOK).Payload
+		assert.Equal(t, int64(1), *o.VariantID)
+		assert.Equal(t, "control", *o.VariantKey)
+		assert.True(t, time.Time(expiresAt).Equal(time.Time(o.ExpiresAt)))
+
+		res = putOverrideHandler(override.PutOverrideParams{
+			FlagID:     2,
+			OverrideID: 1,
+			Body:       &models.PutOverrideRequest{VariantKey: util.StringPtr("control")},
+		})
+		assert.IsType(t, &override.PutOverrideDefault{}, res)
+	})
+
+	t.Run("find overrides", func(t *testing.T) {
+		res := findOverridesHandler(override.FindOverridesParams{FlagID: 1})
+		ovs := res.(*override.FindOverridesOK).Payload
+		assert.Len(t, ovs, 1)
+		assert.Equal(t, "qa_1", *ovs[0].EntityID)
+		assert.Equal(t, "control", *ovs[0].VariantKey)
+	})
+
+	t.Run("cannot delete the variant of an override", func(t *testing.T) {
+		res := NewCRUD().DeleteVariant(variant.DeleteVariantParams{FlagID: 1, VariantID: 1})
+		assert.Contains(t, *res.(*variant.DeleteVariantDefault).Payload.Message, "override 1 of entityID qa_1 still uses it")
+	})
+
+	t.Run("delete override", func(t *testing.T) {
+		res := deleteOverrideHandler(override.DeleteOverrideParams{FlagID: 1, OverrideID: 1})
+		assert.IsType(t, &override.DeleteOverrideOK{}, res)
+
+		res = findOverridesHandler(override.FindOverridesParams{FlagID: 1})
+		assert.Len(t, res.(*override.FindOverridesOK).Payload, 0)
+
+		res = NewCRUD().DeleteVariant(variant.DeleteVariantParams{FlagID: 1, VariantID: 1})
+		assert.IsType(t, &variant.DeleteVariantOK{}, res)
+	})
+}
+
+func genFixtureOverrideEvalCache() *EvalCache {
+	past := time.Now().Add(-time.Hour)
+	f := entity.GenFixtureFlag()
+	f.Overrides = []entity.Override{
+		{Model: gorm.Model{ID: 600}, FlagID: 100, EntityID: "qa_1", VariantID: 301},
+		{Model: gorm.Model{ID: 601}, FlagID: 100, EntityType: "user", EntityID: "qa_1", VariantID: 300},
+		{Model: gorm.Model{ID: 602}, FlagID: 100, EntityID: "qa_2", VariantID: 301, ExpiresAt: &past},
+	}
+	f.PrepareEvaluation()
+	return &EvalCache{mapCache: map[string]*entity.Flag{"100": &f}}
+}
+
+func TestEvalFlagWithOverrides(t *testing.T) {
+	defer gostub.StubFunc(&GetEvalCache, genFixtureOverrideEvalCache()).Reset()
+	defer gostub.StubFunc(&logEvalResult).Reset()
+
+	t.Run("override applied before the segments", func(t *testing.T) {
+		r := evalFlag(models.EvalContext{
+			EntityID:      "qa_1",
+			EntityType:    util.StringPtr("company"),
+			EntityContext: map[string]interface{}{"dl_state": "NY"},
+			FlagID:        100,
+		})
+		assert.Equal(t, int64(301), *r.VariantID)
+		assert.Equal(t, "treatment", *r.VariantKey)
+		assert.Nil(t, r.SegmentID)
+		assert.Equal(t, entity.Attachment{"value": "321"}, r.VariantAttachment)
+		assert.Contains(t, r.EvalDebugLog.Msg, "override applied. overrideID 600")
+	})
+
+	t.Run("override of the entityType", func(t *testing.T) {
+		r := evalFlag(models.EvalContext{
+			EntityID:   "qa_1",
+			EntityType: util.StringPtr("user"),
+			FlagID:     100,
+		})
+		assert.Equal(t, int64(300), *r.VariantID)
+		assert.Contains(t, r.EvalDebugLog.Msg, "override applied. overrideID 601")
+	})
+
+	t.Run("expired override", func(t *testing.T) {
+		r := evalFlag(models.EvalContext{
+			EntityID:      "qa_2",
+			EntityType:    util.StringPtr("user"),
+			EntityContext: map[string]interface{}{"dl_state": "NY"},
+			FlagID:        100,
+		})
+		assert.Nil(t, r.VariantID)
+		assert.NotContains(t, r.EvalDebugLog.Msg, "override applied")
+	})
+}
+
+func TestEvaluationConfigWithOverrides(t *testing.T) {
+	defer gostub.StubFunc(&GetEvalCache, genFixtureOverrideEvalCache()).Reset()
+
+	res := getEvaluationConfigHandler(evaluation.GetEvaluationConfigParams{})
+	fs := res.(*evaluation.GetEvaluationConfigOK).Payload.Flags
+	assert.Len(t, fs[0].Overrides, 3)
+	assert.Equal(t, "treatment", *fs[0].Overrides[0].VariantKey)
+	assert.Equal(t, "user", *fs[0].Overrides[1].EntityType)
+	assert.False(t, time.Time(fs[0].Overrides[2].ExpiresAt).IsZero())
+}
@@ -0,0 +1,306 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/checkr/flagr/pkg/config"
+	"github.com/checkr/flagr/pkg/entity"
+	"github.com/checkr/flagr/pkg/util"
+	"github.com/checkr/flagr/swagger_gen/models"
+	"github.com/checkr/flagr/swagger_gen/restapi/operations/flag"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/sirupsen/logrus"
+)
+
+var getFlagStatsHandler = func(params flag.GetFlagStatsParams) middleware.Responder {
+	f := &entity.Flag{}
+	if err := entity.NewFlagQuerySet(getDB()).IDEq(uint(params.FlagID)).One(f); err != nil {
+		return flag.NewGetFlagStatsDefault(404).WithPayload(
+			ErrorMessage("cannot find flag %v. %s", params.FlagID, err))
+	}
+	if err := f.Preload(getDB()); err != nil {
+		return flag.NewGetFlagStatsDefault(500).WithPayload(
+			ErrorMessage("cannot preload flag %v. %s", params.FlagID, err))
+	}
+
+	acs := []entity.AssignmentCount{}
+	if err := entity.NewAssignmentCountQuerySet(getDB()).FlagIDEq(f.ID).All(&acs); err != nil {
+		return flag.NewGetFlagStatsDefault(500).WithPayload(
+			ErrorMessage("cannot find assignment counts for %v. %s", params.FlagID, err))
+	}
+
+	return flag.NewGetFlagStatsOK().WithPayload(computeFlagStats(f, acs))
+}
+
+func computeFlagStats(f *entity.Flag, acs []entity.AssignmentCount) *models.FlagStats {
+	counts := make(map[uint]map[uint]uint) // segmentID -> variantID -> count
+	for _, ac := range acs {
+		if counts[ac.SegmentID] == nil {
+			counts[ac.SegmentID] = make(map[uint]uint)
+		}
+		counts[ac.SegmentID][ac.VariantID] += ac.Count
+	}
+
+	variantKeys := make(map[uint]string)
+	for _, v := range f.Variants {
+		variantKeys[v.ID] = v.Key
+	}
+
+	stats := &models.FlagStats{
+		FlagID:      util.Int64Ptr(int64(f.ID)),
+		FlagKey:     f.Key,
+		SrmDetected: util.BoolPtr(false),
+		Segments:    []*models.SegmentStats{},
+	}
+	for _, s := range f.Segments {
+		ss := computeSegmentStats(s, counts[s.ID], variantKeys)
+		if *ss.SrmDetected {
+			stats.SrmDetected = util.BoolPtr(true)
+		}
+		stats.Segments = append(stats.Segments, ss)
+	}
+	return stats
+}
+
+// computeSegmentStats runs the chi-squared goodness of fit test of the observed
+// assignment counts against the distribution percents of the segment
+func computeSegmentStats(s entity.Segment, counts map[uint]uint, variantKeys map[uint]string) *models.SegmentStats {
+	total := uint(0)
+	for _, n := range counts {
+		total += n
+	}
+
+	chiSquared := 0.0
+	categories := 0
+	unexpected := uint(0)
+	vs := []*models.VariantStats{}
+	distributed := make(map[uint]bool)
+
+	for _, d := range s.Distributions {
+		distributed[d.VariantID] = true
+		observed := counts[d.VariantID]
+		expected := float64(total) * float64(d.Percent) / 100
+		vs = append(vs, &models.VariantStats{
+			VariantID:       util.Int64Ptr(int64(d.VariantID)),
+			VariantKey:      util.StringPtr(d.VariantKey),
+			ExpectedPercent: util.Int64Ptr(int64(d.Percent)),
+			ObservedCount:   util.Int64Ptr(int64(observed)),
+			ExpectedCount:   expected,
+		})
+		if d.Percent == 0 {
+			unexpected += observed
+			continue
+		}
+		categories++
+		if total > 0 {
+			chiSquared += math.Pow(float64(observed)-expected, 2) / expected
+		}
+	}
+
+	// variants that got assigned but are no longer in the distributions
+	for variantID, observed := range counts {
+		if distributed[variantID] {
+			continue
+		}
+		unexpected += observed
+		vs = append(vs, &models.VariantStats{
+			VariantID:       util.Int64Ptr(int64(variantID)),
+			VariantKey:      util.StringPtr(variantKeys[variantID]),
+			ExpectedPercent: util.Int64Ptr(0),
+			ObservedCount:   util.Int64Ptr(int64(observed)),
+		})
+	}
+
+	pValue := 1.0
+	if unexpected > 0 {
+		pValue = 0 // impossible to observe any of them if the bucketing is correct
+	} else if categories > 1 && total > 0 {
+		pValue = chiSquaredSurvival(chiSquared, categories-1)
+	}
+
+	srm := int(total) >= config.Config.SRMMinSampleSize && pValue < config.Config.SRMPValueThreshold
+	return &models.SegmentStats{
+		SegmentID:   util.Int64Ptr(int64(s.ID)),
+		TotalCount:  util.Int64Ptr(int64(total)),
+		ChiSquared:  chiSquared,
+		PValue:      util.Float64Ptr(pValue),
+		SrmDetected: util.BoolPtr(srm),
+		Variants:    vs,
+	}
+}
+
+// chiSquaredSurvival returns P(X >= x) of the chi-squared distribution with df degrees of freedom
+func chiSquaredSurvival(x float64, df int) float64 {
+	if x <= 0 {
+		return 1
+	}
+	return regularizedGammaQ(float64(df)/2, x/2)
+}
+
+// regularizedGammaQ is the regularized upper incomplete gamma function Q(a, x),
+// see Numerical Recipes 6.2
+func regularizedGammaQ(a, x float64) float64 {
+	const (
+		maxIter = 1000
+		eps     = 1e-15
+		tiny    = 1e-300
+	)
+	lga, _ := math.Lgamma(a)
+	prefix := math.Exp(-x + a*math.Log(x) - lga)
+
+	if x < a+1 {
+		// series representation of P(a, x)
+		sum := 1 / a
+		term := sum
+		for n := 1; n < maxIter; n++ {
+			term *= x / (a + float64(n))
+			sum += term
+			if math.Abs(term) < math.Abs(sum)*eps {
+				break
+			}
+		}
+		return 1 - sum*prefix
+	}
+
+	// continued fraction representation of Q(a, x) with the modified Lentz's method
+	b := x + 1 - a
+	c := 1 / tiny
+	d := 1 / b
+	h := d
+	for i := 1; i < maxIter; i++ {
+		an := -float64(i) * (float64(i) - a)
+		b += 2
+		d = an*d + b
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = b + an/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		del := d * c
+		h *= del
+		if math.Abs(del-1) < eps {
+			break
+		}
+	}
+	return prefix * h
+}
+
+// SRMChecker periodically checks the SRM of all the flags and sends the webhook
+// for the segments that are newly detected with SRM. Every replica runs it, and
+// the SRMAlert marks in DB make sure only one of them sends the webhook
+type SRMChecker struct {
+	checkInterval time.Duration
+}
+
+// NewSRMChecker creates a new SRMChecker
+func NewSRMChecker() *SRMChecker {
+	return &SRMChecker{
+		checkInterval: config.Config.SRMCheckInterval,
+	}
+}
+
+// Start starts the polling of SRMChecker
+func (sc *SRMChecker) Start() {
+	go func() {
+		for range time.Tick(sc.checkInterval) {
+			err := sc.check()
+			if err != nil {
+				logrus.WithField("err", err).Error("check sample ratio mismatch error")
+			}
+		}
+	}()
+}
+
+func (sc *SRMChecker) check() error {
+	acs := []entity.AssignmentCount{}
+	if err := entity.NewAssignmentCountQuerySet(getDB()).All(&acs); err != nil {
+		return err
+	}
+
+	flagACs := make(map[uint][]entity.AssignmentCount)
+	for _, ac := range acs {
+		flagACs[ac.FlagID] = append(flagACs[ac.FlagID], ac)
+	}
+
+	for flagID, acs := range flagACs {
+		f := GetEvalCache().GetByFlagKeyOrID(flagID)
+		if f == nil {
+			continue
+		}
+		stats := computeFlagStats(f, acs)
+		for _, ss := range stats.Segments {
+			segmentID := uint(*ss.SegmentID)
+			if !*ss.SrmDetected {
+				if err := entity.ResetSRMAlert(getDB(), segmentID); err != nil {
+					return err
+				}
+				continue
+			}
+			if config.Config.SRMWebhookURL == "" {
+				continue
+			}
+
+			claimed, err := entity.ClaimSRMAlert(getDB(), f.ID, segmentID)
+			if err != nil {
+				return err
+			}
+			if !claimed {
+				continue
+			}
+			payload := &srmWebhookPayload{
+				Event:     "srm_detected",
+				FlagID:    f.ID,
+				FlagKey:   f.Key,
+				Segment:   ss,
+				Timestamp: util.TimeNow(),
+			}
+			if err := sendSRMWebhook(config.Config.SRMWebhookURL, payload); err != nil {
+				logrus.WithFields(logrus.Fields{
+					"err":       err,
+					"flagID":    f.ID,
+					"segmentID": segmentID,
+				}).Error("failed to send the SRM webhook")
+				// release the mark so that the next check retries the webhook
+				if err := entity.ResetSRMAlert(getDB(), segmentID); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+type srmWebhookPayload struct {
+	Event     string               `json:"event"`
+	FlagID    uint                 `json:"flagID"`
+	FlagKey   string               `json:"flagKey"`
+	Segment   *models.SegmentStats `json:"segment"`
+	Timestamp string               `json:"timestamp"`
+}
+
+var sendSRMWebhook = func(url string, payload *srmWebhookPayload) error {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	client := &http.Client{Timeout: config.Config.SRMWebhookTimeout}
+	resp, err := client.Post(url, "application/json", bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status code %d from the SRM webhook", resp.StatusCode)
+	}
+	return nil
+}
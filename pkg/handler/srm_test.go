@@ -0,0 +1,153 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/checkr/flagr/pkg/config"
+	"github.com/checkr/flagr/pkg/entity"
+	"github.com/checkr/flagr/swagger_gen/restapi/operations/flag"
+
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChiSquaredSurvival(t *testing.T) {
+	t.Run("known critical values", func(t *testing.T) {
+		assert.InDelta(t, 0.05, chiSquaredSurvival(3.841, 1), 1e-4)
+		assert.InDelta(t, 0.05, chiSquaredSurvival(5.991, 2), 1e-4)
+		assert.InDelta(t, 0.001, chiSquaredSurvival(10.828, 1), 1e-5)
+		assert.InDelta(t, 0.5, chiSquaredSurvival(9.342, 10), 1e-3)
+	})
+
+	t.Run("boundaries", func(t *testing.T) {
+		assert.Equal(t, 1.0, chiSquaredSurvival(0, 1))
+		assert.InDelta(t, 0, chiSquaredSurvival(1000, 1), 1e-15)
+	})
+}
+
+func TestComputeSegmentStats(t *testing.T) {
+	s := entity.GenFixtureSegment()
+	variantKeys := map[uint]string{300: "control", 301: "treatment", 302: "removed"}
+
+	t.Run("balanced counts", func(t *testing.T) {
+		ss := computeSegmentStats(s, map[uint]uint{300: 5010, 301: 4990}, variantKeys)
+		assert.Equal(t, int64(10000), *ss.TotalCount)
+		assert.InDelta(t, 0.04, ss.ChiSquared, 1e-9)
+		assert.True(t, *ss.PValue > 0.8)
+		assert.False(t, *ss.SrmDetected)
+		assert.Len(t, ss.Variants, 2)
+		assert.Equal(t, 5000.0, ss.Variants[0].ExpectedCount)
+	})
+
+	t.Run("skewed counts", func(t *testing.T) {
+		ss := computeSegmentStats(s, map[uint]uint{300: 5300, 301: 4700}, variantKeys)
+		assert.InDelta(t, 36, ss.ChiSquared, 1e-9)
+		assert.True(t, *ss.PValue < config.Config.SRMPValueThreshold)
+		assert.True(t, *ss.SrmDetected)
+	})
+
+	t.Run("skewed counts below the min sample size", func(t *testing.T) {
+		ss := computeSegmentStats(s, map[uint]uint{300: 30, 301: 2}, variantKeys)
+		assert.True(t, *ss.PValue < config.Config.SRMPValueThreshold)
+		assert.False(t, *ss.SrmDetected)
+	})
+
+	t.Run("assignments to a variant not in the distributions", func(t *testing.T) {
+		ss := computeSegmentStats(s, map[uint]uint{300: 500, 301: 500, 302: 1}, variantKeys)
+		assert.Equal(t, 0.0, *ss.PValue)
+		assert.True(t, *ss.SrmDetected)
+		assert.Len(t, ss.Variants, 3)
+		assert.Equal(t, "removed", *ss.Variants[2].VariantKey)
+	})
+
+	t.Run("no counts", func(t *testing.T) {
+		ss := computeSegmentStats(s, nil, variantKeys)
+		assert.Equal(t, int64(0), *ss.TotalCount)
+		assert.Equal(t, 1.0, *ss.PValue)
+		assert.False(t, *ss.SrmDetected)
+	})
+}
+
+func TestGetFlagStats(t *testing.T) {
+	f := entity.GenFixtureFlag()
+	db := entity.PopulateTestDB(f)
+	defer db.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+
+	entity.IncrAssignmentCount(db, 100, 200, 300, 5300)
+	entity.IncrAssignmentCount(db, 100, 200, 301, 4700)
+
+	t.Run("happy code path", func(t *testing.T) {
+		res := getFlagStatsHandler(flag.GetFlagStatsParams{FlagID: int64(100)})
+		stats := res.(*flag.GetFlagStatsOK).Payload
+		assert.Equal(t, int64(100), *stats.FlagID)
+		assert.True(t, *stats.SrmDetected)
+		assert.Len(t, stats.Segments, 1)
+		assert.Equal(t, int64(10000), *stats.Segments[0].TotalCount)
+	})
+
+	t.Run("flag not found", func(t *testing.T) {
+		res := getFlagStatsHandler(flag.GetFlagStatsParams{FlagID: int64(99999)})
+		assert.NotZero(t, res.(*flag.GetFlagStatsDefault).Payload)
+	})
+}
+
+func TestSRMChecker(t *testing.T) {
+	f := entity.GenFixtureFlag()
+	db := entity.PopulateTestDB(f)
+	defer db.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+	defer gostub.StubFunc(&GetEvalCache, GenFixtureEvalCache()).Reset()
+
+	entity.IncrAssignmentCount(db, 100, 200, 300, 5300)
+	entity.IncrAssignmentCount(db, 100, 200, 301, 4700)
+
+	payloads := []srmWebhookPayload{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		p := srmWebhookPayload{}
+		json.NewDecoder(r.Body).Decode(&p)
+		payloads = append(payloads, p)
+	}))
+	defer server.Close()
+	defer gostub.Stub(&config.Config.SRMWebhookURL, server.URL).Reset()
+
+	t.Run("webhook error", func(t *testing.T) {
+		defer gostub.Stub(&config.Config.SRMWebhookURL, "http://127.0.0.1:0").Reset()
+		sc := NewSRMChecker()
+		assert.NoError(t, sc.check())
+		n, _ := entity.NewSRMAlertQuerySet(db).SegmentIDEq(200).AlertedEq(true).Count()
+		assert.Equal(t, 0, n)
+	})
+
+	t.Run("sends the webhook only once per detection", func(t *testing.T) {
+		sc := NewSRMChecker()
+		assert.NoError(t, sc.check())
+		assert.NoError(t, sc.check())
+		assert.Len(t, payloads, 1)
+		assert.Equal(t, "srm_detected", payloads[0].Event)
+		assert.Equal(t, uint(100), payloads[0].FlagID)
+		assert.Equal(t, int64(200), *payloads[0].Segment.SegmentID)
+	})
+
+	t.Run("sends the webhook only once across replicas", func(t *testing.T) {
+		payloads = payloads[:0]
+		assert.NoError(t, entity.ResetSRMAlert(db, 200))
+		assert.NoError(t, NewSRMChecker().check())
+		assert.NoError(t, NewSRMChecker().check())
+		assert.Len(t, payloads, 1)
+	})
+
+	t.Run("sends the webhook again after the SRM is gone", func(t *testing.T) {
+		payloads = payloads[:0]
+		entity.IncrAssignmentCount(db, 100, 200, 301, 600)
+		assert.NoError(t, NewSRMChecker().check())
+		assert.Len(t, payloads, 0)
+
+		entity.IncrAssignmentCount(db, 100, 200, 300, 1000)
+		assert.NoError(t, NewSRMChecker().check())
+		assert.Len(t, payloads, 1)
+	})
+}
@@ -0,0 +1,318 @@
+package handler
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/checkr/flagr/pkg/config"
+	"github.com/checkr/flagr/pkg/entity"
+	"github.com/checkr/flagr/pkg/util"
+	"github.com/checkr/flagr/swagger_gen/restapi/operations/flag"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/jinzhu/gorm"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	singletonStickyAssignmentStore     StickyAssignmentStore
+	singletonStickyAssignmentStoreOnce sync.Once
+)
+
+// StickyAssignmentStore stores the sticky assignments of the entities
+type StickyAssignmentStore interface {
+	// Get gets the assignment of the entity for the flag, nil if there's none
+	Get(flagID uint, entityID string) (*entity.StickyAssignment, error)
+	// Set creates or replaces the assignment of the entity for the flag
+	Set(a entity.StickyAssignment) error
+	// Clear deletes the assignment of the entity for the flag, or all the
+	// assignments of the flag if entityID is empty
+	Clear(flagID uint, entityID string) error
+}
+
+// GetStickyAssignmentStore gets the sticky assignment store
+var GetStickyAssignmentStore = func() StickyAssignmentStore {
+	singletonStickyAssignmentStoreOnce.Do(func() {
+		switch config.Config.StickyAssignmentStore {
+		case "sql":
+			cs := newCachedStickyAssignmentStore(&sqlStickyAssignmentStore{})
+			cs.Start()
+			singletonStickyAssignmentStore = cs
+		case "memory":
+			singletonStickyAssignmentStore = NewMemoryStickyAssignmentStore()
+		default:
+			panic("stickyAssignmentStore not supported")
+		}
+	})
+	return singletonStickyAssignmentStore
+}
+
+// sqlStickyAssignmentStore stores the assignments in the DB
+type sqlStickyAssignmentStore struct{}
+
+func (s *sqlStickyAssignmentStore) Get(flagID uint, entityID string) (*entity.StickyAssignment, error) {
+	a := &entity.StickyAssignment{}
+	err := entity.NewStickyAssignmentQuerySet(getDB()).FlagIDEq(flagID).EntityIDEq(entityID).One(a)
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// Set upserts the assignment. The update goes first, and the update is retried if the create
+// loses the race of the unique index to another evaluation or Flagr instance
+func (s *sqlStickyAssignmentStore) Set(a entity.StickyAssignment) error {
+	updated, err := s.update(a)
+	if err != nil || updated {
+		return err
+	}
+	if err := a.Create(getDB()); err != nil {
+		if _, uerr := s.update(a); uerr != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *sqlStickyAssignmentStore) update(a entity.StickyAssignment) (bool, error) {
+	n, err := entity.NewStickyAssignmentQuerySet(getDB()).FlagIDEq(a.FlagID).EntityIDEq(a.EntityID).GetUpdater().
+		SetSegmentID(a.SegmentID).
+		SetVariantID(a.VariantID).
+		UpdateNum()
+	return n > 0, err
+}
+
+func (s *sqlStickyAssignmentStore) Clear(flagID uint, entityID string) error {
+	// hard delete so that the unique index doesn't block the next assignment
+	q := getDB().Unscoped().Where("flag_id = ?", flagID)
+	if entityID != "" {
+		q = q.Where("entity_id = ?", entityID)
+	}
+	return q.Delete(&entity.StickyAssignment{}).Error
+}
+
+type stickyAssignmentKey struct {
+	flagID   uint
+	entityID string
+}
+
+type cachedStickyAssignment struct {
+	assignment entity.StickyAssignment
+	expiresAt  time.Time
+}
+
+// cachedStickyAssignmentStore fronts a store with an in-memory cache, so that the evaluations
+// don't wait for the store. The new assignments are buffered and periodically flushed into the store
+type cachedStickyAssignmentStore struct {
+	store StickyAssignmentStore
+
+	cache   map[stickyAssignmentKey]cachedStickyAssignment
+	pending map[stickyAssignmentKey]entity.StickyAssignment
+	lock    sync.Mutex
+
+	// flushLock keeps Clear from being overwritten by an assignment being flushed
+	flushLock sync.Mutex
+
+	cacheSize     int
+	cacheTTL      time.Duration
+	flushInterval time.Duration
+}
+
+func newCachedStickyAssignmentStore(store StickyAssignmentStore) *cachedStickyAssignmentStore {
+	return &cachedStickyAssignmentStore{
+		store:         store,
+		cache:         make(map[stickyAssignmentKey]cachedStickyAssignment),
+		pending:       make(map[stickyAssignmentKey]entity.StickyAssignment),
+		cacheSize:     config.Config.StickyAssignmentCacheSize,
+		cacheTTL:      config.Config.StickyAssignmentCacheTTL,
+		flushInterval: config.Config.StickyAssignmentFlushInterval,
+	}
+}
+
+// Start starts the flushing of the new assignments
+func (s *cachedStickyAssignmentStore) Start() {
+	go func() {
+		for range time.Tick(s.flushInterval) {
+			if err := s.flush(); err != nil {
+				logrus.WithField("err", err).Error("flush sticky assignments error")
+			}
+		}
+	}()
+}
+
+func (s *cachedStickyAssignmentStore) Get(flagID uint, entityID string) (*entity.StickyAssignment, error) {
+	k := stickyAssignmentKey{flagID: flagID, entityID: entityID}
+	s.lock.Lock()
+	if a, ok := s.pending[k]; ok {
+		s.lock.Unlock()
+		return &a, nil
+	}
+	if c, ok := s.cache[k]; ok && time.Now().Before(c.expiresAt) {
+		s.lock.Unlock()
+		return &c.assignment, nil
+	}
+	s.lock.Unlock()
+
+	a, err := s.store.Get(flagID, entityID)
+	if err != nil || a == nil {
+		return a, err
+	}
+	s.lock.Lock()
+	s.cacheLocked(k, *a)
+	s.lock.Unlock()
+	return a, nil
+}
+
+func (s *cachedStickyAssignmentStore) Set(a entity.StickyAssignment) error {
+	k := stickyAssignmentKey{flagID: a.FlagID, entityID: a.EntityID}
+	s.lock.Lock()
+	s.pending[k] = a
+	s.cacheLocked(k, a)
+	s.lock.Unlock()
+	return nil
+}
+
+func (s *cachedStickyAssignmentStore) Clear(flagID uint, entityID string) error {
+	s.flushLock.Lock()
+	defer s.flushLock.Unlock()
+
+	s.lock.Lock()
+	for k := range s.pending {
+		if k.flagID == flagID && (entityID == "" || k.entityID == entityID) {
+			delete(s.pending, k)
+		}
+	}
+	for k := range s.cache {
+		if k.flagID == flagID && (entityID == "" || k.entityID == entityID) {
+			delete(s.cache, k)
+		}
+	}
+	s.lock.Unlock()
+	return s.store.Clear(flagID, entityID)
+}
+
+// cacheLocked caches the assignment, evicting an arbitrary one if the cache is full
+func (s *cachedStickyAssignmentStore) cacheLocked(k stickyAssignmentKey, a entity.StickyAssignment) {
+	if _, ok := s.cache[k]; !ok && len(s.cache) >= s.cacheSize {
+		for evicted := range s.cache {
+			delete(s.cache, evicted)
+			break
+		}
+	}
+	s.cache[k] = cachedStickyAssignment{assignment: a, expiresAt: time.Now().Add(s.cacheTTL)}
+}
+
+func (s *cachedStickyAssignmentStore) flush() error {
+	s.flushLock.Lock()
+	defer s.flushLock.Unlock()
+
+	s.lock.Lock()
+	pending := s.pending
+	s.pending = make(map[stickyAssignmentKey]entity.StickyAssignment)
+	s.lock.Unlock()
+
+	for k, a := range pending {
+		if err := s.store.Set(a); err != nil {
+			s.restore(pending)
+			return err
+		}
+		delete(pending, k)
+	}
+	return nil
+}
+
+// restore puts back the assignments that failed to flush, so that they will be retried next time
+func (s *cachedStickyAssignmentStore) restore(pending map[stickyAssignmentKey]entity.StickyAssignment) {
+	s.lock.Lock()
+	for k, a := range pending {
+		if _, ok := s.pending[k]; !ok { // the newer one wins
+			s.pending[k] = a
+		}
+	}
+	s.lock.Unlock()
+}
+
+// memoryStickyAssignmentStore keeps the assignments in the process, they are
+// lost on restart and not shared across the Flagr instances
+type memoryStickyAssignmentStore struct {
+	assignments map[stickyAssignmentKey]entity.StickyAssignment
+	lock        sync.RWMutex
+}
+
+// NewMemoryStickyAssignmentStore creates an in-memory StickyAssignmentStore
+func NewMemoryStickyAssignmentStore() StickyAssignmentStore {
+	return &memoryStickyAssignmentStore{
+		assignments: make(map[stickyAssignmentKey]entity.StickyAssignment),
+	}
+}
+
+func (s *memoryStickyAssignmentStore) Get(flagID uint, entityID string) (*entity.StickyAssignment, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	a, ok := s.assignments[stickyAssignmentKey{flagID: flagID, entityID: entityID}]
+	if !ok {
+		return nil, nil
+	}
+	return &a, nil
+}
+
+func (s *memoryStickyAssignmentStore) Set(a entity.StickyAssignment) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.assignments[stickyAssignmentKey{flagID: a.FlagID, entityID: a.EntityID}] = a
+	return nil
+}
+
+func (s *memoryStickyAssignmentStore) Clear(flagID uint, entityID string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for k := range s.assignments {
+		if k.flagID == flagID && (entityID == "" || k.entityID == entityID) {
+			delete(s.assignments, k)
+		}
+	}
+	return nil
+}
+
+// stickyAssign reuses the stored assignment of the entity if it's still in the same segment,
+// otherwise it stores the new assignment. The store errors fall back to the new assignment
+func stickyAssign(f *entity.Flag, entityID string, sID *int64, vID *int64) (*int64, string) {
+	if sID == nil || vID == nil {
+		return vID, ""
+	}
+
+	store := GetStickyAssignmentStore()
+	a, err := store.Get(f.ID, entityID)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"err": err, "flagID": f.ID}).Error("failed to get the sticky assignment")
+		return vID, ""
+	}
+	if a != nil && int64(a.SegmentID) == *sID {
+		if _, ok := f.FlagEvaluation.VariantsMap[a.VariantID]; ok {
+			return util.Int64Ptr(int64(a.VariantID)), fmt.Sprintf(
+				"sticky assignment of variantID %v in segmentID %v. ", a.VariantID, a.SegmentID)
+		}
+	}
+
+	err = store.Set(entity.StickyAssignment{
+		FlagID:    f.ID,
+		EntityID:  entityID,
+		SegmentID: uint(*sID),
+		VariantID: uint(*vID),
+	})
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"err": err, "flagID": f.ID}).Error("failed to set the sticky assignment")
+	}
+	return vID, ""
+}
+
+var deleteFlagAssignmentsHandler = func(params flag.DeleteFlagAssignmentsParams) middleware.Responder {
+	if err := GetStickyAssignmentStore().Clear(uint(params.FlagID), util.SafeString(params.EntityID)); err != nil {
+		return flag.NewDeleteFlagAssignmentsDefault(500).WithPayload(
+			ErrorMessage("cannot clear the sticky assignments of flag %v. %s", params.FlagID, err))
+	}
+	return flag.NewDeleteFlagAssignmentsOK()
+}
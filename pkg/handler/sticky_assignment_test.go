@@ -0,0 +1,202 @@
+package handler
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/checkr/flagr/pkg/entity"
+	"github.com/checkr/flagr/pkg/util"
+	"github.com/checkr/flagr/swagger_gen/models"
+	"github.com/checkr/flagr/swagger_gen/restapi/operations/flag"
+
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStickyAssignmentStores(t *testing.T) {
+	db := entity.NewTestDB()
+	defer db.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+
+	stores := map[string]StickyAssignmentStore{
+		"sql":    &sqlStickyAssignmentStore{},
+		"memory": NewMemoryStickyAssignmentStore(),
+		"cached": newCachedStickyAssignmentStore(&sqlStickyAssignmentStore{}),
+	}
+	for name, s := range stores {
+		t.Run(name, func(t *testing.T) {
+			a, err := s.Get(100, "entity_1")
+			assert.NoError(t, err)
+			assert.Nil(t, a)
+
+			assert.NoError(t, s.Set(entity.StickyAssignment{FlagID: 100, EntityID: "entity_1", SegmentID: 200, VariantID: 300}))
+			assert.NoError(t, s.Set(entity.StickyAssignment{FlagID: 100, EntityID: "entity_2", SegmentID: 200, VariantID: 300}))
+			assert.NoError(t, s.Set(entity.StickyAssignment{FlagID: 100, EntityID: "entity_1", SegmentID: 200, VariantID: 301}))
+			a, err = s.Get(100, "entity_1")
+			assert.NoError(t, err)
+			assert.Equal(t, uint(301), a.VariantID)
+
+			assert.NoError(t, s.Clear(100, "entity_1"))
+			a, _ = s.Get(100, "entity_1")
+			assert.Nil(t, a)
+			a, _ = s.Get(100, "entity_2")
+			assert.NotNil(t, a)
+
+			assert.NoError(t, s.Clear(100, ""))
+			a, _ = s.Get(100, "entity_2")
+			assert.Nil(t, a)
+
+			// the cleared assignments can be set again
+			assert.NoError(t, s.Set(entity.StickyAssignment{FlagID: 100, EntityID: "entity_1", SegmentID: 200, VariantID: 300}))
+			a, _ = s.Get(100, "entity_1")
+			assert.Equal(t, uint(300), a.VariantID)
+			assert.NoError(t, s.Clear(100, ""))
+		})
+	}
+}
+
+func TestCachedStickyAssignmentStore(t *testing.T) {
+	db := entity.NewTestDB()
+	defer db.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+
+	sqlStore := &sqlStickyAssignmentStore{}
+	s := newCachedStickyAssignmentStore(sqlStore)
+	s.cacheSize = 1
+
+	t.Run("it should buffer the assignments until the flush", func(t *testing.T) {
+		assert.NoError(t, s.Set(entity.StickyAssignment{FlagID: 100, EntityID: "entity_1", SegmentID: 200, VariantID: 300}))
+		a, _ := sqlStore.Get(100, "entity_1")
+		assert.Nil(t, a)
+		a, _ = s.Get(100, "entity_1")
+		assert.Equal(t, uint(300), a.VariantID)
+
+		assert.NoError(t, s.flush())
+		a, _ = sqlStore.Get(100, "entity_1")
+		assert.Equal(t, uint(300), a.VariantID)
+	})
+
+	t.Run("it should read the evicted and expired assignments from the store", func(t *testing.T) {
+		assert.NoError(t, s.Set(entity.StickyAssignment{FlagID: 100, EntityID: "entity_2", SegmentID: 200, VariantID: 301}))
+		assert.NoError(t, s.flush())
+		assert.Len(t, s.cache, 1)
+
+		a, _ := s.Get(100, "entity_1")
+		assert.Equal(t, uint(300), a.VariantID)
+
+		// the assignment is replaced by another instance
+		assert.NoError(t, sqlStore.Set(entity.StickyAssignment{FlagID: 100, EntityID: "entity_1", SegmentID: 200, VariantID: 302}))
+		a, _ = s.Get(100, "entity_1")
+		assert.Equal(t, uint(300), a.VariantID)
+		for k, c := range s.cache {
+			c.expiresAt = time.Now().Add(-time.Second)
+			s.cache[k] = c
+		}
+		a, _ = s.Get(100, "entity_1")
+		assert.Equal(t, uint(302), a.VariantID)
+	})
+
+	t.Run("it should not flush the cleared assignments", func(t *testing.T) {
+		assert.NoError(t, s.Set(entity.StickyAssignment{FlagID: 100, EntityID: "entity_3", SegmentID: 200, VariantID: 300}))
+		assert.NoError(t, s.Clear(100, ""))
+		assert.NoError(t, s.flush())
+		a, _ := s.Get(100, "entity_3")
+		assert.Nil(t, a)
+		a, _ = sqlStore.Get(100, "entity_1")
+		assert.Nil(t, a)
+	})
+}
+
+func TestSQLStickyAssignmentStoreUpsert(t *testing.T) {
+	db := entity.NewTestDB()
+	defer db.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+
+	s := &sqlStickyAssignmentStore{}
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			assert.NoError(t, s.Set(entity.StickyAssignment{FlagID: 100, EntityID: "entity_1", SegmentID: 200, VariantID: uint(300 + i)}))
+		}(i)
+	}
+	wg.Wait()
+
+	n := 0
+	db.Model(&entity.StickyAssignment{}).Count(&n)
+	assert.Equal(t, 1, n)
+}
+
+func TestEvalFlagWithStickyAssignments(t *testing.T) {
+	store := NewMemoryStickyAssignmentStore()
+	defer gostub.StubFunc(&GetStickyAssignmentStore, store).Reset()
+	defer gostub.StubFunc(&logEvalResult).Reset()
+
+	f := entity.GenFixtureFlag()
+	f.StickyAssignments = true
+	defer gostub.StubFunc(&GetEvalCache, &EvalCache{
+		mapCache: map[string]*entity.Flag{"100": &f},
+	}).Reset()
+
+	eval := func(entityID string) *models.EvalResult {
+		return evalFlag(models.EvalContext{
+			EntityID:      entityID,
+			EntityContext: map[string]interface{}{"dl_state": "CA"},
+			FlagID:        100,
+		})
+	}
+	setDistributions := func(control uint, treatment uint) {
+		f.Segments[0].Distributions[0].Percent = control
+		f.Segments[0].Distributions[1].Percent = treatment
+		f.PrepareEvaluation()
+	}
+
+	// find an entity assigned to control
+	entityID := ""
+	for i := 0; entityID == ""; i++ {
+		if r := eval(fmt.Sprintf("entity_%d", i)); *r.VariantID == 300 {
+			entityID = fmt.Sprintf("entity_%d", i)
+		}
+	}
+
+	t.Run("keeps the variant when the distributions change", func(t *testing.T) {
+		setDistributions(0, 100)
+		r := eval(entityID)
+		assert.Equal(t, int64(300), *r.VariantID)
+		assert.Equal(t, "control", *r.VariantKey)
+		assert.Contains(t, r.EvalDebugLog.Msg, "sticky assignment of variantID 300")
+
+		f.StickyAssignments = false
+		assert.Equal(t, int64(301), *eval(entityID).VariantID)
+		f.StickyAssignments = true
+	})
+
+	t.Run("reassigns when the segment changes", func(t *testing.T) {
+		store.Set(entity.StickyAssignment{FlagID: 100, EntityID: entityID, SegmentID: 999, VariantID: 300})
+		assert.Equal(t, int64(301), *eval(entityID).VariantID)
+		a, _ := store.Get(100, entityID)
+		assert.Equal(t, uint(200), a.SegmentID)
+		assert.Equal(t, uint(301), a.VariantID)
+	})
+
+	t.Run("clears the assignments", func(t *testing.T) {
+		setDistributions(100, 0)
+		assert.Equal(t, int64(301), *eval(entityID).VariantID)
+
+		res := deleteFlagAssignmentsHandler(flag.DeleteFlagAssignmentsParams{FlagID: 100, EntityID: util.StringPtr(entityID)})
+		assert.IsType(t, &flag.DeleteFlagAssignmentsOK{}, res)
+		assert.Equal(t, int64(300), *eval(entityID).VariantID)
+	})
+
+	t.Run("doesn't store the random entityIDs", func(t *testing.T) {
+		store.Clear(100, "")
+		evalFlag(models.EvalContext{
+			EntityContext: map[string]interface{}{"dl_state": "CA"},
+			FlagID:        100,
+		})
+		assert.Len(t, store.(*memoryStickyAssignmentStore).assignments, 0)
+	})
+}
@@ -0,0 +1,45 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/checkr/flagr/pkg/config"
+	"github.com/checkr/flagr/pkg/useragent"
+	"github.com/checkr/flagr/pkg/util"
+)
+
+// the properties derived from the User-Agent into the entityContext
+const (
+	UABrowserProperty = "$ua.browser"
+	UAVersionProperty = "$ua.version"
+	UAOSProperty      = "$ua.os"
+	UADeviceProperty  = "$ua.device"
+)
+
+// userAgentEnricher derives the browser, OS and device of the entity's User-Agent, which is the
+// UserAgentProperty of the entityContext or the User-Agent header of the request
+type userAgentEnricher struct{}
+
+func (e *userAgentEnricher) Enrich(entityContext map[string]interface{}, r *http.Request) map[string]interface{} {
+	s := util.SafeString(entityContext[config.Config.UserAgentProperty])
+	if s == "" && r != nil {
+		s = r.UserAgent()
+	}
+	if s == "" {
+		return nil
+	}
+
+	ua := useragent.Parse(s)
+	ret := make(map[string]interface{}, 4)
+	for k, v := range map[string]string{
+		UABrowserProperty: ua.Browser,
+		UAVersionProperty: ua.Version,
+		UAOSProperty:      ua.OS,
+		UADeviceProperty:  ua.Device,
+	} {
+		if v != "" {
+			ret[k] = v
+		}
+	}
+	return ret
+}
@@ -0,0 +1,91 @@
+package handler
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/checkr/flagr/pkg/entity"
+	"github.com/checkr/flagr/pkg/util"
+	"github.com/checkr/flagr/swagger_gen/models"
+	"github.com/checkr/flagr/swagger_gen/restapi/operations/evaluation"
+
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testDesktopUA = "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.6099.129 Safari/537.36"
+	testMobileUA  = "Mozilla/5.0 (iPhone; CPU iPhone OS 17_2 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.2 Mobile/15E148 Safari/604.1"
+)
+
+func TestUserAgentEnricher(t *testing.T) {
+	e := &userAgentEnricher{}
+
+	r := httptest.NewRequest("GET", "/api/v1/evaluation/flags/flag_key_100", nil)
+	r.Header.Set("User-Agent", testDesktopUA)
+	assert.Equal(t, map[string]interface{}{
+		"$ua.browser": "Chrome",
+		"$ua.version": "120.0.6099",
+		"$ua.os":      "Windows",
+		"$ua.device":  "desktop",
+	}, e.Enrich(map[string]interface{}{}, r))
+
+	ret := e.Enrich(map[string]interface{}{"user_agent": testMobileUA}, r)
+	assert.Equal(t, "Safari", ret[UABrowserProperty])
+	assert.Equal(t, "mobile", ret[UADeviceProperty])
+
+	assert.Nil(t, e.Enrich(nil, nil))
+}
+
+func TestEnrichEntityContext(t *testing.T) {
+	geo, err := newGeoEnricher("../geoip/testdata/test-city.mmdb")
+	assert.NoError(t, err)
+	defer gostub.StubFunc(&GetEnrichers, []Enricher{geo, &userAgentEnricher{}}).Reset()
+
+	r := httptest.NewRequest("POST", "/api/v1/evaluation", nil)
+	r.RemoteAddr = "81.2.69.160:54321"
+	r.Header.Set("User-Agent", testMobileUA)
+
+	m := map[string]interface{}{"$ua.device": "tablet"}
+	ret := enrichEntityContext(m, r).(map[string]interface{})
+	assert.Equal(t, "GB", ret[GeoCountryProperty])
+	assert.Equal(t, "iOS", ret[UAOSProperty])
+	assert.Equal(t, "tablet", ret[UADeviceProperty])
+	assert.Len(t, m, 1)
+
+	r = httptest.NewRequest("POST", "/api/v1/evaluation", nil)
+	assert.Equal(t, m, enrichEntityContext(m, r))
+}
+
+func TestEvalUserAgentConstraints(t *testing.T) {
+	defer gostub.StubFunc(&GetEnrichers, []Enricher{&userAgentEnricher{}}).Reset()
+	defer gostub.StubFunc(&logEvalResult).Reset()
+
+	f := entity.GenFixtureFlag()
+	f.Segments[0].Constraints = []entity.Constraint{{
+		Property:  UADeviceProperty,
+		Operator:  models.ConstraintOperatorEQ,
+		Value:     "mobile",
+		ValueType: models.ConstraintValueTypeSTRING,
+	}}
+	assert.NoError(t, f.PrepareEvaluation())
+	defer gostub.StubFunc(&GetEvalCache, &EvalCache{
+		mapCache: map[string]*entity.Flag{f.Key: &f},
+	}).Reset()
+
+	eval := func(ua string) *models.EvalResult {
+		r := httptest.NewRequest("GET", "/api/v1/evaluation/flags/"+f.Key, nil)
+		r.Header.Set("User-Agent", ua)
+		resp := NewEval().GetEvaluation(evaluation.GetEvaluationParams{
+			EntityID:    util.StringPtr("entityID1"),
+			FlagKey:     f.Key,
+			HTTPRequest: r,
+		})
+		return resp.(*evaluation.GetEvaluationOK).Payload
+	}
+
+	r := eval(testMobileUA)
+	assert.NotNil(t, r.VariantID)
+	assert.Equal(t, "Safari", r.EvalContext.EntityContext.(map[string]interface{})[UABrowserProperty])
+	assert.Nil(t, eval(testDesktopUA).VariantID)
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/checkr/flagr/pkg/util"
 	"github.com/checkr/flagr/swagger_gen/restapi/operations/distribution"
 	"github.com/checkr/flagr/swagger_gen/restapi/operations/variant"
+	"github.com/jinzhu/gorm"
 )
 
 var validatePutDistributions = func(params distribution.PutDistributionsParams) *Error {
@@ -55,6 +56,12 @@ var validateDeleteVariant = func(params variant.DeleteVariantParams) *Error {
 	}
 	f.Preload(getDB())
 
+	for _, o := range f.Overrides {
+		if o.VariantID == util.SafeUint(params.VariantID) {
+			return NewError(400, "error deleting variant %v. override %v of entityID %s still uses it", params.VariantID, o.ID, o.EntityID)
+		}
+	}
+
 	q := entity.NewDistributionQuerySet(getDB())
 	for _, s := range f.Segments {
 		for _, d := range s.Distributions {
@@ -72,6 +79,48 @@ var validateDeleteVariant = func(params variant.DeleteVariantParams) *Error {
 	return nil
 }
 
+// validateSegmentAudience checks that the audience exists, and locks it until the transaction
+// saving the segment ends, so that the audience can't be deleted in the meantime
+var validateSegmentAudience = func(tx *gorm.DB, audienceID uint) *Error {
+	if audienceID == 0 {
+		return nil
+	}
+	err := entity.NewAudienceQuerySet(entity.ForUpdate(tx)).IDEq(audienceID).One(&entity.Audience{})
+	if gorm.IsRecordNotFoundError(err) {
+		return NewError(400, "error finding audienceID %v", audienceID)
+	}
+	if err != nil {
+		return NewError(500, "error finding audienceID %v. reason %s", audienceID, err)
+	}
+	return nil
+}
+
+var validateConstraintIDList = func(c *entity.Constraint) *Error {
+	key, ok := c.IDListKey()
+	if !ok {
+		return nil
+	}
+	n, err := entity.NewIDListQuerySet(getDB()).KeyEq(key).Count()
+	if err != nil {
+		return NewError(500, "error finding ID list %s. reason %s", key, err)
+	}
+	if n == 0 {
+		return NewError(400, "error finding ID list %s", key)
+	}
+	return nil
+}
+
+var validateSegmentConstraintGroup = func(segmentID uint, g entity.ConstraintGroup) *Error {
+	cs := []entity.Constraint{}
+	if err := entity.NewConstraintQuerySet(getDB()).SegmentIDEq(segmentID).All(&cs); err != nil {
+		return NewError(500, "error finding constraints of segmentID %v. reason %s", segmentID, err)
+	}
+	if err := g.Validate(cs); err != nil {
+		return NewError(400, "error validating constraintGroup. reason %s", err)
+	}
+	return nil
+}
+
 var validatePutVariantForDistributions = func(v *entity.Variant) *Error {
 	q := entity.NewDistributionQuerySet(getDB())
 	if err := q.VariantIDEq(v.ID).GetUpdater().SetVariantKey(v.Key).Update(); err != nil {
@@ -79,3 +128,35 @@ var validatePutVariantForDistributions = func(v *entity.Variant) *Error {
 	}
 	return nil
 }
+
+var validateVariantAttachment = func(flagID uint, a entity.Attachment) *Error {
+	f := &entity.Flag{}
+	err := entity.NewFlagQuerySet(getDB()).IDEq(flagID).One(f)
+	if err == gorm.ErrRecordNotFound {
+		return nil
+	}
+	if err != nil {
+		return NewError(500, "error finding flagID %v. reason %s", flagID, err)
+	}
+	if err := f.AttachmentSchema.ValidateAttachment(a); err != nil {
+		return NewError(400, "%s", err)
+	}
+	return nil
+}
+
+var validatePutFlagAttachmentSchema = func(flagID uint, s entity.AttachmentSchema) *Error {
+	if err := s.Validate(); err != nil {
+		return NewError(400, "%s", err)
+	}
+
+	vs := []entity.Variant{}
+	if err := entity.NewVariantQuerySet(getDB()).FlagIDEq(flagID).OrderAscByID().All(&vs); err != nil {
+		return NewError(500, "error finding variants of flagID %v. reason %s", flagID, err)
+	}
+	for _, v := range vs {
+		if err := s.ValidateAttachment(v.Attachment); err != nil {
+			return NewError(400, "variant '%s' does not match the new attachment schema. %s", v.Key, err)
+		}
+	}
+	return nil
+}
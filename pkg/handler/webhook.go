@@ -0,0 +1,154 @@
+package handler
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/checkr/flagr/pkg/entity"
+	"github.com/checkr/flagr/pkg/mapper/entity_restapi/e2r"
+	"github.com/checkr/flagr/pkg/util"
+	"github.com/checkr/flagr/swagger_gen/restapi/operations/webhook"
+	"github.com/go-openapi/runtime/middleware"
+)
+
+var findWebhooksHandler = func(params webhook.FindWebhooksParams) middleware.Responder {
+	ws := []entity.Webhook{}
+	if err := entity.NewWebhookQuerySet(getDB()).OrderAscByID().All(&ws); err != nil {
+		return webhook.NewFindWebhooksDefault(500).WithPayload(
+			ErrorMessage("cannot query all webhooks. %s", err))
+	}
+	return webhook.NewFindWebhooksOK().WithPayload(e2r.MapWebhooks(ws))
+}
+
+var createWebhookHandler = func(params webhook.CreateWebhookParams) middleware.Responder {
+	if err := validateWebhookFlagKeys(params.Body.FlagKeys); err != nil {
+		return webhook.NewCreateWebhookDefault(400).WithPayload(
+			ErrorMessage("cannot create webhook. %s", err))
+	}
+
+	w := &entity.Webhook{
+		URL:     *params.Body.URL,
+		Secret:  params.Body.Secret,
+		Format:  params.Body.Format,
+		Enabled: params.Body.Enabled,
+	}
+	if w.Format == "" {
+		w.Format = entity.WebhookFormatJSON
+	}
+	w.SetEventList(params.Body.Events)
+	w.SetFlagKeyList(params.Body.FlagKeys)
+	if err := validateWebhook(w); err != nil {
+		return webhook.NewCreateWebhookDefault(400).WithPayload(
+			ErrorMessage("cannot create webhook. %s", err))
+	}
+
+	if err := w.Create(getDB()); err != nil {
+		return webhook.NewCreateWebhookDefault(500).WithPayload(
+			ErrorMessage("cannot create webhook. %s", err))
+	}
+	return webhook.NewCreateWebhookOK().WithPayload(e2r.MapWebhook(w))
+}
+
+var getWebhookHandler = func(params webhook.GetWebhookParams) middleware.Responder {
+	w := &entity.Webhook{}
+	if err := entity.NewWebhookQuerySet(getDB()).IDEq(uint(params.WebhookID)).One(w); err != nil {
+		return webhook.NewGetWebhookDefault(404).WithPayload(
+			ErrorMessage("cannot find webhook %v. %s", params.WebhookID, err))
+	}
+	return webhook.NewGetWebhookOK().WithPayload(e2r.MapWebhook(w))
+}
+
+var putWebhookHandler = func(params webhook.PutWebhookParams) middleware.Responder {
+	w := &entity.Webhook{}
+	if err := entity.NewWebhookQuerySet(getDB()).IDEq(uint(params.WebhookID)).One(w); err != nil {
+		return webhook.NewPutWebhookDefault(404).WithPayload(
+			ErrorMessage("cannot find webhook %v. %s", params.WebhookID, err))
+	}
+
+	if params.Body.URL != nil {
+		w.URL = *params.Body.URL
+	}
+	if params.Body.Secret != nil {
+		w.Secret = *params.Body.Secret
+	}
+	if params.Body.Events != nil {
+		w.SetEventList(params.Body.Events)
+	}
+	if params.Body.FlagKeys != nil {
+		if err := validateWebhookFlagKeys(params.Body.FlagKeys); err != nil {
+			return webhook.NewPutWebhookDefault(400).WithPayload(
+				ErrorMessage("cannot update webhook %v. %s", params.WebhookID, err))
+		}
+		w.SetFlagKeyList(params.Body.FlagKeys)
+	}
+	if params.Body.Format != nil {
+		w.Format = *params.Body.Format
+	}
+	if params.Body.Enabled != nil {
+		w.Enabled = *params.Body.Enabled
+	}
+	if err := validateWebhook(w); err != nil {
+		return webhook.NewPutWebhookDefault(400).WithPayload(
+			ErrorMessage("cannot update webhook %v. %s", params.WebhookID, err))
+	}
+
+	if err := getDB().Save(w).Error; err != nil {
+		return webhook.NewPutWebhookDefault(500).WithPayload(
+			ErrorMessage("cannot update webhook %v. %s", params.WebhookID, err))
+	}
+	return webhook.NewPutWebhookOK().WithPayload(e2r.MapWebhook(w))
+}
+
+var deleteWebhookHandler = func(params webhook.DeleteWebhookParams) middleware.Responder {
+	if err := entity.NewWebhookQuerySet(getDB()).IDEq(uint(params.WebhookID)).Delete(); err != nil {
+		return webhook.NewDeleteWebhookDefault(500).WithPayload(
+			ErrorMessage("cannot delete webhook %v. %s", params.WebhookID, err))
+	}
+	return webhook.NewDeleteWebhookOK()
+}
+
+var findWebhookDeliveriesHandler = func(params webhook.FindWebhookDeliveriesParams) middleware.Responder {
+	q := entity.NewWebhookDeliveryQuerySet(getDB()).WebhookIDEq(uint(params.WebhookID))
+	if params.Status != nil {
+		q = q.StatusEq(*params.Status)
+	}
+	if params.Limit != nil {
+		q = q.Limit(int(*params.Limit))
+	}
+	if params.Offset != nil {
+		q = q.Offset(int(*params.Offset))
+	}
+
+	ds := []entity.WebhookDelivery{}
+	if err := q.OrderDescByID().All(&ds); err != nil {
+		return webhook.NewFindWebhookDeliveriesDefault(500).WithPayload(
+			ErrorMessage("cannot query the deliveries of webhook %v. %s", params.WebhookID, err))
+	}
+	return webhook.NewFindWebhookDeliveriesOK().WithPayload(e2r.MapWebhookDeliveries(ds))
+}
+
+func validateWebhook(w *entity.Webhook) error {
+	u, err := url.Parse(w.URL)
+	if err != nil {
+		return err
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid webhook url %s. it should be an absolute http or https url", w.URL)
+	}
+	for _, e := range w.EventList() {
+		if !entity.IsFlagEvent(e) {
+			return fmt.Errorf("invalid webhook event %s. it should be one of %v", e, entity.FlagEvents)
+		}
+	}
+	return nil
+}
+
+// validateWebhookFlagKeys validates the flag keys before they are joined into the webhook
+func validateWebhookFlagKeys(flagKeys []string) error {
+	for _, k := range flagKeys {
+		if ok, reason := util.IsSafeKey(k); !ok {
+			return fmt.Errorf("invalid webhook flag key %s. reason: %s", k, reason)
+		}
+	}
+	return nil
+}
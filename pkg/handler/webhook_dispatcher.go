@@ -0,0 +1,161 @@
+package handler
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/checkr/flagr/pkg/config"
+	"github.com/checkr/flagr/pkg/entity"
+	"github.com/jinzhu/gorm"
+	"github.com/sirupsen/logrus"
+)
+
+const webhookDispatchBatchSize = 100
+
+// WebhookDispatcher polls the outbox table of the webhook deliveries, sends the pending ones,
+// and reschedules the failed ones with exponential backoff
+type WebhookDispatcher struct {
+	dispatchInterval time.Duration
+}
+
+// NewWebhookDispatcher creates a new WebhookDispatcher
+func NewWebhookDispatcher() *WebhookDispatcher {
+	return &WebhookDispatcher{
+		dispatchInterval: config.Config.WebhookDispatchInterval,
+	}
+}
+
+// Start starts the polling of WebhookDispatcher
+func (wd *WebhookDispatcher) Start() {
+	go func() {
+		for range time.Tick(wd.dispatchInterval) {
+			err := wd.dispatch()
+			if err != nil {
+				logrus.WithField("err", err).Error("dispatch webhook deliveries error")
+			}
+		}
+	}()
+}
+
+func (wd *WebhookDispatcher) dispatch() error {
+	ds := []entity.WebhookDelivery{}
+	err := entity.NewWebhookDeliveryQuerySet(getDB()).
+		StatusEq(entity.WebhookDeliveryStatusPending).
+		NextAttemptAtLte(time.Now()).
+		OrderAscByID().
+		Limit(webhookDispatchBatchSize).
+		All(&ds)
+	if err != nil {
+		return err
+	}
+
+	for i := range ds {
+		if err := wd.deliver(&ds[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (wd *WebhookDispatcher) deliver(d *entity.WebhookDelivery) error {
+	// claim the delivery by bumping the attempts, so that other flagr instances polling
+	// the same table skip it, and lease it until the request times out
+	n, err := entity.NewWebhookDeliveryQuerySet(getDB()).
+		IDEq(d.ID).
+		StatusEq(entity.WebhookDeliveryStatusPending).
+		AttemptsEq(d.Attempts).
+		GetUpdater().
+		SetAttempts(d.Attempts + 1).
+		SetNextAttemptAt(time.Now().Add(2 * config.Config.WebhookTimeout)).
+		UpdateNum()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return nil
+	}
+	d.Attempts++
+
+	u := entity.NewWebhookDeliveryQuerySet(getDB()).IDEq(d.ID).GetUpdater()
+
+	w := &entity.Webhook{}
+	if err := entity.NewWebhookQuerySet(getDB()).IDEq(d.WebhookID).One(w); err != nil {
+		if !gorm.IsRecordNotFoundError(err) {
+			return err
+		}
+		return u.SetStatus(entity.WebhookDeliveryStatusFailed).SetLastError("webhook is deleted").Update()
+	}
+	if !w.Enabled {
+		return u.SetStatus(entity.WebhookDeliveryStatusFailed).SetLastError("webhook is disabled").Update()
+	}
+
+	code, err := sendWebhook(w, d)
+	u = u.SetResponseCode(code)
+	switch {
+	case err == nil:
+		u = u.SetStatus(entity.WebhookDeliveryStatusSucceeded).SetLastError("")
+	case int(d.Attempts) >= config.Config.WebhookMaxAttempts:
+		u = u.SetStatus(entity.WebhookDeliveryStatusFailed).SetLastError(err.Error())
+	default:
+		u = u.SetNextAttemptAt(time.Now().Add(webhookBackoff(d.Attempts))).SetLastError(err.Error())
+	}
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"err":               err,
+			"webhookID":         w.ID,
+			"webhookDeliveryID": d.ID,
+			"attempts":          d.Attempts,
+		}).Warn("failed to send the webhook delivery")
+	}
+	return u.Update()
+}
+
+// webhookBackoff returns the delay before the next attempt, which doubles after every failed attempt
+func webhookBackoff(attempts uint) time.Duration {
+	delay := config.Config.WebhookBackoffBase
+	for i := uint(1); i < attempts && delay < config.Config.WebhookBackoffMax; i++ {
+		delay *= 2
+	}
+	if delay > config.Config.WebhookBackoffMax {
+		delay = config.Config.WebhookBackoffMax
+	}
+	return delay
+}
+
+// signWebhookPayload returns the hex encoded HMAC-SHA256 of the payload
+func signWebhookPayload(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+var sendWebhook = func(w *entity.Webhook, d *entity.WebhookDelivery) (int, error) {
+	req, err := http.NewRequest("POST", w.URL, bytes.NewReader(d.Payload))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Flagr-Event", d.Event)
+	req.Header.Set("X-Flagr-Delivery", strconv.FormatUint(uint64(d.ID), 10))
+	if w.Secret != "" {
+		req.Header.Set("X-Flagr-Signature", "sha256="+signWebhookPayload(w.Secret, d.Payload))
+	}
+
+	client := &http.Client{Timeout: config.Config.WebhookTimeout}
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("unexpected status code %d from the webhook", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,315 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/checkr/flagr/pkg/config"
+	"github.com/checkr/flagr/pkg/entity"
+	"github.com/checkr/flagr/pkg/util"
+	"github.com/checkr/flagr/swagger_gen/models"
+	"github.com/checkr/flagr/swagger_gen/restapi/operations/flag"
+	"github.com/checkr/flagr/swagger_gen/restapi/operations/segment"
+	"github.com/checkr/flagr/swagger_gen/restapi/operations/webhook"
+
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCrudWebhooks(t *testing.T) {
+	db := entity.NewTestDB()
+	defer db.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+
+	t.Run("create webhook", func(t *testing.T) {
+		res := createWebhookHandler(webhook.CreateWebhookParams{
+			Body: &models.CreateWebhookRequest{
+				URL:     util.StringPtr("https://example.com/flagr"),
+				Secret:  "s3cret",
+				Events:  []string{entity.FlagEventEnabled, entity.FlagEventDisabled},
+				Enabled: true,
+			},
+		})
+		w := res.(*webhook.CreateWebhookOK).Payload
+		assert.Equal(t, int64(1), *w.ID)
+		assert.Equal(t, []string{"flag.enabled", "flag.disabled"}, w.Events)
+		assert.Equal(t, []string{}, w.FlagKeys)
+		assert.Equal(t, entity.WebhookFormatJSON, w.Format)
+		assert.True(t, w.SecretConfigured)
+	})
+
+	t.Run("create webhook with invalid url or event", func(t *testing.T) {
+		res := createWebhookHandler(webhook.CreateWebhookParams{
+			Body: &models.CreateWebhookRequest{URL: util.StringPtr("example.com/flagr")},
+		})
+		assert.IsType(t, &webhook.CreateWebhookDefault{}, res)
+
+		res = createWebhookHandler(webhook.CreateWebhookParams{
+			Body: &models.CreateWebhookRequest{
+				URL:    util.StringPtr("https://example.com/flagr"),
+				Events: []string{"flag.unknown"},
+			},
+		})
+		assert.IsType(t, &webhook.CreateWebhookDefault{}, res)
+
+		res = createWebhookHandler(webhook.CreateWebhookParams{
+			Body: &models.CreateWebhookRequest{
+				URL:      util.StringPtr("https://example.com/flagr"),
+				FlagKeys: []string{"a,b"},
+			},
+		})
+		assert.IsType(t, &webhook.CreateWebhookDefault{}, res)
+	})
+
+	t.Run("find and get webhooks", func(t *testing.T) {
+		res := findWebhooksHandler(webhook.FindWebhooksParams{})
+		assert.Len(t, res.(*webhook.FindWebhooksOK).Payload, 1)
+
+		res = getWebhookHandler(webhook.GetWebhookParams{WebhookID: 1})
+		assert.Equal(t, "https://example.com/flagr", *res.(*webhook.GetWebhookOK).Payload.URL)
+
+		res = getWebhookHandler(webhook.GetWebhookParams{WebhookID: 999})
+		assert.IsType(t, &webhook.GetWebhookDefault{}, res)
+	})
+
+	t.Run("put webhook", func(t *testing.T) {
+		res := putWebhookHandler(webhook.PutWebhookParams{
+			WebhookID: 1,
+			Body: &models.PutWebhookRequest{
+				Secret:   util.StringPtr(""),
+				Events:   []string{},
+				FlagKeys: []string{"flag_key_100"},
+				Format:   util.StringPtr(entity.WebhookFormatSlack),
+				Enabled:  util.BoolPtr(false),
+			},
+		})
+		w := res.(*webhook.PutWebhookOK).Payload
+		assert.Equal(t, "https://example.com/flagr", *w.URL)
+		assert.Equal(t, []string{}, w.Events)
+		assert.Equal(t, []string{"flag_key_100"}, w.FlagKeys)
+		assert.Equal(t, entity.WebhookFormatSlack, w.Format)
+		assert.False(t, *w.Enabled)
+		assert.False(t, w.SecretConfigured)
+
+		res = putWebhookHandler(webhook.PutWebhookParams{
+			WebhookID: 1,
+			Body:      &models.PutWebhookRequest{URL: util.StringPtr("ftp://example.com")},
+		})
+		assert.IsType(t, &webhook.PutWebhookDefault{}, res)
+
+		res = putWebhookHandler(webhook.PutWebhookParams{WebhookID: 999, Body: &models.PutWebhookRequest{}})
+		assert.IsType(t, &webhook.PutWebhookDefault{}, res)
+	})
+
+	t.Run("find webhook deliveries", func(t *testing.T) {
+		db.Create(&entity.WebhookDelivery{WebhookID: 1, Event: entity.FlagEventCreated, Status: entity.WebhookDeliveryStatusSucceeded})
+		db.Create(&entity.WebhookDelivery{WebhookID: 1, Event: entity.FlagEventUpdated, Status: entity.WebhookDeliveryStatusPending})
+
+		res := findWebhookDeliveriesHandler(webhook.FindWebhookDeliveriesParams{WebhookID: 1})
+		ds := res.(*webhook.FindWebhookDeliveriesOK).Payload
+		assert.Len(t, ds, 2)
+		assert.Equal(t, entity.FlagEventUpdated, *ds[0].Event)
+
+		res = findWebhookDeliveriesHandler(webhook.FindWebhookDeliveriesParams{
+			WebhookID: 1,
+			Status:    util.StringPtr(entity.WebhookDeliveryStatusSucceeded),
+			Limit:     util.Int64Ptr(10),
+			Offset:    util.Int64Ptr(0),
+		})
+		assert.Len(t, res.(*webhook.FindWebhookDeliveriesOK).Payload, 1)
+	})
+
+	t.Run("delete webhook", func(t *testing.T) {
+		res := deleteWebhookHandler(webhook.DeleteWebhookParams{WebhookID: 1})
+		assert.IsType(t, &webhook.DeleteWebhookOK{}, res)
+
+		res = findWebhooksHandler(webhook.FindWebhooksParams{})
+		assert.Len(t, res.(*webhook.FindWebhooksOK).Payload, 0)
+	})
+
+	t.Run("db generic error", func(t *testing.T) {
+		db.Error = fmt.Errorf("db generic error")
+		defer func() { db.Error = nil }()
+
+		assert.IsType(t, &webhook.FindWebhooksDefault{}, findWebhooksHandler(webhook.FindWebhooksParams{}))
+		assert.IsType(t, &webhook.DeleteWebhookDefault{}, deleteWebhookHandler(webhook.DeleteWebhookParams{WebhookID: 1}))
+		assert.IsType(t, &webhook.FindWebhookDeliveriesDefault{}, findWebhookDeliveriesHandler(
+			webhook.FindWebhookDeliveriesParams{WebhookID: 1}))
+	})
+}
+
+func TestWebhookDispatcher(t *testing.T) {
+	db := entity.NewTestDB()
+	defer db.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+	defer gostub.Stub(&config.Config.WebhookEnabled, true).Reset()
+
+	statusCode := http.StatusOK
+	requests := []*http.Request{}
+	bodies := []entity.FlagEvent{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		e := entity.FlagEvent{}
+		json.NewDecoder(r.Body).Decode(&e)
+		requests = append(requests, r)
+		bodies = append(bodies, e)
+		w.WriteHeader(statusCode)
+	}))
+	defer server.Close()
+
+	db.Create(&entity.Webhook{URL: server.URL, Secret: "s3cret", Enabled: true})
+
+	c := &crud{}
+	c.CreateFlag(flag.CreateFlagParams{Body: &models.CreateFlagRequest{Description: util.StringPtr("funny flag")}})
+	wd := NewWebhookDispatcher()
+
+	t.Run("sends the signed payload", func(t *testing.T) {
+		assert.NoError(t, wd.dispatch())
+		assert.Len(t, requests, 1)
+		assert.Equal(t, entity.FlagEventCreated, requests[0].Header.Get("X-Flagr-Event"))
+		assert.Equal(t, entity.FlagEventCreated, bodies[0].Event)
+		assert.Equal(t, uint(1), bodies[0].FlagID)
+
+		d := entity.WebhookDelivery{}
+		entity.NewWebhookDeliveryQuerySet(db).IDEq(1).One(&d)
+		assert.Equal(t, entity.WebhookDeliveryStatusSucceeded, d.Status)
+		assert.Equal(t, uint(1), d.Attempts)
+		assert.Equal(t, http.StatusOK, d.ResponseCode)
+		assert.Equal(t, "sha256="+signWebhookPayload("s3cret", d.Payload), requests[0].Header.Get("X-Flagr-Signature"))
+
+		assert.NoError(t, wd.dispatch())
+		assert.Len(t, requests, 1)
+	})
+
+	t.Run("retries with backoff and fails after max attempts", func(t *testing.T) {
+		defer gostub.Stub(&config.Config.WebhookMaxAttempts, 2).Reset()
+		statusCode = http.StatusInternalServerError
+		defer func() { statusCode = http.StatusOK }()
+
+		c.SetFlagEnabledState(flag.SetFlagEnabledParams{FlagID: 1, Body: &models.SetFlagEnabledRequest{Enabled: util.BoolPtr(true)}})
+		assert.NoError(t, wd.dispatch())
+		assert.Len(t, requests, 2)
+		assert.Equal(t, entity.FlagEventEnabled, bodies[1].Event)
+
+		d := entity.WebhookDelivery{}
+		entity.NewWebhookDeliveryQuerySet(db).IDEq(2).One(&d)
+		assert.Equal(t, entity.WebhookDeliveryStatusPending, d.Status)
+		assert.Equal(t, http.StatusInternalServerError, d.ResponseCode)
+		assert.NotEmpty(t, d.LastError)
+		assert.True(t, d.NextAttemptAt.After(time.Now()))
+
+		// not due yet
+		assert.NoError(t, wd.dispatch())
+		assert.Len(t, requests, 2)
+
+		entity.NewWebhookDeliveryQuerySet(db).IDEq(2).GetUpdater().SetNextAttemptAt(time.Now()).Update()
+		assert.NoError(t, wd.dispatch())
+		assert.Len(t, requests, 3)
+		entity.NewWebhookDeliveryQuerySet(db).IDEq(2).One(&d)
+		assert.Equal(t, entity.WebhookDeliveryStatusFailed, d.Status)
+		assert.Equal(t, uint(2), d.Attempts)
+	})
+
+	t.Run("keeps the flag if the deleted event cannot be enqueued", func(t *testing.T) {
+		db.DropTable(&entity.WebhookDelivery{})
+		defer db.AutoMigrate(&entity.WebhookDelivery{})
+
+		res := c.DeleteFlag(flag.DeleteFlagParams{FlagID: 1})
+		assert.IsType(t, &flag.DeleteFlagDefault{}, res)
+		n, _ := entity.NewFlagQuerySet(db).IDEq(1).Count()
+		assert.Equal(t, 1, n)
+	})
+
+	t.Run("deleted flag", func(t *testing.T) {
+		c.DeleteFlag(flag.DeleteFlagParams{FlagID: 1})
+		assert.NoError(t, wd.dispatch())
+		assert.Len(t, requests, 4)
+		assert.Equal(t, entity.FlagEventDeleted, bodies[3].Event)
+		assert.Equal(t, "flag.deleted", requests[3].Header.Get("X-Flagr-Event"))
+	})
+
+	t.Run("disabled webhook", func(t *testing.T) {
+		entity.NewWebhookQuerySet(db).IDEq(1).GetUpdater().SetEnabled(false).Update()
+		db.Create(&entity.WebhookDelivery{
+			WebhookID:     1,
+			Event:         entity.FlagEventUpdated,
+			Status:        entity.WebhookDeliveryStatusPending,
+			NextAttemptAt: time.Now(),
+		})
+		assert.NoError(t, wd.dispatch())
+		assert.Len(t, requests, 4)
+
+		d := entity.WebhookDelivery{}
+		entity.NewWebhookDeliveryQuerySet(db).OrderDescByID().One(&d)
+		assert.Equal(t, entity.WebhookDeliveryStatusFailed, d.Status)
+		assert.Equal(t, "webhook is disabled", d.LastError)
+	})
+}
+
+func TestSlackWebhook(t *testing.T) {
+	db := entity.NewTestDB()
+	defer db.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+	defer gostub.Stub(&config.Config.WebhookEnabled, true).Reset()
+
+	messages := []map[string]string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		m := map[string]string{}
+		json.NewDecoder(r.Body).Decode(&m)
+		messages = append(messages, m)
+	}))
+	defer server.Close()
+
+	c := &crud{}
+	c.CreateFlag(flag.CreateFlagParams{Body: &models.CreateFlagRequest{
+		Description: util.StringPtr("funny flag"),
+		Key:         "new_checkout",
+	}})
+	c.CreateFlag(flag.CreateFlagParams{Body: &models.CreateFlagRequest{
+		Description: util.StringPtr("other flag"),
+		Key:         "other_flag",
+	}})
+	c.CreateSegment(segment.CreateSegmentParams{
+		FlagID: 1,
+		Body: &models.CreateSegmentRequest{
+			Description:    util.StringPtr("US users"),
+			RolloutPercent: util.Int64Ptr(10),
+		},
+	})
+
+	db.Create(&entity.Webhook{
+		URL:      server.URL,
+		FlagKeys: "new_checkout",
+		Format:   entity.WebhookFormatSlack,
+		Enabled:  true,
+	})
+
+	c.PutSegment(segment.PutSegmentParams{
+		FlagID:    1,
+		SegmentID: 1,
+		Body: &models.PutSegmentRequest{
+			Description:    util.StringPtr("US users"),
+			RolloutPercent: util.Int64Ptr(25),
+		},
+	})
+	c.SetFlagEnabledState(flag.SetFlagEnabledParams{FlagID: 2, Body: &models.SetFlagEnabledRequest{Enabled: util.BoolPtr(true)}})
+
+	assert.NoError(t, NewWebhookDispatcher().dispatch())
+	assert.Equal(t, []map[string]string{
+		{"text": "anonymous updated `new_checkout` (rollout 10%→25% in segment 'US users')"},
+	}, messages)
+}
+
+func TestWebhookBackoff(t *testing.T) {
+	defer gostub.Stub(&config.Config.WebhookBackoffBase, 10*time.Second).Reset()
+	defer gostub.Stub(&config.Config.WebhookBackoffMax, time.Minute).Reset()
+
+	assert.Equal(t, 10*time.Second, webhookBackoff(1))
+	assert.Equal(t, 20*time.Second, webhookBackoff(2))
+	assert.Equal(t, 40*time.Second, webhookBackoff(3))
+	assert.Equal(t, time.Minute, webhookBackoff(4))
+	assert.Equal(t, time.Minute, webhookBackoff(100))
+}
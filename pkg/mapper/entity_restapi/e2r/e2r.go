@@ -24,6 +24,16 @@ func MapFlag(e *entity.Flag, preload bool) (*models.Flag, error) {
 	r.UpdatedAt = strfmt.DateTime(e.UpdatedAt)
 	r.CreatedBy = e.CreatedBy
 	r.UpdatedBy = e.UpdatedBy
+	if len(e.AttachmentSchema) != 0 {
+		r.AttachmentSchema = e.AttachmentSchema
+	}
+	r.Salt = e.Salt
+	r.Hash = e.Hash
+	r.BucketBy = e.BucketBy
+	r.StickyAssignments = e.StickyAssignments
+	r.LayerID = int64(e.LayerID)
+	r.LayerBucketStart = int64(e.LayerBucketStart)
+	r.LayerBucketEnd = int64(e.LayerBucketEnd)
 
 	if preload {
 		if err := e.Preload(getDB()); err != nil {
@@ -91,7 +101,10 @@ func MapSegment(e *entity.Segment, preload bool) *models.Segment {
 	r.Description = util.StringPtr(e.Description)
 	r.Rank = util.Int64Ptr(int64(e.Rank))
 	r.RolloutPercent = util.Int64Ptr(int64(e.RolloutPercent))
+	r.RolloutBasisPoints = int64(e.RolloutBasisPoints)
+	r.AudienceID = int64(e.AudienceID)
 	r.Constraints = MapConstraints(e.Constraints)
+	r.ConstraintGroup = MapConstraintGroup(e.ConstraintGroup)
 	r.Distributions = MapDistributions(e.Distributions)
 	return r
 }
@@ -105,6 +118,21 @@ func MapSegments(e []entity.Segment, preload bool) []*models.Segment {
 	return ret
 }
 
+// MapConstraintGroup maps constraint group, an empty group is mapped to nil
+func MapConstraintGroup(e entity.ConstraintGroup) *models.ConstraintGroup {
+	if e.IsEmpty() {
+		return nil
+	}
+	r := &models.ConstraintGroup{
+		Operator:     e.Operator,
+		ConstraintID: int64(e.ConstraintID),
+	}
+	for _, child := range e.Children {
+		r.Children = append(r.Children, MapConstraintGroup(child))
+	}
+	return r
+}
+
 // MapConstraint maps constraint
 func MapConstraint(e *entity.Constraint) *models.Constraint {
 	r := &models.Constraint{}
@@ -112,6 +140,7 @@ func MapConstraint(e *entity.Constraint) *models.Constraint {
 	r.Property = util.StringPtr(e.Property)
 	r.Operator = util.StringPtr(e.Operator)
 	r.Value = util.StringPtr(e.Value)
+	r.ValueType = e.ValueType
 	return r
 }
 
@@ -163,3 +192,186 @@ func MapVariants(e []entity.Variant) []*models.Variant {
 	}
 	return ret
 }
+
+// MapOverride maps override, the variantKey is looked up in the variants of the flag
+func MapOverride(e *entity.Override, variants []entity.Variant) *models.Override {
+	r := &models.Override{
+		ID:         int64(e.ID),
+		EntityType: util.StringPtr(e.EntityType),
+		EntityID:   util.StringPtr(e.EntityID),
+		VariantID:  util.Int64Ptr(int64(e.VariantID)),
+		VariantKey: util.StringPtr(""),
+	}
+	for _, v := range variants {
+		if v.ID == e.VariantID {
+			r.VariantKey = util.StringPtr(v.Key)
+		}
+	}
+	if e.ExpiresAt != nil {
+		r.ExpiresAt = strfmt.DateTime(*e.ExpiresAt)
+	}
+	return r
+}
+
+// MapOverrides maps overrides
+func MapOverrides(e []entity.Override, variants []entity.Variant) []*models.Override {
+	ret := make([]*models.Override, len(e), len(e))
+	for i, o := range e {
+		ret[i] = MapOverride(&o, variants)
+	}
+	return ret
+}
+
+// MapEvaluationConfigFlag maps the flag to its evaluation config, the flag is
+// expected to have its segments, variants and overrides loaded
+func MapEvaluationConfigFlag(e *entity.Flag) *models.EvaluationConfigFlag {
+	b := e.Bucketing()
+	r := &models.EvaluationConfigFlag{
+		ID:                util.Int64Ptr(int64(e.ID)),
+		Key:               util.StringPtr(e.Key),
+		Enabled:           util.BoolPtr(e.Enabled),
+		SnapshotID:        int64(e.SnapshotID),
+		Salt:              util.StringPtr(b.Salt),
+		Hash:              entity.HashCRC32,
+		BucketNum:         int64(b.BucketNum()),
+		BucketBy:          e.BucketBy,
+		StickyAssignments: e.StickyAssignments,
+		Segments:          make([]*models.EvaluationConfigSegment, len(e.Segments), len(e.Segments)),
+		Variants:          MapVariants(e.Variants),
+		Overrides:         MapOverrides(e.Overrides, e.Variants),
+	}
+	if b.Hash != "" {
+		r.Hash = b.Hash
+	}
+	if e.LayerID != 0 {
+		r.LayerID = int64(e.LayerID)
+		r.LayerSalt = entity.LayerSalt(e.LayerID)
+		r.LayerBucketStart = int64(e.LayerBucketStart)
+		r.LayerBucketEnd = int64(e.LayerBucketEnd)
+	}
+	for i, s := range e.Segments {
+		r.Segments[i] = MapEvaluationConfigSegment(&s)
+	}
+	return r
+}
+
+// MapEvaluationConfigSegment maps the segment to its evaluation config
+func MapEvaluationConfigSegment(e *entity.Segment) *models.EvaluationConfigSegment {
+	cs, _ := e.EvaluationConstraints() // the flags in the EvalCache are prepared for evaluation
+	r := &models.EvaluationConfigSegment{
+		ID:                 util.Int64Ptr(int64(e.ID)),
+		RolloutPercent:     util.Int64Ptr(int64(e.RolloutPercent)),
+		RolloutBasisPoints: int64(e.RolloutInBasisPoints()),
+		AudienceID:         int64(e.AudienceID),
+		Constraints:        MapConstraints(cs),
+		ConstraintGroup:    MapConstraintGroup(e.ConstraintGroup),
+		Distributions:      make([]*models.EvaluationConfigDistribution, len(e.Distributions), len(e.Distributions)),
+	}
+	for i, d := range e.Distributions {
+		r.Distributions[i] = &models.EvaluationConfigDistribution{
+			VariantID: util.Int64Ptr(int64(d.VariantID)),
+			Percent:   util.Int64Ptr(int64(d.Percent)),
+		}
+	}
+	return r
+}
+
+// MapLayer maps layer with the flags in it
+func MapLayer(e *entity.Layer, flags []entity.Flag) *models.Layer {
+	r := &models.Layer{
+		ID:          util.Int64Ptr(int64(e.ID)),
+		Key:         util.StringPtr(e.Key),
+		Description: e.Description,
+		Flags:       make([]*models.LayerFlag, len(flags), len(flags)),
+	}
+	for i, f := range flags {
+		r.Flags[i] = &models.LayerFlag{
+			FlagID:      util.Int64Ptr(int64(f.ID)),
+			FlagKey:     f.Key,
+			BucketStart: util.Int64Ptr(int64(f.LayerBucketStart)),
+			BucketEnd:   util.Int64Ptr(int64(f.LayerBucketEnd)),
+		}
+	}
+	return r
+}
+
+// MapAudience maps audience with the segments using it, flagKeys maps the flagIDs
+// of the segments to their keys
+func MapAudience(e *entity.Audience, segments []entity.Segment, flagKeys map[uint]string) *models.Audience {
+	r := &models.Audience{
+		ID:          util.Int64Ptr(int64(e.ID)),
+		Key:         util.StringPtr(e.Key),
+		Description: e.Description,
+		Constraints: MapConstraints(e.Constraints),
+		Segments:    make([]*models.AudienceSegment, len(segments), len(segments)),
+	}
+	for i, s := range segments {
+		r.Segments[i] = &models.AudienceSegment{
+			FlagID:      util.Int64Ptr(int64(s.FlagID)),
+			FlagKey:     flagKeys[s.FlagID],
+			SegmentID:   util.Int64Ptr(int64(s.ID)),
+			Description: s.Description,
+		}
+	}
+	return r
+}
+
+// MapWebhook maps webhook, the secret is never exposed
+func MapWebhook(e *entity.Webhook) *models.Webhook {
+	r := &models.Webhook{
+		ID:               util.Int64Ptr(int64(e.ID)),
+		URL:              util.StringPtr(e.URL),
+		Events:           e.EventList(),
+		FlagKeys:         e.FlagKeyList(),
+		Format:           e.Format,
+		Enabled:          util.BoolPtr(e.Enabled),
+		SecretConfigured: e.Secret != "",
+	}
+	return r
+}
+
+// MapWebhooks maps webhooks
+func MapWebhooks(e []entity.Webhook) []*models.Webhook {
+	ret := make([]*models.Webhook, len(e), len(e))
+	for i, w := range e {
+		ret[i] = MapWebhook(&w)
+	}
+	return ret
+}
+
+// MapWebhookDelivery maps webhook delivery
+func MapWebhookDelivery(e *entity.WebhookDelivery) *models.WebhookDelivery {
+	r := &models.WebhookDelivery{
+		ID:            util.Int64Ptr(int64(e.ID)),
+		WebhookID:     util.Int64Ptr(int64(e.WebhookID)),
+		Event:         util.StringPtr(e.Event),
+		Payload:       string(e.Payload),
+		Status:        util.StringPtr(e.Status),
+		Attempts:      util.Int64Ptr(int64(e.Attempts)),
+		ResponseCode:  int64(e.ResponseCode),
+		LastError:     e.LastError,
+		NextAttemptAt: strfmt.DateTime(e.NextAttemptAt),
+		CreatedAt:     strfmt.DateTime(e.CreatedAt),
+	}
+	return r
+}
+
+// MapWebhookDeliveries maps webhook deliveries
+func MapWebhookDeliveries(e []entity.WebhookDelivery) []*models.WebhookDelivery {
+	ret := make([]*models.WebhookDelivery, len(e), len(e))
+	for i, d := range e {
+		ret[i] = MapWebhookDelivery(&d)
+	}
+	return ret
+}
+
+// MapIDList maps ID list without its IDs
+func MapIDList(e *entity.IDList) *models.IDList {
+	return &models.IDList{
+		ID:          util.Int64Ptr(int64(e.ID)),
+		Key:         util.StringPtr(e.Key),
+		Description: e.Description,
+		Size:        util.Int64Ptr(int64(e.Size)),
+		UpdatedAt:   strfmt.DateTime(e.UpdatedAt),
+	}
+}
@@ -30,21 +30,47 @@ func MapDistribution(r *models.Distribution, segmentID uint) entity.Distribution
 	return e
 }
 
-// MapAttachment maps attachment
+// MapConstraintGroup maps constraint group, nil is mapped to an empty group
+func MapConstraintGroup(r *models.ConstraintGroup) entity.ConstraintGroup {
+	e := entity.ConstraintGroup{}
+	if r == nil {
+		return e
+	}
+	e.Operator = r.Operator
+	e.ConstraintID = uint(r.ConstraintID)
+	for _, child := range r.Children {
+		e.Children = append(e.Children, MapConstraintGroup(child))
+	}
+	return e
+}
+
+// MapAttachment maps attachment, which can be any JSON object
 func MapAttachment(a interface{}) (entity.Attachment, error) {
 	e := entity.Attachment{}
 
 	if a != nil {
 		m, ok := a.(map[string]interface{})
 		if !ok {
-			return e, fmt.Errorf("all key/value pairs should be string/string. invalid attachment format %s", spew.Sdump(a))
+			return e, fmt.Errorf("attachment should be a JSON object. invalid attachment format %s", spew.Sdump(a))
+		}
+		for k, v := range m {
+			e[k] = v
+		}
+	}
+	return e, nil
+}
+
+// MapAttachmentSchema maps attachment schema
+func MapAttachmentSchema(a interface{}) (entity.AttachmentSchema, error) {
+	e := entity.AttachmentSchema{}
+
+	if a != nil {
+		m, ok := a.(map[string]interface{})
+		if !ok {
+			return e, fmt.Errorf("attachment schema should be a JSON object. invalid attachment schema format %s", spew.Sdump(a))
 		}
 		for k, v := range m {
-			s, ok := v.(string)
-			if !ok {
-				return e, fmt.Errorf("all key/value pairs should be string/string. invalid attachment format %s", spew.Sdump(a))
-			}
-			e[k] = s
+			e[k] = v
 		}
 	}
 	return e, nil
@@ -0,0 +1,123 @@
+// Package useragent parses the User-Agent headers of the common browsers, operating systems
+// and bots into coarse fields for targeting
+package useragent
+
+import (
+	"regexp"
+	"strings"
+)
+
+// the device types
+const (
+	DeviceDesktop = "desktop"
+	DeviceMobile  = "mobile"
+	DeviceTablet  = "tablet"
+	DeviceBot     = "bot"
+)
+
+// UserAgent is the parsed User-Agent. The fields that can't be recognized are empty
+type UserAgent struct {
+	// Browser is the name of the browser, e.g. Chrome, or of the bot, e.g. Googlebot
+	Browser string
+	// Version is the version of the browser, with at most 3 version numbers so that it's a
+	// semantic version, e.g. 120.0.6099
+	Version string
+	// OS is the name of the operating system, e.g. Windows, macOS, iOS, Android, Linux
+	OS string
+	// Device is the type of the device, which is desktop, mobile, tablet or bot
+	Device string
+}
+
+// browser is recognized by the first product token in the User-Agent matching its pattern
+type browser struct {
+	name    string
+	pattern *regexp.Regexp
+}
+
+// browsers are in the order of precedence, since most User-Agents mimic the others, e.g. Edge
+// has the tokens of Chrome and Safari as well
+var browsers = []browser{
+	{"Edge", regexp.MustCompile(`\b(?:Edg|Edge|EdgA|EdgiOS)/([\d.]+)`)},
+	{"Opera", regexp.MustCompile(`\b(?:OPR|Opera)/([\d.]+)`)},
+	{"Samsung Internet", regexp.MustCompile(`\bSamsungBrowser/([\d.]+)`)},
+	{"Firefox", regexp.MustCompile(`\b(?:Firefox|FxiOS)/([\d.]+)`)},
+	{"Chrome", regexp.MustCompile(`\b(?:Chrome|CriOS)/([\d.]+)`)},
+	{"Safari", regexp.MustCompile(`\bVersion/([\d.]+).*\bSafari/`)},
+	{"Internet Explorer", regexp.MustCompile(`\b(?:MSIE |Trident/.*\brv:)([\d.]+)`)},
+}
+
+var botPattern = regexp.MustCompile(`(?i)([\w-]*(?:bot|crawler|spider|slurp))\b|\b(curl|wget|python-requests|Go-http-client)\b`)
+
+// operatingSystem is recognized by the first of the oses whose token is in the User-Agent
+type operatingSystem struct {
+	name   string
+	tokens []string
+}
+
+var oses = []operatingSystem{
+	{"iOS", []string{"iPhone", "iPad", "iPod"}},
+	{"Android", []string{"Android"}},
+	{"Windows", []string{"Windows"}},
+	{"Chrome OS", []string{"CrOS"}},
+	{"macOS", []string{"Macintosh", "Mac OS X"}},
+	{"Linux", []string{"Linux"}},
+}
+
+// Parse parses the User-Agent
+func Parse(s string) UserAgent {
+	ua := UserAgent{}
+	if strings.TrimSpace(s) == "" {
+		return ua
+	}
+
+	for _, o := range oses {
+		if containsAny(s, o.tokens...) {
+			ua.OS = o.name
+			break
+		}
+	}
+
+	if m := botPattern.FindStringSubmatch(s); m != nil {
+		ua.Browser = m[1] + m[2]
+		ua.Device = DeviceBot
+		return ua
+	}
+
+	for _, b := range browsers {
+		if m := b.pattern.FindStringSubmatch(s); m != nil {
+			ua.Browser = b.name
+			ua.Version = semanticVersion(m[1])
+			break
+		}
+	}
+
+	switch {
+	case containsAny(s, "iPad", "Tablet") || (ua.OS == "Android" && !strings.Contains(s, "Mobile")):
+		ua.Device = DeviceTablet
+	case containsAny(s, "Mobile", "iPhone", "iPod", "Android"):
+		ua.Device = DeviceMobile
+	default:
+		ua.Device = DeviceDesktop
+	}
+	return ua
+}
+
+func containsAny(s string, tokens ...string) bool {
+	for _, t := range tokens {
+		if strings.Contains(s, t) {
+			return true
+		}
+	}
+	return false
+}
+
+// semanticVersion keeps the first 3 version numbers of the version, e.g. 120.0.6099.129 is
+// 120.0.6099
+func semanticVersion(v string) string {
+	v = strings.Trim(v, ".")
+	parts := strings.Split(v, ".")
+	if len(parts) > 3 {
+		parts = parts[:3]
+	}
+	return strings.Join(parts, ".")
+}
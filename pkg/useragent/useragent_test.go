@@ -0,0 +1,65 @@
+package useragent
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	for _, tc := range []struct {
+		ua       string
+		expected UserAgent
+	}{
+		{
+			"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.6099.129 Safari/537.36",
+			UserAgent{Browser: "Chrome", Version: "120.0.6099", OS: "Windows", Device: DeviceDesktop},
+		},
+		{
+			"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36 Edg/120.0.2210.91",
+			UserAgent{Browser: "Edge", Version: "120.0.2210", OS: "Windows", Device: DeviceDesktop},
+		},
+		{
+			"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.2 Safari/605.1.15",
+			UserAgent{Browser: "Safari", Version: "17.2", OS: "macOS", Device: DeviceDesktop},
+		},
+		{
+			"Mozilla/5.0 (X11; Ubuntu; Linux x86_64; rv:121.0) Gecko/20100101 Firefox/121.0",
+			UserAgent{Browser: "Firefox", Version: "121.0", OS: "Linux", Device: DeviceDesktop},
+		},
+		{
+			"Mozilla/5.0 (iPhone; CPU iPhone OS 17_2 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) CriOS/120.0.6099.119 Mobile/15E148 Safari/604.1",
+			UserAgent{Browser: "Chrome", Version: "120.0.6099", OS: "iOS", Device: DeviceMobile},
+		},
+		{
+			"Mozilla/5.0 (iPad; CPU OS 17_2 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.2 Mobile/15E148 Safari/604.1",
+			UserAgent{Browser: "Safari", Version: "17.2", OS: "iOS", Device: DeviceTablet},
+		},
+		{
+			"Mozilla/5.0 (Linux; Android 14; SM-S918B) AppleWebKit/537.36 (KHTML, like Gecko) SamsungBrowser/23.0 Chrome/115.0.0.0 Mobile Safari/537.36",
+			UserAgent{Browser: "Samsung Internet", Version: "23.0", OS: "Android", Device: DeviceMobile},
+		},
+		{
+			"Mozilla/5.0 (Linux; Android 13; SM-X700) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36",
+			UserAgent{Browser: "Chrome", Version: "120.0.0", OS: "Android", Device: DeviceTablet},
+		},
+		{
+			"Mozilla/5.0 (Windows NT 6.1; WOW64; Trident/7.0; rv:11.0) like Gecko",
+			UserAgent{Browser: "Internet Explorer", Version: "11.0", OS: "Windows", Device: DeviceDesktop},
+		},
+		{
+			"Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)",
+			UserAgent{Browser: "Googlebot", Device: DeviceBot},
+		},
+		{
+			"curl/8.4.0",
+			UserAgent{Browser: "curl", Device: DeviceBot},
+		},
+		{
+			"",
+			UserAgent{},
+		},
+	} {
+		assert.Equal(t, tc.expected, Parse(tc.ua), tc.ua)
+	}
+}
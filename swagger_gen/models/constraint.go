@@ -24,10 +24,10 @@ type Constraint struct {
 	// Minimum: 1
 	ID int64 `json:"id,omitempty"`
 
-	// operator
+	// SEMVER_* compare semantic versions, in which prereleases precede their releases. BEFORE, AFTER and BETWEEN compare times, the values are RFC3339 times, dates, unix timestamps in seconds, times of day like "09:00" or now, and BETWEEN takes an array of the start and the excluded end. The property now is the time of the evaluation. *_CI compare strings ignoring case, IN_CI and NOTIN_CI take an array or a comma-separated list. IN_CIDR and NOT_IN_CIDR match IPv4 and IPv6 addresses against an array or a comma-separated list of CIDR ranges. IN_LIST and NOT_IN_LIST match against the ID list whose key is the value. The values of these operators don't need quotes
 	// Required: true
 	// Min Length: 1
-	// Enum: [EQ NEQ LT LTE GT GTE EREG NEREG IN NOTIN CONTAINS NOTCONTAINS]
+	// Enum: [EQ NEQ LT LTE GT GTE EREG NEREG IN NOTIN CONTAINS NOTCONTAINS SEMVER_EQ SEMVER_LT SEMVER_LTE SEMVER_GT SEMVER_GTE BEFORE AFTER BETWEEN STARTS_WITH NOT_STARTS_WITH ENDS_WITH NOT_ENDS_WITH EQ_CI NEQ_CI IN_CI NOTIN_CI IN_CIDR NOT_IN_CIDR IN_LIST NOT_IN_LIST]
 	Operator *string `json:"operator"`
 
 	// property
@@ -39,6 +39,10 @@ type Constraint struct {
 	// Required: true
 	// Min Length: 1
 	Value *string `json:"value"`
+
+	// the type of the plain value that flagr builds the expression from, e.g. the value CA with STRING, or CA, NY with STRING_LIST. Without it, the value is a literal of the expression, e.g. "CA" or ["CA", "NY"]. The operators other than EQ to NOTCONTAINS take plain values whatever the type
+	// Enum: [STRING NUMBER BOOL STRING_LIST NUMBER_LIST]
+	ValueType string `json:"valueType,omitempty"`
 }
 
 // Validate validates this constraint
@@ -61,6 +65,10 @@ func (m *Constraint) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateValueType(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -84,7 +92,7 @@ var constraintTypeOperatorPropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["EQ","NEQ","LT","LTE","GT","GTE","EREG","NEREG","IN","NOTIN","CONTAINS","NOTCONTAINS"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["EQ","NEQ","LT","LTE","GT","GTE","EREG","NEREG","IN","NOTIN","CONTAINS","NOTCONTAINS","SEMVER_EQ","SEMVER_LT","SEMVER_LTE","SEMVER_GT","SEMVER_GTE","BEFORE","AFTER","BETWEEN","STARTS_WITH","NOT_STARTS_WITH","ENDS_WITH","NOT_ENDS_WITH","EQ_CI","NEQ_CI","IN_CI","NOTIN_CI","IN_CIDR","NOT_IN_CIDR","IN_LIST","NOT_IN_LIST"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -129,6 +137,66 @@ const (
 
 	// ConstraintOperatorNOTCONTAINS captures enum value "NOTCONTAINS"
 	ConstraintOperatorNOTCONTAINS string = "NOTCONTAINS"
+
+	// ConstraintOperatorSEMVEREQ captures enum value "SEMVER_EQ"
+	ConstraintOperatorSEMVEREQ string = "SEMVER_EQ"
+
+	// ConstraintOperatorSEMVERLT captures enum value "SEMVER_LT"
+	ConstraintOperatorSEMVERLT string = "SEMVER_LT"
+
+	// ConstraintOperatorSEMVERLTE captures enum value "SEMVER_LTE"
+	ConstraintOperatorSEMVERLTE string = "SEMVER_LTE"
+
+	// ConstraintOperatorSEMVERGT captures enum value "SEMVER_GT"
+	ConstraintOperatorSEMVERGT string = "SEMVER_GT"
+
+	// ConstraintOperatorSEMVERGTE captures enum value "SEMVER_GTE"
+	ConstraintOperatorSEMVERGTE string = "SEMVER_GTE"
+
+	// ConstraintOperatorBEFORE captures enum value "BEFORE"
+	ConstraintOperatorBEFORE string = "BEFORE"
+
+	// ConstraintOperatorAFTER captures enum value "AFTER"
+	ConstraintOperatorAFTER string = "AFTER"
+
+	// ConstraintOperatorBETWEEN captures enum value "BETWEEN"
+	ConstraintOperatorBETWEEN string = "BETWEEN"
+
+	// ConstraintOperatorSTARTSWITH captures enum value "STARTS_WITH"
+	ConstraintOperatorSTARTSWITH string = "STARTS_WITH"
+
+	// ConstraintOperatorNOTSTARTSWITH captures enum value "NOT_STARTS_WITH"
+	ConstraintOperatorNOTSTARTSWITH string = "NOT_STARTS_WITH"
+
+	// ConstraintOperatorENDSWITH captures enum value "ENDS_WITH"
+	ConstraintOperatorENDSWITH string = "ENDS_WITH"
+
+	// ConstraintOperatorNOTENDSWITH captures enum value "NOT_ENDS_WITH"
+	ConstraintOperatorNOTENDSWITH string = "NOT_ENDS_WITH"
+
+	// ConstraintOperatorEQCI captures enum value "EQ_CI"
+	ConstraintOperatorEQCI string = "EQ_CI"
+
+	// ConstraintOperatorNEQCI captures enum value "NEQ_CI"
+	ConstraintOperatorNEQCI string = "NEQ_CI"
+
+	// ConstraintOperatorINCI captures enum value "IN_CI"
+	ConstraintOperatorINCI string = "IN_CI"
+
+	// ConstraintOperatorNOTINCI captures enum value "NOTIN_CI"
+	ConstraintOperatorNOTINCI string = "NOTIN_CI"
+
+	// ConstraintOperatorINCIDR captures enum value "IN_CIDR"
+	ConstraintOperatorINCIDR string = "IN_CIDR"
+
+	// ConstraintOperatorNOTINCIDR captures enum value "NOT_IN_CIDR"
+	ConstraintOperatorNOTINCIDR string = "NOT_IN_CIDR"
+
+	// ConstraintOperatorINLIST captures enum value "IN_LIST"
+	ConstraintOperatorINLIST string = "IN_LIST"
+
+	// ConstraintOperatorNOTINLIST captures enum value "NOT_IN_LIST"
+	ConstraintOperatorNOTINLIST string = "NOT_IN_LIST"
 )
 
 // prop value enum
@@ -183,6 +251,58 @@ func (m *Constraint) validateValue(formats strfmt.Registry) error {
 	return nil
 }
 
+var constraintTypeValueTypePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["STRING","NUMBER","BOOL","STRING_LIST","NUMBER_LIST"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		constraintTypeValueTypePropEnum = append(constraintTypeValueTypePropEnum, v)
+	}
+}
+
+const (
+
+	// ConstraintValueTypeSTRING captures enum value "STRING"
+	ConstraintValueTypeSTRING string = "STRING"
+
+	// ConstraintValueTypeNUMBER captures enum value "NUMBER"
+	ConstraintValueTypeNUMBER string = "NUMBER"
+
+	// ConstraintValueTypeBOOL captures enum value "BOOL"
+	ConstraintValueTypeBOOL string = "BOOL"
+
+	// ConstraintValueTypeSTRINGLIST captures enum value "STRING_LIST"
+	ConstraintValueTypeSTRINGLIST string = "STRING_LIST"
+
+	// ConstraintValueTypeNUMBERLIST captures enum value "NUMBER_LIST"
+	ConstraintValueTypeNUMBERLIST string = "NUMBER_LIST"
+)
+
+// prop value enum
+func (m *Constraint) validateValueTypeEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, constraintTypeValueTypePropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Constraint) validateValueType(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ValueType) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateValueTypeEnum("valueType", "body", m.ValueType); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *Constraint) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -6,6 +6,8 @@ package models
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"encoding/json"
+
 	strfmt "github.com/go-openapi/strfmt"
 
 	"github.com/go-openapi/errors"
@@ -31,6 +33,10 @@ type CreateConstraintRequest struct {
 	// Required: true
 	// Min Length: 1
 	Value *string `json:"value"`
+
+	// the type of the plain value that flagr builds the expression from, e.g. the value CA with STRING, or CA, NY with STRING_LIST. Without it, or with an empty one, the value is a literal of the expression, e.g. "CA" or ["CA", "NY"]. The operators other than EQ to NOTCONTAINS take plain values whatever the type. Updating a constraint without it keeps its stored type
+	// Enum: [ STRING NUMBER BOOL STRING_LIST NUMBER_LIST]
+	ValueType *string `json:"valueType,omitempty"`
 }
 
 // Validate validates this create constraint request
@@ -49,6 +55,10 @@ func (m *CreateConstraintRequest) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateValueType(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -94,6 +104,61 @@ func (m *CreateConstraintRequest) validateValue(formats strfmt.Registry) error {
 	return nil
 }
 
+var createConstraintRequestTypeValueTypePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["","STRING","NUMBER","BOOL","STRING_LIST","NUMBER_LIST"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		createConstraintRequestTypeValueTypePropEnum = append(createConstraintRequestTypeValueTypePropEnum, v)
+	}
+}
+
+const (
+
+	// CreateConstraintRequestValueTypeEmpty captures enum value ""
+	CreateConstraintRequestValueTypeEmpty string = ""
+
+	// CreateConstraintRequestValueTypeSTRING captures enum value "STRING"
+	CreateConstraintRequestValueTypeSTRING string = "STRING"
+
+	// CreateConstraintRequestValueTypeNUMBER captures enum value "NUMBER"
+	CreateConstraintRequestValueTypeNUMBER string = "NUMBER"
+
+	// CreateConstraintRequestValueTypeBOOL captures enum value "BOOL"
+	CreateConstraintRequestValueTypeBOOL string = "BOOL"
+
+	// CreateConstraintRequestValueTypeSTRINGLIST captures enum value "STRING_LIST"
+	CreateConstraintRequestValueTypeSTRINGLIST string = "STRING_LIST"
+
+	// CreateConstraintRequestValueTypeNUMBERLIST captures enum value "NUMBER_LIST"
+	CreateConstraintRequestValueTypeNUMBERLIST string = "NUMBER_LIST"
+)
+
+// prop value enum
+func (m *CreateConstraintRequest) validateValueTypeEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, createConstraintRequestTypeValueTypePropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *CreateConstraintRequest) validateValueType(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ValueType) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateValueTypeEnum("valueType", "body", *m.ValueType); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *CreateConstraintRequest) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -17,11 +17,20 @@ import (
 // swagger:model createSegmentRequest
 type CreateSegmentRequest struct {
 
+	// the audience whose constraints the entities must match besides the constraints of the segment, 0 means none
+	// Minimum: 0
+	AudienceID int64 `json:"audienceID,omitempty"`
+
 	// description
 	// Required: true
 	// Min Length: 1
 	Description *string `json:"description"`
 
+	// the rollout in basis points (0.01%), it overrides rolloutPercent if it's not 0
+	// Maximum: 10000
+	// Minimum: 0
+	RolloutBasisPoints int64 `json:"rolloutBasisPoints,omitempty"`
+
 	// rollout percent
 	// Required: true
 	// Maximum: 100
@@ -33,10 +42,18 @@ type CreateSegmentRequest struct {
 func (m *CreateSegmentRequest) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateAudienceID(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateDescription(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateRolloutBasisPoints(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateRolloutPercent(formats); err != nil {
 		res = append(res, err)
 	}
@@ -47,6 +64,19 @@ func (m *CreateSegmentRequest) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *CreateSegmentRequest) validateAudienceID(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.AudienceID) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("audienceID", "body", int64(m.AudienceID), 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *CreateSegmentRequest) validateDescription(formats strfmt.Registry) error {
 
 	if err := validate.Required("description", "body", m.Description); err != nil {
@@ -60,6 +90,23 @@ func (m *CreateSegmentRequest) validateDescription(formats strfmt.Registry) erro
 	return nil
 }
 
+func (m *CreateSegmentRequest) validateRolloutBasisPoints(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.RolloutBasisPoints) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("rolloutBasisPoints", "body", int64(m.RolloutBasisPoints), 0, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("rolloutBasisPoints", "body", int64(m.RolloutBasisPoints), 10000, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *CreateSegmentRequest) validateRolloutPercent(formats strfmt.Registry) error {
 
 	if err := validate.Required("rolloutPercent", "body", m.RolloutPercent); err != nil {
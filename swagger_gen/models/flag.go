@@ -6,6 +6,7 @@ package models
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"encoding/json"
 	"strconv"
 
 	strfmt "github.com/go-openapi/strfmt"
@@ -19,6 +20,12 @@ import (
 // swagger:model flag
 type Flag struct {
 
+	// JSON Schema (draft 4) that the variant attachments of the flag are validated against
+	AttachmentSchema interface{} `json:"attachmentSchema,omitempty"`
+
+	// the entityContext property to bucket the entities by instead of the entityID, e.g. company_id. The entityID is used if it's missing
+	BucketBy string `json:"bucketBy,omitempty"`
+
 	// created by
 	CreatedBy string `json:"createdBy,omitempty"`
 
@@ -35,6 +42,10 @@ type Flag struct {
 	// Required: true
 	Enabled *bool `json:"enabled"`
 
+	// the hash of bucketing the entities. crc32 into 1000 buckets by default, or sha1 into 1000000 buckets for rollouts in basis points
+	// Enum: [crc32 sha1]
+	Hash string `json:"hash,omitempty"`
+
 	// id
 	// Read Only: true
 	// Minimum: 1
@@ -44,9 +55,30 @@ type Flag struct {
 	// Min Length: 1
 	Key string `json:"key,omitempty"`
 
+	// the bucket after the last bucket of the layer allocated to the flag
+	// Read Only: true
+	// Minimum: 0
+	LayerBucketEnd int64 `json:"layerBucketEnd,omitempty"`
+
+	// the first bucket of the layer allocated to the flag
+	// Read Only: true
+	// Minimum: 0
+	LayerBucketStart int64 `json:"layerBucketStart,omitempty"`
+
+	// the layer the flag is in, 0 means none
+	// Read Only: true
+	// Minimum: 0
+	LayerID int64 `json:"layerID,omitempty"`
+
+	// the salt of bucketing the entities, the flagID if empty. Flags with the same salt and hash bucket an entity the same way
+	Salt string `json:"salt,omitempty"`
+
 	// segments
 	Segments []*Segment `json:"segments"`
 
+	// the first assignment of every entity is stored and reused as long as the entity stays in the same segment, even if the distributions change
+	StickyAssignments bool `json:"stickyAssignments,omitempty"`
+
 	// updated at
 	// Format: date-time
 	UpdatedAt strfmt.DateTime `json:"updatedAt,omitempty"`
@@ -74,6 +106,10 @@ func (m *Flag) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateHash(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateID(formats); err != nil {
 		res = append(res, err)
 	}
@@ -82,6 +118,18 @@ func (m *Flag) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateLayerBucketEnd(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateLayerBucketStart(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateLayerID(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateSegments(formats); err != nil {
 		res = append(res, err)
 	}
@@ -131,6 +179,49 @@ func (m *Flag) validateEnabled(formats strfmt.Registry) error {
 	return nil
 }
 
+var flagTypeHashPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["crc32","sha1"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		flagTypeHashPropEnum = append(flagTypeHashPropEnum, v)
+	}
+}
+
+const (
+
+	// FlagHashCrc32 captures enum value "crc32"
+	FlagHashCrc32 string = "crc32"
+
+	// FlagHashSha1 captures enum value "sha1"
+	FlagHashSha1 string = "sha1"
+)
+
+// prop value enum
+func (m *Flag) validateHashEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, flagTypeHashPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Flag) validateHash(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Hash) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateHashEnum("hash", "body", m.Hash); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Flag) validateID(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.ID) { // not required
@@ -157,6 +248,45 @@ func (m *Flag) validateKey(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Flag) validateLayerBucketEnd(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.LayerBucketEnd) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("layerBucketEnd", "body", int64(m.LayerBucketEnd), 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Flag) validateLayerBucketStart(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.LayerBucketStart) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("layerBucketStart", "body", int64(m.LayerBucketStart), 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Flag) validateLayerID(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.LayerID) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("layerID", "body", int64(m.LayerID), 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Flag) validateSegments(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.Segments) { // not required
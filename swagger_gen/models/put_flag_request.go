@@ -6,6 +6,8 @@ package models
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"encoding/json"
+
 	strfmt "github.com/go-openapi/strfmt"
 
 	"github.com/go-openapi/errors"
@@ -17,6 +19,12 @@ import (
 // swagger:model putFlagRequest
 type PutFlagRequest struct {
 
+	// JSON Schema (draft 4) that the variant attachments of the flag are validated against, an empty object removes it
+	AttachmentSchema interface{} `json:"attachmentSchema,omitempty"`
+
+	// the entityContext property to bucket the entities by instead of the entityID, an empty string resets it to the entityID
+	BucketBy *string `json:"bucketBy,omitempty"`
+
 	// enabled data records will get data logging in the metrics pipeline, for example, kafka.
 	DataRecordsEnabled *bool `json:"dataRecordsEnabled,omitempty"`
 
@@ -25,8 +33,18 @@ type PutFlagRequest struct {
 	// Min Length: 1
 	Description *string `json:"description"`
 
+	// the hash of bucketing the entities, crc32 or sha1. Changing it re-buckets all the entities
+	// Enum: [crc32 sha1]
+	Hash *string `json:"hash,omitempty"`
+
 	// key
 	Key *string `json:"key,omitempty"`
+
+	// the salt of bucketing the entities, an empty string resets it to the flagID. Changing it re-buckets all the entities
+	Salt *string `json:"salt,omitempty"`
+
+	// the first assignment of every entity is stored and reused as long as the entity stays in the same segment, even if the distributions change
+	StickyAssignments *bool `json:"stickyAssignments,omitempty"`
 }
 
 // Validate validates this put flag request
@@ -37,6 +55,10 @@ func (m *PutFlagRequest) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateHash(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -56,6 +78,49 @@ func (m *PutFlagRequest) validateDescription(formats strfmt.Registry) error {
 	return nil
 }
 
+var putFlagRequestTypeHashPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["crc32","sha1"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		putFlagRequestTypeHashPropEnum = append(putFlagRequestTypeHashPropEnum, v)
+	}
+}
+
+const (
+
+	// PutFlagRequestHashCrc32 captures enum value "crc32"
+	PutFlagRequestHashCrc32 string = "crc32"
+
+	// PutFlagRequestHashSha1 captures enum value "sha1"
+	PutFlagRequestHashSha1 string = "sha1"
+)
+
+// prop value enum
+func (m *PutFlagRequest) validateHashEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, putFlagRequestTypeHashPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *PutFlagRequest) validateHash(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Hash) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateHashEnum("hash", "body", *m.Hash); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *PutFlagRequest) MarshalBinary() ([]byte, error) {
 	if m == nil {
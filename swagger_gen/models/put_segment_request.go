@@ -17,11 +17,23 @@ import (
 // swagger:model putSegmentRequest
 type PutSegmentRequest struct {
 
+	// the audience whose constraints the entities must match besides the constraints of the segment, 0 means none. It's kept if absent
+	// Minimum: 0
+	AudienceID *int64 `json:"audienceID,omitempty"`
+
+	// the boolean group of the constraints, it's kept if absent and removed if empty
+	ConstraintGroup *ConstraintGroup `json:"constraintGroup,omitempty"`
+
 	// description
 	// Required: true
 	// Min Length: 1
 	Description *string `json:"description"`
 
+	// the rollout in basis points (0.01%), it overrides rolloutPercent if it's not 0. It's kept if absent, unless rolloutPercent is changed
+	// Maximum: 10000
+	// Minimum: 0
+	RolloutBasisPoints *int64 `json:"rolloutBasisPoints,omitempty"`
+
 	// rollout percent
 	// Required: true
 	// Maximum: 100
@@ -33,10 +45,22 @@ type PutSegmentRequest struct {
 func (m *PutSegmentRequest) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateAudienceID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateConstraintGroup(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateDescription(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateRolloutBasisPoints(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateRolloutPercent(formats); err != nil {
 		res = append(res, err)
 	}
@@ -47,6 +71,37 @@ func (m *PutSegmentRequest) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *PutSegmentRequest) validateAudienceID(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.AudienceID) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("audienceID", "body", int64(*m.AudienceID), 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PutSegmentRequest) validateConstraintGroup(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ConstraintGroup) { // not required
+		return nil
+	}
+
+	if m.ConstraintGroup != nil {
+		if err := m.ConstraintGroup.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("constraintGroup")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *PutSegmentRequest) validateDescription(formats strfmt.Registry) error {
 
 	if err := validate.Required("description", "body", m.Description); err != nil {
@@ -60,6 +115,23 @@ func (m *PutSegmentRequest) validateDescription(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *PutSegmentRequest) validateRolloutBasisPoints(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.RolloutBasisPoints) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("rolloutBasisPoints", "body", int64(*m.RolloutBasisPoints), 0, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("rolloutBasisPoints", "body", int64(*m.RolloutBasisPoints), 10000, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *PutSegmentRequest) validateRolloutPercent(formats strfmt.Registry) error {
 
 	if err := validate.Required("rolloutPercent", "body", m.RolloutPercent); err != nil {
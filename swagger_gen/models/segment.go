@@ -19,6 +19,13 @@ import (
 // swagger:model segment
 type Segment struct {
 
+	// the audience whose constraints the entities must match besides the constraints of the segment, 0 means none
+	// Minimum: 0
+	AudienceID int64 `json:"audienceID,omitempty"`
+
+	// constraint group
+	ConstraintGroup *ConstraintGroup `json:"constraintGroup,omitempty"`
+
 	// constraints
 	Constraints []*Constraint `json:"constraints"`
 
@@ -40,6 +47,11 @@ type Segment struct {
 	// Minimum: 0
 	Rank *int64 `json:"rank"`
 
+	// the rollout in basis points (0.01%), it overrides rolloutPercent if it's not 0
+	// Maximum: 10000
+	// Minimum: 0
+	RolloutBasisPoints int64 `json:"rolloutBasisPoints,omitempty"`
+
 	// rollout percent
 	// Required: true
 	// Maximum: 100
@@ -51,6 +63,14 @@ type Segment struct {
 func (m *Segment) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateAudienceID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateConstraintGroup(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateConstraints(formats); err != nil {
 		res = append(res, err)
 	}
@@ -71,6 +91,10 @@ func (m *Segment) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateRolloutBasisPoints(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateRolloutPercent(formats); err != nil {
 		res = append(res, err)
 	}
@@ -81,6 +105,37 @@ func (m *Segment) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Segment) validateAudienceID(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.AudienceID) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("audienceID", "body", int64(m.AudienceID), 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Segment) validateConstraintGroup(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ConstraintGroup) { // not required
+		return nil
+	}
+
+	if m.ConstraintGroup != nil {
+		if err := m.ConstraintGroup.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("constraintGroup")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Segment) validateConstraints(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.Constraints) { // not required
@@ -170,6 +225,23 @@ func (m *Segment) validateRank(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Segment) validateRolloutBasisPoints(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.RolloutBasisPoints) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("rolloutBasisPoints", "body", int64(m.RolloutBasisPoints), 0, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("rolloutBasisPoints", "body", int64(m.RolloutBasisPoints), 10000, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Segment) validateRolloutPercent(formats strfmt.Registry) error {
 
 	if err := validate.Required("rolloutPercent", "body", m.RolloutPercent); err != nil {
@@ -35,28 +35,20 @@ func init() {
   },
   "basePath": "/api/v1",
   "paths": {
-    "/evaluation": {
-      "post": {
+    "/audiences": {
+      "get": {
         "tags": [
-          "evaluation"
-        ],
-        "operationId": "postEvaluation",
-        "parameters": [
-          {
-            "description": "evalution context",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/evalContext"
-            }
-          }
+          "audience"
         ],
+        "operationId": "findAudiences",
         "responses": {
           "200": {
-            "description": "evaluation result",
+            "description": "list all the audiences",
             "schema": {
-              "$ref": "#/definitions/evalResult"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/audience"
+              }
             }
           },
           "default": {
@@ -66,56 +58,28 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/evaluation/batch": {
+      },
       "post": {
         "tags": [
-          "evaluation"
+          "audience"
         ],
-        "operationId": "postEvaluationBatch",
+        "operationId": "createAudience",
         "parameters": [
           {
-            "description": "evalution batch request",
+            "description": "create an audience",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/evaluationBatchRequest"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "evaluation batch result",
-            "schema": {
-              "$ref": "#/definitions/evaluationBatchResponse"
-            }
-          },
-          "default": {
-            "description": "generic error response",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/createAudienceRequest"
             }
           }
-        }
-      }
-    },
-    "/export/sqlite": {
-      "get": {
-        "description": "Export sqlite3 format of the db dump, which is converted from the main database.",
-        "produces": [
-          "application/octet-stream"
-        ],
-        "tags": [
-          "export"
         ],
-        "operationId": "getExportSQLite",
         "responses": {
           "200": {
-            "description": "OK",
+            "description": "returns the created audience",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/audience"
             }
           },
           "default": {
@@ -127,60 +91,28 @@ func init() {
         }
       }
     },
-    "/flags": {
+    "/audiences/{audienceID}": {
       "get": {
         "tags": [
-          "flag"
+          "audience"
         ],
-        "operationId": "findFlags",
+        "operationId": "getAudience",
         "parameters": [
           {
+            "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "the numbers of flags to return",
-            "name": "limit",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "description": "return flags having given enabled status",
-            "name": "enabled",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "return flags exactly matching given description",
-            "name": "description",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "return flags partially matching given description",
-            "name": "description_like",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "return flags matching given key",
-            "name": "key",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int64",
-            "description": "return flags given the offset, it should usually set together with limit",
-            "name": "offset",
-            "in": "query"
+            "description": "numeric ID of the audience",
+            "name": "audienceID",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "list all the flags",
+            "description": "returns the audience with the segments using it",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/flag"
-              }
+              "$ref": "#/definitions/audience"
             }
           },
           "default": {
@@ -191,27 +123,36 @@ func init() {
           }
         }
       },
-      "post": {
+      "put": {
         "tags": [
-          "flag"
+          "audience"
         ],
-        "operationId": "createFlag",
+        "operationId": "putAudience",
         "parameters": [
           {
-            "description": "create a flag",
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the audience",
+            "name": "audienceID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "update an audience",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/createFlagRequest"
+              "$ref": "#/definitions/putAudienceRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "returns the created flag",
+            "description": "returns the audience just updated",
             "schema": {
-              "$ref": "#/definitions/flag"
+              "$ref": "#/definitions/audience"
             }
           },
           "default": {
@@ -221,31 +162,26 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/flags/{flagID}": {
-      "get": {
+      },
+      "delete": {
         "tags": [
-          "flag"
+          "audience"
         ],
-        "operationId": "getFlag",
+        "operationId": "deleteAudience",
         "parameters": [
           {
             "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "numeric ID of the flag to get",
-            "name": "flagID",
+            "description": "numeric ID of the audience",
+            "name": "audienceID",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "returns the flag",
-            "schema": {
-              "$ref": "#/definitions/flag"
-            }
+            "description": "deleted, an audience still used by segments cannot be deleted"
           },
           "default": {
             "description": "generic error response",
@@ -254,38 +190,29 @@ func init() {
             }
           }
         }
-      },
-      "put": {
+      }
+    },
+    "/conversions": {
+      "post": {
+        "description": "ingest the conversion events of the entities for the experiment analysis",
         "tags": [
-          "flag"
+          "analysis"
         ],
-        "operationId": "putFlag",
+        "operationId": "postConversionEvents",
         "parameters": [
           {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the flag to get",
-            "name": "flagID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "update a flag",
+            "description": "conversion events",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/putFlagRequest"
+              "$ref": "#/definitions/postConversionEventsRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "returns the flag",
-            "schema": {
-              "$ref": "#/definitions/flag"
-            }
+            "description": "conversion events are ingested"
           },
           "default": {
             "description": "generic error response",
@@ -294,26 +221,31 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
+      }
+    },
+    "/evaluation": {
+      "post": {
         "tags": [
-          "flag"
+          "evaluation"
         ],
-        "operationId": "deleteFlag",
+        "operationId": "postEvaluation",
         "parameters": [
           {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the flag",
-            "name": "flagID",
-            "in": "path",
-            "required": true
+            "description": "evalution context",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/evalContext"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "OK deleted"
+            "description": "evaluation result",
+            "schema": {
+              "$ref": "#/definitions/evalResult"
+            }
           },
           "default": {
             "description": "generic error response",
@@ -324,37 +256,28 @@ func init() {
         }
       }
     },
-    "/flags/{flagID}/enabled": {
-      "put": {
+    "/evaluation/batch": {
+      "post": {
         "tags": [
-          "flag"
+          "evaluation"
         ],
-        "operationId": "setFlagEnabled",
+        "operationId": "postEvaluationBatch",
         "parameters": [
           {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the flag to get",
-            "name": "flagID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "set flag enabled state",
+            "description": "evalution batch request",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/setFlagEnabledRequest"
+              "$ref": "#/definitions/evaluationBatchRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "returns the flag",
+            "description": "evaluation batch result",
             "schema": {
-              "$ref": "#/definitions/flag"
+              "$ref": "#/definitions/evaluationBatchResponse"
             }
           },
           "default": {
@@ -366,31 +289,29 @@ func init() {
         }
       }
     },
-    "/flags/{flagID}/segments": {
-      "get": {
+    "/evaluation/batch/compact": {
+      "post": {
+        "description": "Evaluates the flags like postEvaluationBatch, but returns only the variant key and attachment of each flag, keyed by the flagKey for each entity. It's the compact mode of postEvaluationBatch, served as its own operation for the same reason as postEvaluationCompact",
         "tags": [
-          "segment"
+          "evaluation"
         ],
-        "operationId": "findSegments",
+        "operationId": "postEvaluationBatchCompact",
         "parameters": [
           {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the flag to get",
-            "name": "flagID",
-            "in": "path",
-            "required": true
+            "description": "evalution batch request",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/evaluationBatchRequest"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "segments ordered by rank of the flag",
+            "description": "compact evaluation batch result",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/segment"
-              }
+              "$ref": "#/definitions/evaluationBatchCompactResponse"
             }
           },
           "default": {
@@ -400,37 +321,31 @@ func init() {
             }
           }
         }
-      },
+      }
+    },
+    "/evaluation/compact": {
       "post": {
+        "description": "Evaluates the flag like postEvaluation, but returns only the variant key and attachment of the flag, keyed by the flagKey. It's the compact mode of postEvaluation, served as its own operation rather than a query option, since a Swagger 2.0 operation can only declare one schema for its 200 response and the generated clients need to know which one they get",
         "tags": [
-          "segment"
+          "evaluation"
         ],
-        "operationId": "createSegment",
+        "operationId": "postEvaluationCompact",
         "parameters": [
           {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the flag to get",
-            "name": "flagID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "create a segment under a flag",
+            "description": "evalution context",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/createSegmentRequest"
+              "$ref": "#/definitions/evalContext"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "segment created",
+            "description": "compact evaluation result",
             "schema": {
-              "$ref": "#/definitions/segment"
+              "$ref": "#/definitions/evaluationCompactResponse"
             }
           },
           "default": {
@@ -442,35 +357,51 @@ func init() {
         }
       }
     },
-    "/flags/{flagID}/segments/reorder": {
-      "put": {
+    "/evaluation/config": {
+      "get": {
+        "description": "Returns the prepared evaluation config of the flags, so that clients can evaluate them locally. An entity is bucketed by crc32(salt + entityID) % 1000 against the accumulated distribution percents of the segment, the same way as the server side evaluation. The ETag header can be sent back in If-None-Match to get a 304 when nothing has changed.",
         "tags": [
-          "segment"
+          "evaluation"
         ],
-        "operationId": "putSegmentsReorder",
+        "operationId": "getEvaluationConfig",
         "parameters": [
           {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the flag",
-            "name": "flagID",
-            "in": "path",
-            "required": true
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "csv",
+            "description": "return the config of the flags matching the given keys",
+            "name": "flagKeys",
+            "in": "query"
           },
           {
-            "description": "reorder segments",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/putSegmentReorderRequest"
-            }
+            "type": "array",
+            "items": {
+              "type": "integer",
+              "format": "int64"
+            },
+            "collectionFormat": "csv",
+            "description": "return the config of the flags matching the given IDs",
+            "name": "flagIDs",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "segments reordered"
+            "description": "the evaluation config of the flags",
+            "schema": {
+              "$ref": "#/definitions/evaluationConfig"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "the entity tag of the evaluation config"
+              }
+            }
+          },
+          "304": {
+            "description": "the evaluation config matches If-None-Match"
           },
           "default": {
             "description": "generic error response",
@@ -481,46 +412,51 @@ func init() {
         }
       }
     },
-    "/flags/{flagID}/segments/{segmentID}": {
-      "put": {
+    "/evaluation/flags/{flagKey}": {
+      "get": {
+        "description": "Evaluates the flag for simple clients and edge caches. Query parameters prefixed with ctx. are mapped into the entity context, e.g. ctx.country=US, and numbers and booleans are inferred from their values. Results that don't depend on the entity context come with a cacheable Cache-Control header.",
         "tags": [
-          "segment"
+          "evaluation"
         ],
-        "operationId": "putSegment",
+        "operationId": "getEvaluation",
         "parameters": [
           {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the flag",
-            "name": "flagID",
+            "type": "string",
+            "description": "key or numeric ID of the flag",
+            "name": "flagKey",
             "in": "path",
             "required": true
           },
           {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the segment",
-            "name": "segmentID",
-            "in": "path",
-            "required": true
+            "type": "string",
+            "description": "entityID of the evaluation, flagr will randomly generate one if it's empty",
+            "name": "entityID",
+            "in": "query"
           },
           {
-            "description": "update a segment",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/putSegmentRequest"
-            }
+            "type": "string",
+            "description": "entityType of the evaluation",
+            "name": "entityType",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "return the debug logs of the evaluation",
+            "name": "enableDebug",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "segment updated",
+            "description": "evaluation result",
             "schema": {
-              "$ref": "#/definitions/segment"
+              "$ref": "#/definitions/evalResult"
+            },
+            "headers": {
+              "Cache-Control": {
+                "type": "string",
+                "description": "whether the evaluation result is cacheable"
+              }
             }
           },
           "default": {
@@ -530,35 +466,24 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
-        "tags": [
-          "segment"
+      }
+    },
+    "/export/sqlite": {
+      "get": {
+        "description": "Export sqlite3 format of the db dump, which is converted from the main database.",
+        "produces": [
+          "application/octet-stream"
         ],
-        "operationId": "deleteSegment",
-        "parameters": [
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the flag",
-            "name": "flagID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the segment",
-            "name": "segmentID",
-            "in": "path",
-            "required": true
-          }
+        "tags": [
+          "export"
         ],
+        "operationId": "getExportSQLite",
         "responses": {
           "200": {
-            "description": "deleted"
+            "description": "OK",
+            "schema": {
+              "type": "file"
+            }
           },
           "default": {
             "description": "generic error response",
@@ -569,39 +494,59 @@ func init() {
         }
       }
     },
-    "/flags/{flagID}/segments/{segmentID}/constraints": {
+    "/flags": {
       "get": {
         "tags": [
-          "constraint"
+          "flag"
         ],
-        "operationId": "findConstraints",
+        "operationId": "findFlags",
         "parameters": [
           {
-            "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "numeric ID of the flag",
-            "name": "flagID",
-            "in": "path",
-            "required": true
+            "description": "the numbers of flags to return",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "return flags having given enabled status",
+            "name": "enabled",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "return flags exactly matching given description",
+            "name": "description",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "return flags partially matching given description",
+            "name": "description_like",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "return flags matching given key",
+            "name": "key",
+            "in": "query"
           },
           {
-            "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "numeric ID of the segment",
-            "name": "segmentID",
-            "in": "path",
-            "required": true
+            "description": "return flags given the offset, it should usually set together with limit",
+            "name": "offset",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "constraints under the segment",
+            "description": "list all the flags",
             "schema": {
               "type": "array",
               "items": {
-                "$ref": "#/definitions/constraint"
+                "$ref": "#/definitions/flag"
               }
             }
           },
@@ -615,43 +560,25 @@ func init() {
       },
       "post": {
         "tags": [
-          "constraint"
+          "flag"
         ],
-        "operationId": "createConstraint",
+        "operationId": "createFlag",
         "parameters": [
           {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the flag",
-            "name": "flagID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the segment",
-            "name": "segmentID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "create a constraint",
+            "description": "create a flag",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/createConstraintRequest"
+              "$ref": "#/definitions/createFlagRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "the constraint created",
+            "description": "returns the created flag",
             "schema": {
-              "$ref": "#/definitions/constraint"
+              "$ref": "#/definitions/flag"
             }
           },
           "default": {
@@ -663,55 +590,91 @@ func init() {
         }
       }
     },
-    "/flags/{flagID}/segments/{segmentID}/constraints/{constraintID}": {
-      "put": {
+    "/flags/stream": {
+      "get": {
+        "description": "Server-Sent Events stream of the flag changes picked up by the evaluation cache. Every flag-updated event carries the new flag config with the snapshot ID as the event ID, and a flag-deleted event is sent when a flag is deleted. Reconnect with the Last-Event-ID header to resume, and comments are sent periodically as keepalives. The server closes the stream after FLAGR_FLAG_STREAM_MAX_DURATION (50s by default), so that it ends between two events before the server write timeout cuts it. Clients are expected to reconnect right away with the Last-Event-ID, which EventSource does by itself.",
+        "produces": [
+          "text/event-stream"
+        ],
         "tags": [
-          "constraint"
+          "flag"
         ],
-        "operationId": "putConstraint",
+        "operationId": "getFlagsStream",
+        "responses": {
+          "200": {
+            "description": "the stream of the flag change events"
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags/{flagID}": {
+      "get": {
+        "tags": [
+          "flag"
+        ],
+        "operationId": "getFlag",
         "parameters": [
           {
             "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "numeric ID of the flag",
+            "description": "numeric ID of the flag to get",
             "name": "flagID",
             "in": "path",
             "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the flag",
+            "schema": {
+              "$ref": "#/definitions/flag"
+            }
           },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "flag"
+        ],
+        "operationId": "putFlag",
+        "parameters": [
           {
             "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "numeric ID of the segment",
-            "name": "segmentID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the constraint",
-            "name": "constraintID",
+            "description": "numeric ID of the flag to get",
+            "name": "flagID",
             "in": "path",
             "required": true
           },
           {
-            "description": "create a constraint",
+            "description": "update a flag",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/createConstraintRequest"
+              "$ref": "#/definitions/putFlagRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "constraint just updated",
+            "description": "returns the flag",
             "schema": {
-              "$ref": "#/definitions/constraint"
+              "$ref": "#/definitions/flag"
             }
           },
           "default": {
@@ -724,9 +687,9 @@ func init() {
       },
       "delete": {
         "tags": [
-          "constraint"
+          "flag"
         ],
-        "operationId": "deleteConstraint",
+        "operationId": "deleteFlag",
         "parameters": [
           {
             "minimum": 1,
@@ -736,29 +699,11 @@ func init() {
             "name": "flagID",
             "in": "path",
             "required": true
-          },
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the segment",
-            "name": "segmentID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the constraint",
-            "name": "constraintID",
-            "in": "path",
-            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "deleted"
+            "description": "OK deleted"
           },
           "default": {
             "description": "generic error response",
@@ -769,12 +714,13 @@ func init() {
         }
       }
     },
-    "/flags/{flagID}/segments/{segmentID}/distributions": {
+    "/flags/{flagID}/analysis": {
       "get": {
+        "description": "compare the conversion of the metric between the variants of the flag",
         "tags": [
-          "distribution"
+          "analysis"
         ],
-        "operationId": "findDistributions",
+        "operationId": "getFlagAnalysis",
         "parameters": [
           {
             "minimum": 1,
@@ -786,23 +732,25 @@ func init() {
             "required": true
           },
           {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the segment",
-            "name": "segmentID",
-            "in": "path",
+            "minLength": 1,
+            "type": "string",
+            "description": "metric name of the conversion events",
+            "name": "metric",
+            "in": "query",
             "required": true
+          },
+          {
+            "type": "string",
+            "description": "the variant to compare with, defaults to the first variant of the flag",
+            "name": "controlVariantKey",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "distribution under the segment",
+            "description": "returns the analysis of the flag",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/distribution"
-              }
+              "$ref": "#/definitions/flagAnalysis"
             }
           },
           "default": {
@@ -812,13 +760,15 @@ func init() {
             }
           }
         }
-      },
-      "put": {
-        "description": "replace the distribution with the new setting",
+      }
+    },
+    "/flags/{flagID}/assignments": {
+      "delete": {
+        "description": "clear the sticky assignments of the flag, the entities get assigned again in the next evaluations",
         "tags": [
-          "distribution"
+          "flag"
         ],
-        "operationId": "putDistributions",
+        "operationId": "deleteFlagAssignments",
         "parameters": [
           {
             "minimum": 1,
@@ -829,33 +779,57 @@ func init() {
             "in": "path",
             "required": true
           },
+          {
+            "type": "string",
+            "description": "clear the assignment of the entity only, all the assignments of the flag if empty",
+            "name": "entityID",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "cleared"
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags/{flagID}/enabled": {
+      "put": {
+        "tags": [
+          "flag"
+        ],
+        "operationId": "setFlagEnabled",
+        "parameters": [
           {
             "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "numeric ID of the segment",
-            "name": "segmentID",
+            "description": "numeric ID of the flag to get",
+            "name": "flagID",
             "in": "path",
             "required": true
           },
           {
-            "description": "array of distributions",
+            "description": "set flag enabled state",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/putDistributionsRequest"
+              "$ref": "#/definitions/setFlagEnabledRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "distribution under the segment",
+            "description": "returns the flag",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/distribution"
-              }
+              "$ref": "#/definitions/flag"
             }
           },
           "default": {
@@ -867,31 +841,37 @@ func init() {
         }
       }
     },
-    "/flags/{flagID}/snapshots": {
-      "get": {
+    "/flags/{flagID}/layer": {
+      "put": {
         "tags": [
-          "flag"
+          "layer"
         ],
-        "operationId": "getFlagSnapshots",
+        "operationId": "setFlagLayer",
         "parameters": [
           {
             "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "numeric ID of the flag to get",
+            "description": "numeric ID of the flag",
             "name": "flagID",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "join the flag to a layer with a percent of the layer's buckets, or leave the layer with layerID 0",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/setFlagLayerRequest"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "returns the flag snapshots",
+            "description": "returns the flag",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/flagSnapshot"
-              }
+              "$ref": "#/definitions/flag"
             }
           },
           "default": {
@@ -903,12 +883,12 @@ func init() {
         }
       }
     },
-    "/flags/{flagID}/variants": {
+    "/flags/{flagID}/overrides": {
       "get": {
         "tags": [
-          "variant"
+          "override"
         ],
-        "operationId": "findVariants",
+        "operationId": "findOverrides",
         "parameters": [
           {
             "minimum": 1,
@@ -922,11 +902,11 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "variant ordered by variantID",
+            "description": "overrides ordered by overrideID",
             "schema": {
               "type": "array",
               "items": {
-                "$ref": "#/definitions/variant"
+                "$ref": "#/definitions/override"
               }
             }
           },
@@ -940,9 +920,9 @@ func init() {
       },
       "post": {
         "tags": [
-          "variant"
+          "override"
         ],
-        "operationId": "createVariant",
+        "operationId": "createOverride",
         "parameters": [
           {
             "minimum": 1,
@@ -954,20 +934,20 @@ func init() {
             "required": true
           },
           {
-            "description": "create a variant",
+            "description": "create an override",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/createVariantRequest"
+              "$ref": "#/definitions/createOverrideRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "variant just created",
+            "description": "override just created",
             "schema": {
-              "$ref": "#/definitions/variant"
+              "$ref": "#/definitions/override"
             }
           },
           "default": {
@@ -979,12 +959,12 @@ func init() {
         }
       }
     },
-    "/flags/{flagID}/variants/{variantID}": {
+    "/flags/{flagID}/overrides/{overrideID}": {
       "put": {
         "tags": [
-          "variant"
+          "override"
         ],
-        "operationId": "putVariant",
+        "operationId": "putOverride",
         "parameters": [
           {
             "minimum": 1,
@@ -999,26 +979,26 @@ func init() {
             "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "numeric ID of the variant",
-            "name": "variantID",
+            "description": "numeric ID of the override",
+            "name": "overrideID",
             "in": "path",
             "required": true
           },
           {
-            "description": "update a variant",
+            "description": "update an override",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/putVariantRequest"
+              "$ref": "#/definitions/putOverrideRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "variant just updated",
+            "description": "override just updated",
             "schema": {
-              "$ref": "#/definitions/variant"
+              "$ref": "#/definitions/override"
             }
           },
           "default": {
@@ -1031,9 +1011,9 @@ func init() {
       },
       "delete": {
         "tags": [
-          "variant"
+          "override"
         ],
-        "operationId": "deleteVariant",
+        "operationId": "deleteOverride",
         "parameters": [
           {
             "minimum": 1,
@@ -1048,8 +1028,8 @@ func init() {
             "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "numeric ID of the variant",
-            "name": "variantID",
+            "description": "numeric ID of the override",
+            "name": "overrideID",
             "in": "path",
             "required": true
           }
@@ -1067,16 +1047,32 @@ func init() {
         }
       }
     },
-    "/health": {
+    "/flags/{flagID}/segments": {
       "get": {
-        "description": "Check if Flagr is healthy",
         "tags": [
-          "health"
+          "segment"
+        ],
+        "operationId": "findSegments",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag to get",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          }
         ],
-        "operationId": "getHealth",
         "responses": {
           "200": {
-            "description": "OK"
+            "description": "segments ordered by rank of the flag",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/segment"
+              }
+            }
           },
           "default": {
             "description": "generic error response",
@@ -1085,697 +1081,5163 @@ func init() {
             }
           }
         }
-      }
-    }
-  },
-  "definitions": {
-    "constraint": {
-      "type": "object",
-      "required": [
-        "property",
-        "operator",
-        "value"
-      ],
-      "properties": {
-        "id": {
-          "type": "integer",
-          "format": "int64",
-          "minimum": 1,
-          "readOnly": true
-        },
-        "operator": {
-          "type": "string",
-          "minLength": 1,
-          "enum": [
-            "EQ",
-            "NEQ",
-            "LT",
-            "LTE",
-            "GT",
-            "GTE",
-            "EREG",
-            "NEREG",
-            "IN",
-            "NOTIN",
-            "CONTAINS",
-            "NOTCONTAINS"
-          ]
-        },
-        "property": {
-          "type": "string",
-          "minLength": 1
-        },
-        "value": {
-          "type": "string",
-          "minLength": 1
+      },
+      "post": {
+        "tags": [
+          "segment"
+        ],
+        "operationId": "createSegment",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag to get",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "create a segment under a flag",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createSegmentRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "segment created",
+            "schema": {
+              "$ref": "#/definitions/segment"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "createConstraintRequest": {
-      "type": "object",
-      "required": [
-        "property",
-        "operator",
-        "value"
-      ],
-      "properties": {
-        "operator": {
-          "type": "string",
-          "minLength": 1
-        },
-        "property": {
-          "type": "string",
-          "minLength": 1
-        },
-        "value": {
-          "type": "string",
-          "minLength": 1
+    "/flags/{flagID}/segments/reorder": {
+      "put": {
+        "tags": [
+          "segment"
+        ],
+        "operationId": "putSegmentsReorder",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "reorder segments",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putSegmentReorderRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "segments reordered"
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "createFlagRequest": {
-      "type": "object",
-      "required": [
-        "description"
-      ],
-      "properties": {
-        "description": {
-          "type": "string",
-          "minLength": 1
-        },
-        "key": {
-          "description": "unique key representation of the flag",
-          "type": "string"
+    "/flags/{flagID}/segments/{segmentID}": {
+      "put": {
+        "tags": [
+          "segment"
+        ],
+        "operationId": "putSegment",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "update a segment",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putSegmentRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "segment updated",
+            "schema": {
+              "$ref": "#/definitions/segment"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "segment"
+        ],
+        "operationId": "deleteSegment",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "deleted"
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "createSegmentRequest": {
-      "type": "object",
-      "required": [
-        "description",
-        "rolloutPercent"
-      ],
-      "properties": {
-        "description": {
-          "type": "string",
-          "minLength": 1
-        },
-        "rolloutPercent": {
-          "type": "integer",
-          "format": "int64",
-          "maximum": 100,
-          "minimum": 0
+    "/flags/{flagID}/segments/{segmentID}/constraints": {
+      "get": {
+        "tags": [
+          "constraint"
+        ],
+        "operationId": "findConstraints",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "constraints under the segment",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/constraint"
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "constraint"
+        ],
+        "operationId": "createConstraint",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "create a constraint",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createConstraintRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the constraint created",
+            "schema": {
+              "$ref": "#/definitions/constraint"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "createVariantRequest": {
-      "type": "object",
-      "required": [
-        "key"
-      ],
-      "properties": {
-        "attachment": {
-          "type": "object"
-        },
-        "key": {
-          "type": "string",
-          "minLength": 1
+    "/flags/{flagID}/segments/{segmentID}/constraints/{constraintID}": {
+      "put": {
+        "tags": [
+          "constraint"
+        ],
+        "operationId": "putConstraint",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the constraint",
+            "name": "constraintID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "create a constraint",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createConstraintRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "constraint just updated",
+            "schema": {
+              "$ref": "#/definitions/constraint"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "constraint"
+        ],
+        "operationId": "deleteConstraint",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the constraint",
+            "name": "constraintID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "deleted"
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags/{flagID}/segments/{segmentID}/distributions": {
+      "get": {
+        "tags": [
+          "distribution"
+        ],
+        "operationId": "findDistributions",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "distribution under the segment",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/distribution"
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "description": "replace the distribution with the new setting",
+        "tags": [
+          "distribution"
+        ],
+        "operationId": "putDistributions",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "array of distributions",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putDistributionsRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "distribution under the segment",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/distribution"
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags/{flagID}/snapshots": {
+      "get": {
+        "tags": [
+          "flag"
+        ],
+        "operationId": "getFlagSnapshots",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag to get",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the flag snapshots",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/flagSnapshot"
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags/{flagID}/stats": {
+      "get": {
+        "description": "returns the observed variant assignment counts of the flag per segment, and the result of the sample ratio mismatch (SRM) check against the distribution percents",
+        "tags": [
+          "flag"
+        ],
+        "operationId": "getFlagStats",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag to get",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the flag stats",
+            "schema": {
+              "$ref": "#/definitions/flagStats"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags/{flagID}/variants": {
+      "get": {
+        "tags": [
+          "variant"
+        ],
+        "operationId": "findVariants",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "variant ordered by variantID",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/variant"
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "variant"
+        ],
+        "operationId": "createVariant",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "create a variant",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createVariantRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "variant just created",
+            "schema": {
+              "$ref": "#/definitions/variant"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags/{flagID}/variants/{variantID}": {
+      "put": {
+        "tags": [
+          "variant"
+        ],
+        "operationId": "putVariant",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the variant",
+            "name": "variantID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "update a variant",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putVariantRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "variant just updated",
+            "schema": {
+              "$ref": "#/definitions/variant"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "variant"
+        ],
+        "operationId": "deleteVariant",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the variant",
+            "name": "variantID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "deleted"
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/health": {
+      "get": {
+        "description": "Check if Flagr is healthy",
+        "tags": [
+          "health"
+        ],
+        "operationId": "getHealth",
+        "responses": {
+          "200": {
+            "description": "OK"
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/id_lists": {
+      "get": {
+        "tags": [
+          "idlist"
+        ],
+        "operationId": "findIDLists",
+        "responses": {
+          "200": {
+            "description": "list all the ID lists without their IDs",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/idList"
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "idlist"
+        ],
+        "operationId": "createIDList",
+        "parameters": [
+          {
+            "description": "create an ID list",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createIDListRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the created ID list",
+            "schema": {
+              "$ref": "#/definitions/idList"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/id_lists/{idListID}": {
+      "get": {
+        "tags": [
+          "idlist"
+        ],
+        "operationId": "getIDList",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the ID list",
+            "name": "idListID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the ID list without its IDs",
+            "schema": {
+              "$ref": "#/definitions/idList"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "idlist"
+        ],
+        "operationId": "putIDList",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the ID list",
+            "name": "idListID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "update an ID list",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putIDListRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the ID list just updated",
+            "schema": {
+              "$ref": "#/definitions/idList"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "idlist"
+        ],
+        "operationId": "deleteIDList",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the ID list",
+            "name": "idListID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "deleted, an ID list still used by constraints cannot be deleted"
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/id_lists/{idListID}/ids": {
+      "get": {
+        "tags": [
+          "idlist"
+        ],
+        "operationId": "getIDListIDs",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the ID list",
+            "name": "idListID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the IDs of the ID list in the uploaded order",
+            "schema": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "idlist"
+        ],
+        "operationId": "putIDListIDs",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the ID list",
+            "name": "idListID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "upload the IDs of an ID list",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putIDListIDsRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the ID list just updated",
+            "schema": {
+              "$ref": "#/definitions/idList"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/layers": {
+      "get": {
+        "tags": [
+          "layer"
+        ],
+        "operationId": "findLayers",
+        "responses": {
+          "200": {
+            "description": "list all the layers",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/layer"
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "layer"
+        ],
+        "operationId": "createLayer",
+        "parameters": [
+          {
+            "description": "create a layer",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createLayerRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the created layer",
+            "schema": {
+              "$ref": "#/definitions/layer"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/layers/{layerID}": {
+      "get": {
+        "tags": [
+          "layer"
+        ],
+        "operationId": "getLayer",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the layer",
+            "name": "layerID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the layer with the buckets of its flags",
+            "schema": {
+              "$ref": "#/definitions/layer"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "layer"
+        ],
+        "operationId": "putLayer",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the layer",
+            "name": "layerID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "update a layer",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putLayerRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the layer just updated",
+            "schema": {
+              "$ref": "#/definitions/layer"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "layer"
+        ],
+        "operationId": "deleteLayer",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the layer",
+            "name": "layerID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "deleted"
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/webhooks": {
+      "get": {
+        "tags": [
+          "webhook"
+        ],
+        "operationId": "findWebhooks",
+        "responses": {
+          "200": {
+            "description": "list all the webhooks",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/webhook"
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "webhook"
+        ],
+        "operationId": "createWebhook",
+        "parameters": [
+          {
+            "description": "create a webhook",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createWebhookRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the created webhook",
+            "schema": {
+              "$ref": "#/definitions/webhook"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/webhooks/{webhookID}": {
+      "get": {
+        "tags": [
+          "webhook"
+        ],
+        "operationId": "getWebhook",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the webhook",
+            "name": "webhookID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the webhook",
+            "schema": {
+              "$ref": "#/definitions/webhook"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "webhook"
+        ],
+        "operationId": "putWebhook",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the webhook",
+            "name": "webhookID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "update a webhook",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putWebhookRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the webhook just updated",
+            "schema": {
+              "$ref": "#/definitions/webhook"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "webhook"
+        ],
+        "operationId": "deleteWebhook",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the webhook",
+            "name": "webhookID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "deleted"
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/webhooks/{webhookID}/deliveries": {
+      "get": {
+        "description": "returns the delivery log of the webhook, the latest first",
+        "tags": [
+          "webhook"
+        ],
+        "operationId": "findWebhookDeliveries",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the webhook",
+            "name": "webhookID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "pending",
+              "succeeded",
+              "failed"
+            ],
+            "type": "string",
+            "description": "return the deliveries with the given status",
+            "name": "status",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "the numbers of deliveries to return",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "return deliveries given the offset, it should usually set together with limit",
+            "name": "offset",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the webhook deliveries",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/webhookDelivery"
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "audience": {
+      "type": "object",
+      "required": [
+        "id",
+        "key",
+        "constraints",
+        "segments"
+      ],
+      "properties": {
+        "constraints": {
+          "description": "constraints joined by AND",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/constraint"
+          }
+        },
+        "description": {
+          "type": "string"
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1,
+          "readOnly": true
+        },
+        "key": {
+          "description": "unique key representation of the audience",
+          "type": "string",
+          "minLength": 1
+        },
+        "segments": {
+          "description": "the segments using the audience ordered by flagID",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/audienceSegment"
+          }
+        }
+      }
+    },
+    "audienceSegment": {
+      "type": "object",
+      "required": [
+        "flagID",
+        "segmentID"
+      ],
+      "properties": {
+        "description": {
+          "type": "string"
+        },
+        "flagID": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "flagKey": {
+          "type": "string"
+        },
+        "segmentID": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        }
+      }
+    },
+    "compactEvalResult": {
+      "type": "object",
+      "properties": {
+        "variantAttachment": {
+          "type": "object"
+        },
+        "variantKey": {
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "constraint": {
+      "type": "object",
+      "required": [
+        "property",
+        "operator",
+        "value"
+      ],
+      "properties": {
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1,
+          "readOnly": true
+        },
+        "operator": {
+          "description": "SEMVER_* compare semantic versions, in which prereleases precede their releases. BEFORE, AFTER and BETWEEN compare times, the values are RFC3339 times, dates, unix timestamps in seconds, times of day like \"09:00\" or now, and BETWEEN takes an array of the start and the excluded end. The property now is the time of the evaluation. *_CI compare strings ignoring case, IN_CI and NOTIN_CI take an array or a comma-separated list. IN_CIDR and NOT_IN_CIDR match IPv4 and IPv6 addresses against an array or a comma-separated list of CIDR ranges. IN_LIST and NOT_IN_LIST match against the ID list whose key is the value. The values of these operators don't need quotes",
+          "type": "string",
+          "minLength": 1,
+          "enum": [
+            "EQ",
+            "NEQ",
+            "LT",
+            "LTE",
+            "GT",
+            "GTE",
+            "EREG",
+            "NEREG",
+            "IN",
+            "NOTIN",
+            "CONTAINS",
+            "NOTCONTAINS",
+            "SEMVER_EQ",
+            "SEMVER_LT",
+            "SEMVER_LTE",
+            "SEMVER_GT",
+            "SEMVER_GTE",
+            "BEFORE",
+            "AFTER",
+            "BETWEEN",
+            "STARTS_WITH",
+            "NOT_STARTS_WITH",
+            "ENDS_WITH",
+            "NOT_ENDS_WITH",
+            "EQ_CI",
+            "NEQ_CI",
+            "IN_CI",
+            "NOTIN_CI",
+            "IN_CIDR",
+            "NOT_IN_CIDR",
+            "IN_LIST",
+            "NOT_IN_LIST"
+          ]
+        },
+        "property": {
+          "type": "string",
+          "minLength": 1
+        },
+        "value": {
+          "type": "string",
+          "minLength": 1
+        },
+        "valueType": {
+          "description": "the type of the plain value that flagr builds the expression from, e.g. the value CA with STRING, or CA, NY with STRING_LIST. Without it, the value is a literal of the expression, e.g. \"CA\" or [\"CA\", \"NY\"]. The operators other than EQ to NOTCONTAINS take plain values whatever the type",
+          "type": "string",
+          "enum": [
+            "STRING",
+            "NUMBER",
+            "BOOL",
+            "STRING_LIST",
+            "NUMBER_LIST"
+          ]
+        }
+      }
+    },
+    "constraintGroup": {
+      "description": "a boolean group of the constraints of a segment. a leaf references a constraint of the segment by constraintID, AND and OR join their children and NOT negates its only child",
+      "type": "object",
+      "properties": {
+        "children": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/constraintGroup"
+          }
+        },
+        "constraintID": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0
+        },
+        "operator": {
+          "type": "string",
+          "enum": [
+            "AND",
+            "OR",
+            "NOT"
+          ]
+        }
+      }
+    },
+    "conversionEvent": {
+      "type": "object",
+      "required": [
+        "entityID",
+        "flagKey",
+        "metric",
+        "value"
+      ],
+      "properties": {
+        "entityID": {
+          "type": "string",
+          "minLength": 1
+        },
+        "flagKey": {
+          "type": "string",
+          "minLength": 1
+        },
+        "metric": {
+          "description": "name of the metric, e.g. checkout",
+          "type": "string",
+          "minLength": 1
+        },
+        "value": {
+          "description": "value of the conversion, e.g. 1 for a binary metric or the revenue",
+          "type": "number",
+          "format": "double"
+        }
+      }
+    },
+    "createAudienceRequest": {
+      "type": "object",
+      "required": [
+        "key"
+      ],
+      "properties": {
+        "constraints": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/createConstraintRequest"
+          }
+        },
+        "description": {
+          "type": "string"
+        },
+        "key": {
+          "description": "unique key representation of the audience",
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "createConstraintRequest": {
+      "type": "object",
+      "required": [
+        "property",
+        "operator",
+        "value"
+      ],
+      "properties": {
+        "operator": {
+          "type": "string",
+          "minLength": 1
+        },
+        "property": {
+          "type": "string",
+          "minLength": 1
+        },
+        "value": {
+          "type": "string",
+          "minLength": 1
+        },
+        "valueType": {
+          "description": "the type of the plain value that flagr builds the expression from, e.g. the value CA with STRING, or CA, NY with STRING_LIST. Without it, or with an empty one, the value is a literal of the expression, e.g. \"CA\" or [\"CA\", \"NY\"]. The operators other than EQ to NOTCONTAINS take plain values whatever the type. Updating a constraint without it keeps its stored type",
+          "type": "string",
+          "enum": [
+            "",
+            "STRING",
+            "NUMBER",
+            "BOOL",
+            "STRING_LIST",
+            "NUMBER_LIST"
+          ],
+          "x-nullable": true
+        }
+      }
+    },
+    "createFlagRequest": {
+      "type": "object",
+      "required": [
+        "description"
+      ],
+      "properties": {
+        "description": {
+          "type": "string",
+          "minLength": 1
+        },
+        "key": {
+          "description": "unique key representation of the flag",
+          "type": "string"
+        }
+      }
+    },
+    "createIDListRequest": {
+      "type": "object",
+      "required": [
+        "key"
+      ],
+      "properties": {
+        "description": {
+          "type": "string"
+        },
+        "ids": {
+          "description": "the IDs separated by newlines or commas, e.g. the content of a CSV file",
+          "type": "string"
+        },
+        "key": {
+          "description": "unique key representation of the ID list, which the IN_LIST constraints reference",
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "createLayerRequest": {
+      "type": "object",
+      "required": [
+        "key"
+      ],
+      "properties": {
+        "description": {
+          "type": "string"
+        },
+        "key": {
+          "description": "unique key representation of the layer",
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "createOverrideRequest": {
+      "type": "object",
+      "required": [
+        "entityID",
+        "variantKey"
+      ],
+      "properties": {
+        "entityID": {
+          "type": "string",
+          "minLength": 1
+        },
+        "entityType": {
+          "description": "the override only applies to the entities of the type, any type if empty",
+          "type": "string"
+        },
+        "expiresAt": {
+          "description": "the override is ignored after it expires, it never expires if empty",
+          "type": "string",
+          "format": "date-time"
+        },
+        "variantKey": {
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "createSegmentRequest": {
+      "type": "object",
+      "required": [
+        "description",
+        "rolloutPercent"
+      ],
+      "properties": {
+        "audienceID": {
+          "description": "the audience whose constraints the entities must match besides the constraints of the segment, 0 means none",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0
+        },
+        "description": {
+          "type": "string",
+          "minLength": 1
+        },
+        "rolloutBasisPoints": {
+          "description": "the rollout in basis points (0.01%), it overrides rolloutPercent if it's not 0",
+          "type": "integer",
+          "format": "int64",
+          "maximum": 10000,
+          "minimum": 0
+        },
+        "rolloutPercent": {
+          "type": "integer",
+          "format": "int64",
+          "maximum": 100,
+          "minimum": 0
+        }
+      }
+    },
+    "createVariantRequest": {
+      "type": "object",
+      "required": [
+        "key"
+      ],
+      "properties": {
+        "attachment": {
+          "type": "object"
+        },
+        "key": {
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "createWebhookRequest": {
+      "type": "object",
+      "required": [
+        "url"
+      ],
+      "properties": {
+        "enabled": {
+          "type": "boolean"
+        },
+        "events": {
+          "description": "the events to subscribe, empty means all the events. flag.created, flag.updated, flag.enabled, flag.disabled or flag.deleted",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "flagKeys": {
+          "description": "the flags to subscribe, empty means all the flags",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "format": {
+          "description": "json posts the flag event, slack posts a human-readable message to a chat incoming webhook. json by default",
+          "type": "string",
+          "enum": [
+            "json",
+            "slack"
+          ]
+        },
+        "secret": {
+          "description": "the secret to sign the payloads with HMAC-SHA256",
+          "type": "string"
+        },
+        "url": {
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "distribution": {
+      "type": "object",
+      "required": [
+        "percent",
+        "variantID",
+        "variantKey"
+      ],
+      "properties": {
+        "bitmap": {
+          "type": "string",
+          "minLength": 1
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1,
+          "readOnly": true
+        },
+        "percent": {
+          "type": "integer",
+          "format": "int64",
+          "maximum": 100,
+          "minimum": 0
+        },
+        "variantID": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "variantKey": {
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "error": {
+      "type": "object",
+      "required": [
+        "message"
+      ],
+      "properties": {
+        "message": {
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "evalContext": {
+      "type": "object",
+      "required": [
+        "entityType"
+      ],
+      "properties": {
+        "enableDebug": {
+          "type": "boolean"
+        },
+        "entityContext": {
+          "type": "object"
+        },
+        "entityID": {
+          "description": "entityID is used to deterministically at random to evaluate the flag result. If it's empty, flagr will randomly generate one.",
+          "type": "string"
+        },
+        "entityType": {
+          "type": "string",
+          "minLength": 1
+        },
+        "flagID": {
+          "description": "flagID",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "flagKey": {
+          "description": "flagKey. flagID or flagKey will resolve to the same flag. Either works.",
+          "type": "string"
+        }
+      }
+    },
+    "evalDebugLog": {
+      "type": "object",
+      "properties": {
+        "msg": {
+          "type": "string"
+        },
+        "segmentDebugLogs": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/segmentDebugLog"
+          }
+        }
+      }
+    },
+    "evalResult": {
+      "type": "object",
+      "required": [
+        "flagID",
+        "flagKey",
+        "segmentID",
+        "variantID",
+        "variantKey",
+        "variantAttachment",
+        "evalContext",
+        "timestamp"
+      ],
+      "properties": {
+        "evalContext": {
+          "$ref": "#/definitions/evalContext"
+        },
+        "evalDebugLog": {
+          "$ref": "#/definitions/evalDebugLog"
+        },
+        "flagID": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "flagKey": {
+          "type": "string"
+        },
+        "flagSnapshotID": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "segmentID": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "timestamp": {
+          "type": "string",
+          "minLength": 1
+        },
+        "variantAttachment": {
+          "type": "object"
+        },
+        "variantID": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "variantKey": {
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "evaluationBatchCompactResponse": {
+      "type": "object",
+      "required": [
+        "evaluationResults"
+      ],
+      "properties": {
+        "evaluationResults": {
+          "description": "compact evaluation results of each entity, in the order of the entities",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/evaluationCompactResponse"
+          }
+        }
+      }
+    },
+    "evaluationBatchRequest": {
+      "type": "object",
+      "required": [
+        "entities"
+      ],
+      "properties": {
+        "enableDebug": {
+          "type": "boolean"
+        },
+        "entities": {
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "$ref": "#/definitions/evaluationEntity"
+          }
+        },
+        "flagIDs": {
+          "description": "flagIDs",
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "type": "integer",
+            "format": "int64",
+            "minimum": 1
+          }
+        },
+        "flagKeys": {
+          "description": "flagKeys. Either flagIDs or flagKeys works. If pass in both, Flagr may return duplicate results.",
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "type": "string",
+            "minLength": 1
+          }
+        }
+      }
+    },
+    "evaluationBatchResponse": {
+      "type": "object",
+      "required": [
+        "evaluationResults"
+      ],
+      "properties": {
+        "evaluationResults": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/evalResult"
+          }
+        }
+      }
+    },
+    "evaluationCompactResponse": {
+      "description": "compact evaluation results keyed by flagKey",
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/compactEvalResult"
+      }
+    },
+    "evaluationConfig": {
+      "type": "object",
+      "required": [
+        "flags"
+      ],
+      "properties": {
+        "flags": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/evaluationConfigFlag"
+          }
+        }
+      }
+    },
+    "evaluationConfigDistribution": {
+      "type": "object",
+      "required": [
+        "variantID",
+        "percent"
+      ],
+      "properties": {
+        "percent": {
+          "type": "integer",
+          "format": "int64",
+          "maximum": 100,
+          "minimum": 0
+        },
+        "variantID": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        }
+      }
+    },
+    "evaluationConfigFlag": {
+      "type": "object",
+      "required": [
+        "id",
+        "key",
+        "enabled",
+        "salt",
+        "segments",
+        "variants"
+      ],
+      "properties": {
+        "bucketBy": {
+          "description": "the entityContext property whose value is bucketed instead of the entityID if it's present",
+          "type": "string"
+        },
+        "bucketNum": {
+          "description": "the number of buckets of the hash, the distributions are accumulated in bucketNum/100 buckets per percent",
+          "type": "integer",
+          "format": "int64"
+        },
+        "enabled": {
+          "type": "boolean"
+        },
+        "hash": {
+          "description": "crc32 is crc32(salt+entityID) % 1000, sha1 is the first 8 bytes of sha1(salt+entityID) as a big-endian uint64 % 1000000",
+          "type": "string",
+          "enum": [
+            "crc32",
+            "sha1"
+          ]
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "key": {
+          "type": "string",
+          "minLength": 1
+        },
+        "layerBucketEnd": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "layerBucketStart": {
+          "description": "the entity gets a variant only if its layer bucket is in [layerBucketStart, layerBucketEnd)",
+          "type": "integer",
+          "format": "int64"
+        },
+        "layerID": {
+          "description": "the layer the flag is in, 0 means none",
+          "type": "integer",
+          "format": "int64"
+        },
+        "layerSalt": {
+          "description": "prefix of the entityID when computing the crc32 bucket in the layer",
+          "type": "string"
+        },
+        "overrides": {
+          "description": "the overrides of the entities, they are checked before the segments and the expired ones are skipped",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/override"
+          }
+        },
+        "salt": {
+          "description": "prefix of the entityID when computing the crc32 bucket",
+          "type": "string"
+        },
+        "segments": {
+          "description": "segments in the order of evaluation",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/evaluationConfigSegment"
+          }
+        },
+        "snapshotID": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "stickyAssignments": {
+          "description": "the stored assignments are only honored by the server side evaluation",
+          "type": "boolean"
+        },
+        "variants": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/variant"
+          }
+        }
+      }
+    },
+    "evaluationConfigSegment": {
+      "type": "object",
+      "required": [
+        "id",
+        "rolloutPercent",
+        "constraints",
+        "distributions"
+      ],
+      "properties": {
+        "audienceID": {
+          "description": "the audience of the segment, its constraints are included in the constraints",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0
+        },
+        "constraintGroup": {
+          "description": "the constraints not referenced by the group are joined by AND with it",
+          "$ref": "#/definitions/constraintGroup"
+        },
+        "constraints": {
+          "description": "constraints joined by AND, the constraints of the audience first",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/constraint"
+          }
+        },
+        "distributions": {
+          "description": "distributions ordered by variantID, in which the buckets are accumulated",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/evaluationConfigDistribution"
+          }
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "rolloutBasisPoints": {
+          "description": "the effective rollout in basis points (0.01%)",
+          "type": "integer",
+          "format": "int64",
+          "maximum": 10000,
+          "minimum": 0
+        },
+        "rolloutPercent": {
+          "type": "integer",
+          "format": "int64",
+          "maximum": 100,
+          "minimum": 0
+        }
+      }
+    },
+    "evaluationEntity": {
+      "type": "object",
+      "required": [
+        "entityType"
+      ],
+      "properties": {
+        "entityContext": {
+          "type": "object"
+        },
+        "entityID": {
+          "type": "string"
+        },
+        "entityType": {
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "flag": {
+      "type": "object",
+      "required": [
+        "description",
+        "enabled",
+        "dataRecordsEnabled"
+      ],
+      "properties": {
+        "attachmentSchema": {
+          "description": "JSON Schema (draft 4) that the variant attachments of the flag are validated against",
+          "type": "object"
+        },
+        "bucketBy": {
+          "description": "the entityContext property to bucket the entities by instead of the entityID, e.g. company_id. The entityID is used if it's missing",
+          "type": "string"
+        },
+        "createdBy": {
+          "type": "string"
+        },
+        "dataRecordsEnabled": {
+          "description": "enabled data records will get data logging in the metrics pipeline, for example, kafka.",
+          "type": "boolean"
+        },
+        "description": {
+          "type": "string",
+          "minLength": 1
+        },
+        "enabled": {
+          "type": "boolean"
+        },
+        "hash": {
+          "description": "the hash of bucketing the entities. crc32 into 1000 buckets by default, or sha1 into 1000000 buckets for rollouts in basis points",
+          "type": "string",
+          "enum": [
+            "crc32",
+            "sha1"
+          ]
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1,
+          "readOnly": true
+        },
+        "key": {
+          "description": "unique key representation of the flag",
+          "type": "string",
+          "minLength": 1
+        },
+        "layerBucketEnd": {
+          "description": "the bucket after the last bucket of the layer allocated to the flag",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0,
+          "readOnly": true
+        },
+        "layerBucketStart": {
+          "description": "the first bucket of the layer allocated to the flag",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0,
+          "readOnly": true
+        },
+        "layerID": {
+          "description": "the layer the flag is in, 0 means none",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0,
+          "readOnly": true
+        },
+        "salt": {
+          "description": "the salt of bucketing the entities, the flagID if empty. Flags with the same salt and hash bucket an entity the same way",
+          "type": "string"
+        },
+        "segments": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/segment"
+          }
+        },
+        "stickyAssignments": {
+          "description": "the first assignment of every entity is stored and reused as long as the entity stays in the same segment, even if the distributions change",
+          "type": "boolean"
+        },
+        "updatedAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "updatedBy": {
+          "type": "string"
+        },
+        "variants": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/variant"
+          }
+        }
+      }
+    },
+    "flagAnalysis": {
+      "type": "object",
+      "required": [
+        "flagID",
+        "metric",
+        "confidenceLevel",
+        "variants"
+      ],
+      "properties": {
+        "confidenceLevel": {
+          "type": "number",
+          "format": "double"
+        },
+        "controlVariantKey": {
+          "type": "string"
+        },
+        "flagID": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "flagKey": {
+          "type": "string"
+        },
+        "metric": {
+          "type": "string",
+          "minLength": 1
+        },
+        "variants": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/variantAnalysis"
+          }
+        }
+      }
+    },
+    "flagSnapshot": {
+      "type": "object",
+      "required": [
+        "id",
+        "flag",
+        "updatedAt"
+      ],
+      "properties": {
+        "flag": {
+          "$ref": "#/definitions/flag"
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "updatedAt": {
+          "type": "string",
+          "minLength": 1
+        },
+        "updatedBy": {
+          "type": "string"
+        }
+      }
+    },
+    "flagStats": {
+      "type": "object",
+      "required": [
+        "flagID",
+        "srmDetected",
+        "segments"
+      ],
+      "properties": {
+        "flagID": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "flagKey": {
+          "type": "string"
+        },
+        "segments": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/segmentStats"
+          }
+        },
+        "srmDetected": {
+          "description": "true if any of the segments has a sample ratio mismatch",
+          "type": "boolean"
+        }
+      }
+    },
+    "idList": {
+      "type": "object",
+      "required": [
+        "id",
+        "key",
+        "size"
+      ],
+      "properties": {
+        "description": {
+          "type": "string"
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1,
+          "readOnly": true
+        },
+        "key": {
+          "description": "unique key representation of the ID list, which the IN_LIST constraints reference",
+          "type": "string",
+          "minLength": 1
+        },
+        "size": {
+          "description": "number of IDs in the list",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0
+        },
+        "updatedAt": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "layer": {
+      "type": "object",
+      "required": [
+        "id",
+        "key",
+        "flags"
+      ],
+      "properties": {
+        "description": {
+          "type": "string"
+        },
+        "flags": {
+          "description": "the flags in the layer ordered by their buckets",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/layerFlag"
+          }
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1,
+          "readOnly": true
+        },
+        "key": {
+          "description": "unique key representation of the layer",
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "layerFlag": {
+      "type": "object",
+      "required": [
+        "flagID",
+        "bucketStart",
+        "bucketEnd"
+      ],
+      "properties": {
+        "bucketEnd": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0
+        },
+        "bucketStart": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0
+        },
+        "flagID": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "flagKey": {
+          "type": "string"
+        }
+      }
+    },
+    "override": {
+      "type": "object",
+      "required": [
+        "entityType",
+        "entityID",
+        "variantID",
+        "variantKey"
+      ],
+      "properties": {
+        "entityID": {
+          "type": "string",
+          "minLength": 1
+        },
+        "entityType": {
+          "description": "the override only applies to the entities of the type, any type if empty",
+          "type": "string"
+        },
+        "expiresAt": {
+          "description": "the override is ignored after it expires, it never expires if empty",
+          "type": "string",
+          "format": "date-time"
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1,
+          "readOnly": true
+        },
+        "variantID": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "variantKey": {
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "postConversionEventsRequest": {
+      "type": "object",
+      "required": [
+        "events"
+      ],
+      "properties": {
+        "events": {
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "$ref": "#/definitions/conversionEvent"
+          }
+        }
+      }
+    },
+    "putAudienceRequest": {
+      "type": "object",
+      "properties": {
+        "constraints": {
+          "description": "replaces all the constraints of the audience if present",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/createConstraintRequest"
+          }
+        },
+        "description": {
+          "type": "string",
+          "x-nullable": true
+        }
+      }
+    },
+    "putDistributionsRequest": {
+      "type": "object",
+      "required": [
+        "distributions"
+      ],
+      "properties": {
+        "distributions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/distribution"
+          }
+        }
+      }
+    },
+    "putFlagRequest": {
+      "type": "object",
+      "required": [
+        "description"
+      ],
+      "properties": {
+        "attachmentSchema": {
+          "description": "JSON Schema (draft 4) that the variant attachments of the flag are validated against, an empty object removes it",
+          "type": "object"
+        },
+        "bucketBy": {
+          "description": "the entityContext property to bucket the entities by instead of the entityID, an empty string resets it to the entityID",
+          "type": "string",
+          "x-nullable": true
+        },
+        "dataRecordsEnabled": {
+          "description": "enabled data records will get data logging in the metrics pipeline, for example, kafka.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "description": {
+          "type": "string",
+          "minLength": 1
+        },
+        "hash": {
+          "description": "the hash of bucketing the entities, crc32 or sha1. Changing it re-buckets all the entities",
+          "type": "string",
+          "enum": [
+            "crc32",
+            "sha1"
+          ],
+          "x-nullable": true
+        },
+        "key": {
+          "type": "string",
+          "x-nullable": true
+        },
+        "salt": {
+          "description": "the salt of bucketing the entities, an empty string resets it to the flagID. Changing it re-buckets all the entities",
+          "type": "string",
+          "x-nullable": true
+        },
+        "stickyAssignments": {
+          "description": "the first assignment of every entity is stored and reused as long as the entity stays in the same segment, even if the distributions change",
+          "type": "boolean",
+          "x-nullable": true
+        }
+      }
+    },
+    "putIDListIDsRequest": {
+      "type": "object",
+      "required": [
+        "ids"
+      ],
+      "properties": {
+        "ids": {
+          "description": "the IDs separated by newlines or commas, e.g. the content of a CSV file. They replace all the IDs of the list",
+          "type": "string"
+        }
+      }
+    },
+    "putIDListRequest": {
+      "type": "object",
+      "properties": {
+        "description": {
+          "type": "string",
+          "x-nullable": true
+        }
+      }
+    },
+    "putLayerRequest": {
+      "type": "object",
+      "properties": {
+        "description": {
+          "type": "string",
+          "x-nullable": true
+        }
+      }
+    },
+    "putOverrideRequest": {
+      "type": "object",
+      "required": [
+        "variantKey"
+      ],
+      "properties": {
+        "expiresAt": {
+          "description": "the override is ignored after it expires, it never expires if empty",
+          "type": "string",
+          "format": "date-time"
+        },
+        "variantKey": {
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "putSegmentReorderRequest": {
+      "type": "object",
+      "required": [
+        "segmentIDs"
+      ],
+      "properties": {
+        "segmentIDs": {
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "type": "integer",
+            "format": "int64",
+            "minimum": 1
+          }
+        }
+      }
+    },
+    "putSegmentRequest": {
+      "type": "object",
+      "required": [
+        "description",
+        "rolloutPercent"
+      ],
+      "properties": {
+        "audienceID": {
+          "description": "the audience whose constraints the entities must match besides the constraints of the segment, 0 means none. It's kept if absent",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0,
+          "x-nullable": true
+        },
+        "constraintGroup": {
+          "description": "the boolean group of the constraints, it's kept if absent and removed if empty",
+          "$ref": "#/definitions/constraintGroup"
+        },
+        "description": {
+          "type": "string",
+          "minLength": 1
+        },
+        "rolloutBasisPoints": {
+          "description": "the rollout in basis points (0.01%), it overrides rolloutPercent if it's not 0. It's kept if absent, unless rolloutPercent is changed",
+          "type": "integer",
+          "format": "int64",
+          "maximum": 10000,
+          "minimum": 0,
+          "x-nullable": true
+        },
+        "rolloutPercent": {
+          "type": "integer",
+          "format": "int64",
+          "maximum": 100,
+          "minimum": 0
+        }
+      }
+    },
+    "putVariantRequest": {
+      "type": "object",
+      "required": [
+        "key",
+        "attachment"
+      ],
+      "properties": {
+        "attachment": {
+          "type": "object"
+        },
+        "key": {
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "putWebhookRequest": {
+      "type": "object",
+      "properties": {
+        "enabled": {
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "events": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "flagKeys": {
+          "description": "the flags to subscribe, empty means all the flags",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "format": {
+          "description": "json posts the flag event, slack posts a human-readable message to a chat incoming webhook. json by default",
+          "type": "string",
+          "enum": [
+            "json",
+            "slack"
+          ],
+          "x-nullable": true
+        },
+        "secret": {
+          "type": "string",
+          "x-nullable": true
+        },
+        "url": {
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": true
+        }
+      }
+    },
+    "segment": {
+      "type": "object",
+      "required": [
+        "description",
+        "rank",
+        "rolloutPercent"
+      ],
+      "properties": {
+        "audienceID": {
+          "description": "the audience whose constraints the entities must match besides the constraints of the segment, 0 means none",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0
+        },
+        "constraintGroup": {
+          "$ref": "#/definitions/constraintGroup"
+        },
+        "constraints": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/constraint"
+          }
+        },
+        "description": {
+          "type": "string",
+          "minLength": 1
+        },
+        "distributions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/distribution"
+          }
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1,
+          "readOnly": true
+        },
+        "rank": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0
+        },
+        "rolloutBasisPoints": {
+          "description": "the rollout in basis points (0.01%), it overrides rolloutPercent if it's not 0",
+          "type": "integer",
+          "format": "int64",
+          "maximum": 10000,
+          "minimum": 0
+        },
+        "rolloutPercent": {
+          "type": "integer",
+          "format": "int64",
+          "maximum": 100,
+          "minimum": 0
+        }
+      }
+    },
+    "segmentDebugLog": {
+      "type": "object",
+      "properties": {
+        "msg": {
+          "type": "string"
+        },
+        "segmentID": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        }
+      }
+    },
+    "segmentStats": {
+      "type": "object",
+      "required": [
+        "segmentID",
+        "totalCount",
+        "pValue",
+        "srmDetected",
+        "variants"
+      ],
+      "properties": {
+        "chiSquared": {
+          "description": "chi-squared statistic of the observed counts against the distribution percents",
+          "type": "number",
+          "format": "double"
+        },
+        "pValue": {
+          "type": "number",
+          "format": "double"
+        },
+        "segmentID": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "srmDetected": {
+          "description": "true if pValue is below the SRM threshold and totalCount reaches the minimum sample size",
+          "type": "boolean"
+        },
+        "totalCount": {
+          "description": "number of evaluations in the segment that got assigned to a variant",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0
+        },
+        "variants": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/variantStats"
+          }
+        }
+      }
+    },
+    "setFlagEnabledRequest": {
+      "type": "object",
+      "required": [
+        "enabled"
+      ],
+      "properties": {
+        "enabled": {
+          "type": "boolean"
+        }
+      }
+    },
+    "setFlagLayerRequest": {
+      "type": "object",
+      "required": [
+        "layerID"
+      ],
+      "properties": {
+        "layerID": {
+          "description": "the layer to join, 0 leaves the current layer",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0
+        },
+        "percent": {
+          "description": "percent of the layer's buckets allocated to the flag, the entities bucketed out of them get no variant of the flag",
+          "type": "integer",
+          "format": "int64",
+          "maximum": 100,
+          "minimum": 1
+        }
+      }
+    },
+    "variant": {
+      "type": "object",
+      "required": [
+        "key"
+      ],
+      "properties": {
+        "attachment": {
+          "type": "object"
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1,
+          "readOnly": true
+        },
+        "key": {
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "variantAnalysis": {
+      "type": "object",
+      "required": [
+        "variantID",
+        "variantKey",
+        "assignedCount",
+        "convertedCount",
+        "significant"
+      ],
+      "properties": {
+        "assignedCount": {
+          "description": "number of entities assigned to the variant",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0
+        },
+        "conversionRate": {
+          "type": "number",
+          "format": "double"
+        },
+        "conversionRateLower": {
+          "description": "lower bound of the Wilson score interval of conversionRate",
+          "type": "number",
+          "format": "double"
+        },
+        "conversionRateUpper": {
+          "description": "upper bound of the Wilson score interval of conversionRate",
+          "type": "number",
+          "format": "double"
+        },
+        "convertedCount": {
+          "description": "number of assigned entities having the metric after the assignment",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0
+        },
+        "lift": {
+          "description": "relative difference of conversionRate against the control variant",
+          "type": "number",
+          "format": "double"
+        },
+        "pValue": {
+          "description": "two-sided p-value of the two-proportion z-test against the control variant",
+          "type": "number",
+          "format": "double"
+        },
+        "significant": {
+          "description": "true if pValue is below 1 - confidenceLevel",
+          "type": "boolean"
+        },
+        "valueMean": {
+          "description": "valueSum divided by assignedCount",
+          "type": "number",
+          "format": "double"
+        },
+        "valueSum": {
+          "type": "number",
+          "format": "double"
+        },
+        "variantID": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "variantKey": {
+          "type": "string"
+        }
+      }
+    },
+    "variantStats": {
+      "type": "object",
+      "required": [
+        "variantID",
+        "variantKey",
+        "expectedPercent",
+        "observedCount"
+      ],
+      "properties": {
+        "expectedCount": {
+          "type": "number",
+          "format": "double"
+        },
+        "expectedPercent": {
+          "type": "integer",
+          "format": "int64",
+          "maximum": 100,
+          "minimum": 0
+        },
+        "observedCount": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0
+        },
+        "variantID": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "variantKey": {
+          "type": "string"
+        }
+      }
+    },
+    "webhook": {
+      "type": "object",
+      "required": [
+        "id",
+        "url",
+        "events",
+        "enabled"
+      ],
+      "properties": {
+        "enabled": {
+          "type": "boolean"
+        },
+        "events": {
+          "description": "the events to subscribe, empty means all the events. flag.created, flag.updated, flag.enabled, flag.disabled or flag.deleted",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "flagKeys": {
+          "description": "the flags to subscribe, empty means all the flags",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "format": {
+          "description": "json posts the flag event, slack posts a human-readable message to a chat incoming webhook. json by default",
+          "type": "string",
+          "enum": [
+            "json",
+            "slack"
+          ]
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1,
+          "readOnly": true
+        },
+        "secretConfigured": {
+          "description": "true if the payloads are signed with a secret",
+          "type": "boolean"
+        },
+        "url": {
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "webhookDelivery": {
+      "type": "object",
+      "required": [
+        "id",
+        "webhookID",
+        "event",
+        "status",
+        "attempts"
+      ],
+      "properties": {
+        "attempts": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "event": {
+          "type": "string"
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "lastError": {
+          "type": "string"
+        },
+        "nextAttemptAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "payload": {
+          "type": "string"
+        },
+        "responseCode": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "pending",
+            "succeeded",
+            "failed"
+          ]
+        },
+        "webhookID": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        }
+      }
+    }
+  },
+  "tags": [
+    {
+      "description": "Everything about the flag",
+      "name": "flag"
+    },
+    {
+      "description": "Segment defines the audience of the flag, it's the user segmentation",
+      "name": "segment"
+    },
+    {
+      "description": "Constraint is the unit of defining a small subset of users",
+      "name": "constraint"
+    },
+    {
+      "description": "Distribution is the percent distribution of variants within that segment",
+      "name": "distribution"
+    },
+    {
+      "description": "Variants are the possible outcomes of flag evaluation",
+      "name": "variant"
+    },
+    {
+      "description": "Evaluation is the process of evaluating a flag given the entity context",
+      "name": "evaluation"
+    },
+    {
+      "description": "Check if Flagr is healthy",
+      "name": "health"
+    },
+    {
+      "description": "Analysis compares the conversion events between the variants of a flag",
+      "name": "analysis"
+    },
+    {
+      "description": "Webhook notifies the external systems of the flag changes",
+      "name": "webhook"
+    },
+    {
+      "description": "Override forces the variant of an entity, e.g. for QA",
+      "name": "override"
+    },
+    {
+      "description": "Audience is a reusable set of constraints shared by the segments of many flags",
+      "name": "audience"
+    },
+    {
+      "description": "ID list is a named list of IDs, e.g. account IDs, that the IN_LIST constraints match against",
+      "name": "idlist"
+    },
+    {
+      "description": "Layer is a mutual exclusion group of flags, an entity gets in at most one flag of a layer",
+      "name": "layer"
+    }
+  ],
+  "x-tagGroups": [
+    {
+      "name": "Flag Management",
+      "tags": [
+        "flag",
+        "segment",
+        "constraint",
+        "distribution",
+        "variant",
+        "override",
+        "audience",
+        "idlist",
+        "layer"
+      ]
+    },
+    {
+      "name": "Flag Evaluation",
+      "tags": [
+        "evaluation"
+      ]
+    },
+    {
+      "name": "Experiment Analysis",
+      "tags": [
+        "analysis"
+      ]
+    },
+    {
+      "name": "Webhook",
+      "tags": [
+        "webhook"
+      ]
+    },
+    {
+      "name": "Health Check",
+      "tags": [
+        "health"
+      ]
+    },
+    {
+      "name": "Export",
+      "tags": [
+        "export"
+      ]
+    }
+  ]
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "consumes": [
+    "application/json"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "schemes": [
+    "http"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "description": "Flagr is a feature flagging, A/B testing and dynamic configuration microservice",
+    "title": "Flagr",
+    "version": "1.0.10"
+  },
+  "basePath": "/api/v1",
+  "paths": {
+    "/audiences": {
+      "get": {
+        "tags": [
+          "audience"
+        ],
+        "operationId": "findAudiences",
+        "responses": {
+          "200": {
+            "description": "list all the audiences",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/audience"
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "audience"
+        ],
+        "operationId": "createAudience",
+        "parameters": [
+          {
+            "description": "create an audience",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createAudienceRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the created audience",
+            "schema": {
+              "$ref": "#/definitions/audience"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/audiences/{audienceID}": {
+      "get": {
+        "tags": [
+          "audience"
+        ],
+        "operationId": "getAudience",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the audience",
+            "name": "audienceID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the audience with the segments using it",
+            "schema": {
+              "$ref": "#/definitions/audience"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "audience"
+        ],
+        "operationId": "putAudience",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the audience",
+            "name": "audienceID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "update an audience",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putAudienceRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the audience just updated",
+            "schema": {
+              "$ref": "#/definitions/audience"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "audience"
+        ],
+        "operationId": "deleteAudience",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the audience",
+            "name": "audienceID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "deleted, an audience still used by segments cannot be deleted"
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/conversions": {
+      "post": {
+        "description": "ingest the conversion events of the entities for the experiment analysis",
+        "tags": [
+          "analysis"
+        ],
+        "operationId": "postConversionEvents",
+        "parameters": [
+          {
+            "description": "conversion events",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/postConversionEventsRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "conversion events are ingested"
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/evaluation": {
+      "post": {
+        "tags": [
+          "evaluation"
+        ],
+        "operationId": "postEvaluation",
+        "parameters": [
+          {
+            "description": "evalution context",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/evalContext"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "evaluation result",
+            "schema": {
+              "$ref": "#/definitions/evalResult"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/evaluation/batch": {
+      "post": {
+        "tags": [
+          "evaluation"
+        ],
+        "operationId": "postEvaluationBatch",
+        "parameters": [
+          {
+            "description": "evalution batch request",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/evaluationBatchRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "evaluation batch result",
+            "schema": {
+              "$ref": "#/definitions/evaluationBatchResponse"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/evaluation/batch/compact": {
+      "post": {
+        "description": "Evaluates the flags like postEvaluationBatch, but returns only the variant key and attachment of each flag, keyed by the flagKey for each entity. It's the compact mode of postEvaluationBatch, served as its own operation for the same reason as postEvaluationCompact",
+        "tags": [
+          "evaluation"
+        ],
+        "operationId": "postEvaluationBatchCompact",
+        "parameters": [
+          {
+            "description": "evalution batch request",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/evaluationBatchRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "compact evaluation batch result",
+            "schema": {
+              "$ref": "#/definitions/evaluationBatchCompactResponse"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/evaluation/compact": {
+      "post": {
+        "description": "Evaluates the flag like postEvaluation, but returns only the variant key and attachment of the flag, keyed by the flagKey. It's the compact mode of postEvaluation, served as its own operation rather than a query option, since a Swagger 2.0 operation can only declare one schema for its 200 response and the generated clients need to know which one they get",
+        "tags": [
+          "evaluation"
+        ],
+        "operationId": "postEvaluationCompact",
+        "parameters": [
+          {
+            "description": "evalution context",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/evalContext"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "compact evaluation result",
+            "schema": {
+              "$ref": "#/definitions/evaluationCompactResponse"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/evaluation/config": {
+      "get": {
+        "description": "Returns the prepared evaluation config of the flags, so that clients can evaluate them locally. An entity is bucketed by crc32(salt + entityID) % 1000 against the accumulated distribution percents of the segment, the same way as the server side evaluation. The ETag header can be sent back in If-None-Match to get a 304 when nothing has changed.",
+        "tags": [
+          "evaluation"
+        ],
+        "operationId": "getEvaluationConfig",
+        "parameters": [
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "csv",
+            "description": "return the config of the flags matching the given keys",
+            "name": "flagKeys",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "integer",
+              "format": "int64"
+            },
+            "collectionFormat": "csv",
+            "description": "return the config of the flags matching the given IDs",
+            "name": "flagIDs",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the evaluation config of the flags",
+            "schema": {
+              "$ref": "#/definitions/evaluationConfig"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "the entity tag of the evaluation config"
+              }
+            }
+          },
+          "304": {
+            "description": "the evaluation config matches If-None-Match"
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/evaluation/flags/{flagKey}": {
+      "get": {
+        "description": "Evaluates the flag for simple clients and edge caches. Query parameters prefixed with ctx. are mapped into the entity context, e.g. ctx.country=US, and numbers and booleans are inferred from their values. Results that don't depend on the entity context come with a cacheable Cache-Control header.",
+        "tags": [
+          "evaluation"
+        ],
+        "operationId": "getEvaluation",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "key or numeric ID of the flag",
+            "name": "flagKey",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "entityID of the evaluation, flagr will randomly generate one if it's empty",
+            "name": "entityID",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "entityType of the evaluation",
+            "name": "entityType",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "return the debug logs of the evaluation",
+            "name": "enableDebug",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "evaluation result",
+            "schema": {
+              "$ref": "#/definitions/evalResult"
+            },
+            "headers": {
+              "Cache-Control": {
+                "type": "string",
+                "description": "whether the evaluation result is cacheable"
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/export/sqlite": {
+      "get": {
+        "description": "Export sqlite3 format of the db dump, which is converted from the main database.",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "export"
+        ],
+        "operationId": "getExportSQLite",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags": {
+      "get": {
+        "tags": [
+          "flag"
+        ],
+        "operationId": "findFlags",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "the numbers of flags to return",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "return flags having given enabled status",
+            "name": "enabled",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "return flags exactly matching given description",
+            "name": "description",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "return flags partially matching given description",
+            "name": "description_like",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "return flags matching given key",
+            "name": "key",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "return flags given the offset, it should usually set together with limit",
+            "name": "offset",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "list all the flags",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/flag"
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "flag"
+        ],
+        "operationId": "createFlag",
+        "parameters": [
+          {
+            "description": "create a flag",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createFlagRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the created flag",
+            "schema": {
+              "$ref": "#/definitions/flag"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags/stream": {
+      "get": {
+        "description": "Server-Sent Events stream of the flag changes picked up by the evaluation cache. Every flag-updated event carries the new flag config with the snapshot ID as the event ID, and a flag-deleted event is sent when a flag is deleted. Reconnect with the Last-Event-ID header to resume, and comments are sent periodically as keepalives. The server closes the stream after FLAGR_FLAG_STREAM_MAX_DURATION (50s by default), so that it ends between two events before the server write timeout cuts it. Clients are expected to reconnect right away with the Last-Event-ID, which EventSource does by itself.",
+        "produces": [
+          "text/event-stream"
+        ],
+        "tags": [
+          "flag"
+        ],
+        "operationId": "getFlagsStream",
+        "responses": {
+          "200": {
+            "description": "the stream of the flag change events"
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags/{flagID}": {
+      "get": {
+        "tags": [
+          "flag"
+        ],
+        "operationId": "getFlag",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag to get",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the flag",
+            "schema": {
+              "$ref": "#/definitions/flag"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "flag"
+        ],
+        "operationId": "putFlag",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag to get",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "update a flag",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putFlagRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the flag",
+            "schema": {
+              "$ref": "#/definitions/flag"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "flag"
+        ],
+        "operationId": "deleteFlag",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK deleted"
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags/{flagID}/analysis": {
+      "get": {
+        "description": "compare the conversion of the metric between the variants of the flag",
+        "tags": [
+          "analysis"
+        ],
+        "operationId": "getFlagAnalysis",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minLength": 1,
+            "type": "string",
+            "description": "metric name of the conversion events",
+            "name": "metric",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "the variant to compare with, defaults to the first variant of the flag",
+            "name": "controlVariantKey",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the analysis of the flag",
+            "schema": {
+              "$ref": "#/definitions/flagAnalysis"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags/{flagID}/assignments": {
+      "delete": {
+        "description": "clear the sticky assignments of the flag, the entities get assigned again in the next evaluations",
+        "tags": [
+          "flag"
+        ],
+        "operationId": "deleteFlagAssignments",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "clear the assignment of the entity only, all the assignments of the flag if empty",
+            "name": "entityID",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "cleared"
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags/{flagID}/enabled": {
+      "put": {
+        "tags": [
+          "flag"
+        ],
+        "operationId": "setFlagEnabled",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag to get",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "set flag enabled state",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/setFlagEnabledRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the flag",
+            "schema": {
+              "$ref": "#/definitions/flag"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags/{flagID}/layer": {
+      "put": {
+        "tags": [
+          "layer"
+        ],
+        "operationId": "setFlagLayer",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "join the flag to a layer with a percent of the layer's buckets, or leave the layer with layerID 0",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/setFlagLayerRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the flag",
+            "schema": {
+              "$ref": "#/definitions/flag"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags/{flagID}/overrides": {
+      "get": {
+        "tags": [
+          "override"
+        ],
+        "operationId": "findOverrides",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "overrides ordered by overrideID",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/override"
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "override"
+        ],
+        "operationId": "createOverride",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "create an override",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createOverrideRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "override just created",
+            "schema": {
+              "$ref": "#/definitions/override"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags/{flagID}/overrides/{overrideID}": {
+      "put": {
+        "tags": [
+          "override"
+        ],
+        "operationId": "putOverride",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the override",
+            "name": "overrideID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "update an override",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putOverrideRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "override just updated",
+            "schema": {
+              "$ref": "#/definitions/override"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "override"
+        ],
+        "operationId": "deleteOverride",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the override",
+            "name": "overrideID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "deleted"
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "distribution": {
-      "type": "object",
-      "required": [
-        "percent",
-        "variantID",
-        "variantKey"
-      ],
-      "properties": {
-        "bitmap": {
-          "type": "string",
-          "minLength": 1
-        },
-        "id": {
-          "type": "integer",
-          "format": "int64",
-          "minimum": 1,
-          "readOnly": true
-        },
-        "percent": {
-          "type": "integer",
-          "format": "int64",
-          "maximum": 100,
-          "minimum": 0
-        },
-        "variantID": {
-          "type": "integer",
-          "format": "int64",
-          "minimum": 1
-        },
-        "variantKey": {
-          "type": "string",
-          "minLength": 1
+    "/flags/{flagID}/segments": {
+      "get": {
+        "tags": [
+          "segment"
+        ],
+        "operationId": "findSegments",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag to get",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "segments ordered by rank of the flag",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/segment"
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "segment"
+        ],
+        "operationId": "createSegment",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag to get",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "create a segment under a flag",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createSegmentRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "segment created",
+            "schema": {
+              "$ref": "#/definitions/segment"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "error": {
-      "type": "object",
-      "required": [
-        "message"
-      ],
-      "properties": {
-        "message": {
-          "type": "string",
-          "minLength": 1
+    "/flags/{flagID}/segments/reorder": {
+      "put": {
+        "tags": [
+          "segment"
+        ],
+        "operationId": "putSegmentsReorder",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "reorder segments",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putSegmentReorderRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "segments reordered"
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "evalContext": {
-      "type": "object",
-      "required": [
-        "entityType"
-      ],
-      "properties": {
-        "enableDebug": {
-          "type": "boolean"
-        },
-        "entityContext": {
-          "type": "object"
-        },
-        "entityID": {
-          "description": "entityID is used to deterministically at random to evaluate the flag result. If it's empty, flagr will randomly generate one.",
-          "type": "string"
-        },
-        "entityType": {
-          "type": "string",
-          "minLength": 1
-        },
-        "flagID": {
-          "description": "flagID",
-          "type": "integer",
-          "format": "int64",
-          "minimum": 1
-        },
-        "flagKey": {
-          "description": "flagKey. flagID or flagKey will resolve to the same flag. Either works.",
-          "type": "string"
+    "/flags/{flagID}/segments/{segmentID}": {
+      "put": {
+        "tags": [
+          "segment"
+        ],
+        "operationId": "putSegment",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "update a segment",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putSegmentRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "segment updated",
+            "schema": {
+              "$ref": "#/definitions/segment"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
-      }
-    },
-    "evalDebugLog": {
-      "type": "object",
-      "properties": {
-        "msg": {
-          "type": "string"
-        },
-        "segmentDebugLogs": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/segmentDebugLog"
+      },
+      "delete": {
+        "tags": [
+          "segment"
+        ],
+        "operationId": "deleteSegment",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "deleted"
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "evalResult": {
-      "type": "object",
-      "required": [
-        "flagID",
-        "flagKey",
-        "segmentID",
-        "variantID",
-        "variantKey",
-        "variantAttachment",
-        "evalContext",
-        "timestamp"
-      ],
-      "properties": {
-        "evalContext": {
-          "$ref": "#/definitions/evalContext"
-        },
-        "evalDebugLog": {
-          "$ref": "#/definitions/evalDebugLog"
-        },
-        "flagID": {
-          "type": "integer",
-          "format": "int64",
-          "minimum": 1
-        },
-        "flagKey": {
-          "type": "string"
-        },
-        "flagSnapshotID": {
-          "type": "integer",
-          "format": "int64"
-        },
-        "segmentID": {
-          "type": "integer",
-          "format": "int64",
-          "minimum": 1
-        },
-        "timestamp": {
-          "type": "string",
-          "minLength": 1
-        },
-        "variantAttachment": {
-          "type": "object"
-        },
-        "variantID": {
-          "type": "integer",
-          "format": "int64",
-          "minimum": 1
-        },
-        "variantKey": {
-          "type": "string",
-          "minLength": 1
+    "/flags/{flagID}/segments/{segmentID}/constraints": {
+      "get": {
+        "tags": [
+          "constraint"
+        ],
+        "operationId": "findConstraints",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "constraints under the segment",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/constraint"
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "constraint"
+        ],
+        "operationId": "createConstraint",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "create a constraint",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createConstraintRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the constraint created",
+            "schema": {
+              "$ref": "#/definitions/constraint"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "evaluationBatchRequest": {
-      "type": "object",
-      "required": [
-        "entities"
-      ],
-      "properties": {
-        "enableDebug": {
-          "type": "boolean"
-        },
-        "entities": {
-          "type": "array",
-          "minItems": 1,
-          "items": {
-            "$ref": "#/definitions/evaluationEntity"
-          }
-        },
-        "flagIDs": {
-          "description": "flagIDs",
-          "type": "array",
-          "minItems": 1,
-          "items": {
+    "/flags/{flagID}/segments/{segmentID}/constraints/{constraintID}": {
+      "put": {
+        "tags": [
+          "constraint"
+        ],
+        "operationId": "putConstraint",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "minimum": 1
-          }
-        },
-        "flagKeys": {
-          "description": "flagKeys. Either flagIDs or flagKeys works. If pass in both, Flagr may return duplicate results.",
-          "type": "array",
-          "minItems": 1,
-          "items": {
-            "type": "string",
-            "minLength": 1
+            "description": "numeric ID of the constraint",
+            "name": "constraintID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "create a constraint",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createConstraintRequest"
+            }
           }
-        }
-      }
-    },
-    "evaluationBatchResponse": {
-      "type": "object",
-      "required": [
-        "evaluationResults"
-      ],
-      "properties": {
-        "evaluationResults": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/evalResult"
+        ],
+        "responses": {
+          "200": {
+            "description": "constraint just updated",
+            "schema": {
+              "$ref": "#/definitions/constraint"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
-      }
-    },
-    "evaluationEntity": {
-      "type": "object",
-      "required": [
-        "entityType"
-      ],
-      "properties": {
-        "entityContext": {
-          "type": "object"
-        },
-        "entityID": {
-          "type": "string"
-        },
-        "entityType": {
-          "type": "string",
-          "minLength": 1
-        }
-      }
-    },
-    "flag": {
-      "type": "object",
-      "required": [
-        "description",
-        "enabled",
-        "dataRecordsEnabled"
-      ],
-      "properties": {
-        "createdBy": {
-          "type": "string"
-        },
-        "dataRecordsEnabled": {
-          "description": "enabled data records will get data logging in the metrics pipeline, for example, kafka.",
-          "type": "boolean"
-        },
-        "description": {
-          "type": "string",
-          "minLength": 1
-        },
-        "enabled": {
-          "type": "boolean"
-        },
-        "id": {
-          "type": "integer",
-          "format": "int64",
-          "minimum": 1,
-          "readOnly": true
-        },
-        "key": {
-          "description": "unique key representation of the flag",
-          "type": "string",
-          "minLength": 1
-        },
-        "segments": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/segment"
+      },
+      "delete": {
+        "tags": [
+          "constraint"
+        ],
+        "operationId": "deleteConstraint",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the constraint",
+            "name": "constraintID",
+            "in": "path",
+            "required": true
           }
-        },
-        "updatedAt": {
-          "type": "string",
-          "format": "date-time"
-        },
-        "updatedBy": {
-          "type": "string"
-        },
-        "variants": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/variant"
+        ],
+        "responses": {
+          "200": {
+            "description": "deleted"
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "flagSnapshot": {
-      "type": "object",
-      "required": [
-        "id",
-        "flag",
-        "updatedAt"
-      ],
-      "properties": {
-        "flag": {
-          "$ref": "#/definitions/flag"
-        },
-        "id": {
-          "type": "integer",
-          "format": "int64",
-          "minimum": 1
-        },
-        "updatedAt": {
-          "type": "string",
-          "minLength": 1
-        },
-        "updatedBy": {
-          "type": "string"
-        }
-      }
-    },
-    "putDistributionsRequest": {
-      "type": "object",
-      "required": [
-        "distributions"
-      ],
-      "properties": {
-        "distributions": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/distribution"
+    "/flags/{flagID}/segments/{segmentID}/distributions": {
+      "get": {
+        "tags": [
+          "distribution"
+        ],
+        "operationId": "findDistributions",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "distribution under the segment",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/distribution"
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
-      }
-    },
-    "putFlagRequest": {
-      "type": "object",
-      "required": [
-        "description"
-      ],
-      "properties": {
-        "dataRecordsEnabled": {
-          "description": "enabled data records will get data logging in the metrics pipeline, for example, kafka.",
-          "type": "boolean",
-          "x-nullable": true
-        },
-        "description": {
-          "type": "string",
-          "minLength": 1
-        },
-        "key": {
-          "type": "string",
-          "x-nullable": true
-        }
-      }
-    },
-    "putSegmentReorderRequest": {
-      "type": "object",
-      "required": [
-        "segmentIDs"
-      ],
-      "properties": {
-        "segmentIDs": {
-          "type": "array",
-          "minItems": 1,
-          "items": {
+      },
+      "put": {
+        "description": "replace the distribution with the new setting",
+        "tags": [
+          "distribution"
+        ],
+        "operationId": "putDistributions",
+        "parameters": [
+          {
+            "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "minimum": 1
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "array of distributions",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putDistributionsRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "distribution under the segment",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/distribution"
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "putSegmentRequest": {
-      "type": "object",
-      "required": [
-        "description",
-        "rolloutPercent"
-      ],
-      "properties": {
-        "description": {
-          "type": "string",
-          "minLength": 1
-        },
-        "rolloutPercent": {
-          "type": "integer",
-          "format": "int64",
-          "maximum": 100,
-          "minimum": 0
+    "/flags/{flagID}/snapshots": {
+      "get": {
+        "tags": [
+          "flag"
+        ],
+        "operationId": "getFlagSnapshots",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag to get",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the flag snapshots",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/flagSnapshot"
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "putVariantRequest": {
-      "type": "object",
-      "required": [
-        "key",
-        "attachment"
-      ],
-      "properties": {
-        "attachment": {
-          "type": "object"
-        },
-        "key": {
-          "type": "string",
-          "minLength": 1
+    "/flags/{flagID}/stats": {
+      "get": {
+        "description": "returns the observed variant assignment counts of the flag per segment, and the result of the sample ratio mismatch (SRM) check against the distribution percents",
+        "tags": [
+          "flag"
+        ],
+        "operationId": "getFlagStats",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag to get",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the flag stats",
+            "schema": {
+              "$ref": "#/definitions/flagStats"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "segment": {
-      "type": "object",
-      "required": [
-        "description",
-        "rank",
-        "rolloutPercent"
-      ],
-      "properties": {
-        "constraints": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/constraint"
+    "/flags/{flagID}/variants": {
+      "get": {
+        "tags": [
+          "variant"
+        ],
+        "operationId": "findVariants",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
           }
-        },
-        "description": {
-          "type": "string",
-          "minLength": 1
-        },
-        "distributions": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/distribution"
+        ],
+        "responses": {
+          "200": {
+            "description": "variant ordered by variantID",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/variant"
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "variant"
+        ],
+        "operationId": "createVariant",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "create a variant",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createVariantRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "variant just created",
+            "schema": {
+              "$ref": "#/definitions/variant"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "id": {
-          "type": "integer",
-          "format": "int64",
-          "minimum": 1,
-          "readOnly": true
-        },
-        "rank": {
-          "type": "integer",
-          "format": "int64",
-          "minimum": 0
-        },
-        "rolloutPercent": {
-          "type": "integer",
-          "format": "int64",
-          "maximum": 100,
-          "minimum": 0
         }
       }
     },
-    "segmentDebugLog": {
-      "type": "object",
-      "properties": {
-        "msg": {
-          "type": "string"
-        },
-        "segmentID": {
-          "type": "integer",
-          "format": "int64",
-          "minimum": 1
+    "/flags/{flagID}/variants/{variantID}": {
+      "put": {
+        "tags": [
+          "variant"
+        ],
+        "operationId": "putVariant",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the variant",
+            "name": "variantID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "update a variant",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putVariantRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "variant just updated",
+            "schema": {
+              "$ref": "#/definitions/variant"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
-      }
-    },
-    "setFlagEnabledRequest": {
-      "type": "object",
-      "required": [
-        "enabled"
-      ],
-      "properties": {
-        "enabled": {
-          "type": "boolean"
+      },
+      "delete": {
+        "tags": [
+          "variant"
+        ],
+        "operationId": "deleteVariant",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the variant",
+            "name": "variantID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "deleted"
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "variant": {
-      "type": "object",
-      "required": [
-        "key"
-      ],
-      "properties": {
-        "attachment": {
-          "type": "object"
-        },
-        "id": {
-          "type": "integer",
-          "format": "int64",
-          "minimum": 1,
-          "readOnly": true
-        },
-        "key": {
-          "type": "string",
-          "minLength": 1
+    "/health": {
+      "get": {
+        "description": "Check if Flagr is healthy",
+        "tags": [
+          "health"
+        ],
+        "operationId": "getHealth",
+        "responses": {
+          "200": {
+            "description": "OK"
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
-    }
-  },
-  "tags": [
-    {
-      "description": "Everything about the flag",
-      "name": "flag"
-    },
-    {
-      "description": "Segment defines the audience of the flag, it's the user segmentation",
-      "name": "segment"
-    },
-    {
-      "description": "Constraint is the unit of defining a small subset of users",
-      "name": "constraint"
-    },
-    {
-      "description": "Distribution is the percent distribution of variants within that segment",
-      "name": "distribution"
-    },
-    {
-      "description": "Variants are the possible outcomes of flag evaluation",
-      "name": "variant"
-    },
-    {
-      "description": "Evaluation is the process of evaluating a flag given the entity context",
-      "name": "evaluation"
-    },
-    {
-      "description": "Check if Flagr is healthy",
-      "name": "health"
-    }
-  ],
-  "x-tagGroups": [
-    {
-      "name": "Flag Management",
-      "tags": [
-        "flag",
-        "segment",
-        "constraint",
-        "distribution",
-        "variant"
-      ]
-    },
-    {
-      "name": "Flag Evaluation",
-      "tags": [
-        "evaluation"
-      ]
     },
-    {
-      "name": "Health Check",
-      "tags": [
-        "health"
-      ]
-    },
-    {
-      "name": "Export",
-      "tags": [
-        "export"
-      ]
-    }
-  ]
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "consumes": [
-    "application/json"
-  ],
-  "produces": [
-    "application/json"
-  ],
-  "schemes": [
-    "http"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "description": "Flagr is a feature flagging, A/B testing and dynamic configuration microservice",
-    "title": "Flagr",
-    "version": "1.0.10"
-  },
-  "basePath": "/api/v1",
-  "paths": {
-    "/evaluation": {
+    "/id_lists": {
+      "get": {
+        "tags": [
+          "idlist"
+        ],
+        "operationId": "findIDLists",
+        "responses": {
+          "200": {
+            "description": "list all the ID lists without their IDs",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/idList"
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
       "post": {
         "tags": [
-          "evaluation"
+          "idlist"
+        ],
+        "operationId": "createIDList",
+        "parameters": [
+          {
+            "description": "create an ID list",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createIDListRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the created ID list",
+            "schema": {
+              "$ref": "#/definitions/idList"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/id_lists/{idListID}": {
+      "get": {
+        "tags": [
+          "idlist"
         ],
-        "operationId": "postEvaluation",
+        "operationId": "getIDList",
         "parameters": [
           {
-            "description": "evalution context",
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the ID list",
+            "name": "idListID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the ID list without its IDs",
+            "schema": {
+              "$ref": "#/definitions/idList"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "idlist"
+        ],
+        "operationId": "putIDList",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the ID list",
+            "name": "idListID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "update an ID list",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/evalContext"
+              "$ref": "#/definitions/putIDListRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "evaluation result",
+            "description": "returns the ID list just updated",
             "schema": {
-              "$ref": "#/definitions/evalResult"
+              "$ref": "#/definitions/idList"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "idlist"
+        ],
+        "operationId": "deleteIDList",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the ID list",
+            "name": "idListID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "deleted, an ID list still used by constraints cannot be deleted"
           },
           "default": {
             "description": "generic error response",
@@ -1786,28 +6248,71 @@ func init() {
         }
       }
     },
-    "/evaluation/batch": {
-      "post": {
+    "/id_lists/{idListID}/ids": {
+      "get": {
         "tags": [
-          "evaluation"
+          "idlist"
         ],
-        "operationId": "postEvaluationBatch",
+        "operationId": "getIDListIDs",
         "parameters": [
           {
-            "description": "evalution batch request",
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the ID list",
+            "name": "idListID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the IDs of the ID list in the uploaded order",
+            "schema": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "idlist"
+        ],
+        "operationId": "putIDListIDs",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the ID list",
+            "name": "idListID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "upload the IDs of an ID list",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/evaluationBatchRequest"
+              "$ref": "#/definitions/putIDListIDsRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "evaluation batch result",
+            "description": "returns the ID list just updated",
             "schema": {
-              "$ref": "#/definitions/evaluationBatchResponse"
+              "$ref": "#/definitions/idList"
             }
           },
           "default": {
@@ -1819,21 +6324,51 @@ func init() {
         }
       }
     },
-    "/export/sqlite": {
+    "/layers": {
       "get": {
-        "description": "Export sqlite3 format of the db dump, which is converted from the main database.",
-        "produces": [
-          "application/octet-stream"
+        "tags": [
+          "layer"
         ],
+        "operationId": "findLayers",
+        "responses": {
+          "200": {
+            "description": "list all the layers",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/layer"
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
         "tags": [
-          "export"
+          "layer"
+        ],
+        "operationId": "createLayer",
+        "parameters": [
+          {
+            "description": "create a layer",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createLayerRequest"
+            }
+          }
         ],
-        "operationId": "getExportSQLite",
         "responses": {
           "200": {
-            "description": "OK",
+            "description": "returns the created layer",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/layer"
             }
           },
           "default": {
@@ -1845,59 +6380,120 @@ func init() {
         }
       }
     },
-    "/flags": {
+    "/layers/{layerID}": {
       "get": {
         "tags": [
-          "flag"
+          "layer"
         ],
-        "operationId": "findFlags",
+        "operationId": "getLayer",
         "parameters": [
           {
+            "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "the numbers of flags to return",
-            "name": "limit",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "description": "return flags having given enabled status",
-            "name": "enabled",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "return flags exactly matching given description",
-            "name": "description",
-            "in": "query"
+            "description": "numeric ID of the layer",
+            "name": "layerID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the layer with the buckets of its flags",
+            "schema": {
+              "$ref": "#/definitions/layer"
+            }
           },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "layer"
+        ],
+        "operationId": "putLayer",
+        "parameters": [
           {
-            "type": "string",
-            "description": "return flags partially matching given description",
-            "name": "description_like",
-            "in": "query"
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the layer",
+            "name": "layerID",
+            "in": "path",
+            "required": true
           },
           {
-            "type": "string",
-            "description": "return flags matching given key",
-            "name": "key",
-            "in": "query"
+            "description": "update a layer",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putLayerRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the layer just updated",
+            "schema": {
+              "$ref": "#/definitions/layer"
+            }
           },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "layer"
+        ],
+        "operationId": "deleteLayer",
+        "parameters": [
           {
+            "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "return flags given the offset, it should usually set together with limit",
-            "name": "offset",
-            "in": "query"
+            "description": "numeric ID of the layer",
+            "name": "layerID",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "list all the flags",
+            "description": "deleted"
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/webhooks": {
+      "get": {
+        "tags": [
+          "webhook"
+        ],
+        "operationId": "findWebhooks",
+        "responses": {
+          "200": {
+            "description": "list all the webhooks",
             "schema": {
               "type": "array",
               "items": {
-                "$ref": "#/definitions/flag"
+                "$ref": "#/definitions/webhook"
               }
             }
           },
@@ -1911,25 +6507,25 @@ func init() {
       },
       "post": {
         "tags": [
-          "flag"
+          "webhook"
         ],
-        "operationId": "createFlag",
+        "operationId": "createWebhook",
         "parameters": [
           {
-            "description": "create a flag",
+            "description": "create a webhook",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/createFlagRequest"
+              "$ref": "#/definitions/createWebhookRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "returns the created flag",
+            "description": "returns the created webhook",
             "schema": {
-              "$ref": "#/definitions/flag"
+              "$ref": "#/definitions/webhook"
             }
           },
           "default": {
@@ -1941,28 +6537,28 @@ func init() {
         }
       }
     },
-    "/flags/{flagID}": {
+    "/webhooks/{webhookID}": {
       "get": {
         "tags": [
-          "flag"
+          "webhook"
         ],
-        "operationId": "getFlag",
+        "operationId": "getWebhook",
         "parameters": [
           {
             "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "numeric ID of the flag to get",
-            "name": "flagID",
+            "description": "numeric ID of the webhook",
+            "name": "webhookID",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "returns the flag",
+            "description": "returns the webhook",
             "schema": {
-              "$ref": "#/definitions/flag"
+              "$ref": "#/definitions/webhook"
             }
           },
           "default": {
@@ -1975,34 +6571,34 @@ func init() {
       },
       "put": {
         "tags": [
-          "flag"
+          "webhook"
         ],
-        "operationId": "putFlag",
+        "operationId": "putWebhook",
         "parameters": [
           {
             "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "numeric ID of the flag to get",
-            "name": "flagID",
+            "description": "numeric ID of the webhook",
+            "name": "webhookID",
             "in": "path",
             "required": true
           },
           {
-            "description": "update a flag",
+            "description": "update a webhook",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/putFlagRequest"
+              "$ref": "#/definitions/putWebhookRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "returns the flag",
+            "description": "returns the webhook just updated",
             "schema": {
-              "$ref": "#/definitions/flag"
+              "$ref": "#/definitions/webhook"
             }
           },
           "default": {
@@ -2015,23 +6611,23 @@ func init() {
       },
       "delete": {
         "tags": [
-          "flag"
+          "webhook"
         ],
-        "operationId": "deleteFlag",
+        "operationId": "deleteWebhook",
         "parameters": [
           {
             "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "numeric ID of the flag",
-            "name": "flagID",
+            "description": "numeric ID of the webhook",
+            "name": "webhookID",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "OK deleted"
+            "description": "deleted"
           },
           "default": {
             "description": "generic error response",
@@ -2042,37 +6638,57 @@ func init() {
         }
       }
     },
-    "/flags/{flagID}/enabled": {
-      "put": {
+    "/webhooks/{webhookID}/deliveries": {
+      "get": {
+        "description": "returns the delivery log of the webhook, the latest first",
         "tags": [
-          "flag"
+          "webhook"
         ],
-        "operationId": "setFlagEnabled",
+        "operationId": "findWebhookDeliveries",
         "parameters": [
           {
             "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "numeric ID of the flag to get",
-            "name": "flagID",
+            "description": "numeric ID of the webhook",
+            "name": "webhookID",
             "in": "path",
             "required": true
           },
           {
-            "description": "set flag enabled state",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/setFlagEnabledRequest"
-            }
+            "enum": [
+              "pending",
+              "succeeded",
+              "failed"
+            ],
+            "type": "string",
+            "description": "return the deliveries with the given status",
+            "name": "status",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "the numbers of deliveries to return",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "return deliveries given the offset, it should usually set together with limit",
+            "name": "offset",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "returns the flag",
+            "description": "returns the webhook deliveries",
             "schema": {
-              "$ref": "#/definitions/flag"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/webhookDelivery"
+              }
             }
           },
           "default": {
@@ -2083,855 +6699,1051 @@ func init() {
           }
         }
       }
+    }
+  },
+  "definitions": {
+    "audience": {
+      "type": "object",
+      "required": [
+        "id",
+        "key",
+        "constraints",
+        "segments"
+      ],
+      "properties": {
+        "constraints": {
+          "description": "constraints joined by AND",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/constraint"
+          }
+        },
+        "description": {
+          "type": "string"
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1,
+          "readOnly": true
+        },
+        "key": {
+          "description": "unique key representation of the audience",
+          "type": "string",
+          "minLength": 1
+        },
+        "segments": {
+          "description": "the segments using the audience ordered by flagID",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/audienceSegment"
+          }
+        }
+      }
+    },
+    "audienceSegment": {
+      "type": "object",
+      "required": [
+        "flagID",
+        "segmentID"
+      ],
+      "properties": {
+        "description": {
+          "type": "string"
+        },
+        "flagID": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "flagKey": {
+          "type": "string"
+        },
+        "segmentID": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        }
+      }
+    },
+    "compactEvalResult": {
+      "type": "object",
+      "properties": {
+        "variantAttachment": {
+          "type": "object"
+        },
+        "variantKey": {
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "constraint": {
+      "type": "object",
+      "required": [
+        "property",
+        "operator",
+        "value"
+      ],
+      "properties": {
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1,
+          "readOnly": true
+        },
+        "operator": {
+          "description": "SEMVER_* compare semantic versions, in which prereleases precede their releases. BEFORE, AFTER and BETWEEN compare times, the values are RFC3339 times, dates, unix timestamps in seconds, times of day like \"09:00\" or now, and BETWEEN takes an array of the start and the excluded end. The property now is the time of the evaluation. *_CI compare strings ignoring case, IN_CI and NOTIN_CI take an array or a comma-separated list. IN_CIDR and NOT_IN_CIDR match IPv4 and IPv6 addresses against an array or a comma-separated list of CIDR ranges. IN_LIST and NOT_IN_LIST match against the ID list whose key is the value. The values of these operators don't need quotes",
+          "type": "string",
+          "minLength": 1,
+          "enum": [
+            "EQ",
+            "NEQ",
+            "LT",
+            "LTE",
+            "GT",
+            "GTE",
+            "EREG",
+            "NEREG",
+            "IN",
+            "NOTIN",
+            "CONTAINS",
+            "NOTCONTAINS",
+            "SEMVER_EQ",
+            "SEMVER_LT",
+            "SEMVER_LTE",
+            "SEMVER_GT",
+            "SEMVER_GTE",
+            "BEFORE",
+            "AFTER",
+            "BETWEEN",
+            "STARTS_WITH",
+            "NOT_STARTS_WITH",
+            "ENDS_WITH",
+            "NOT_ENDS_WITH",
+            "EQ_CI",
+            "NEQ_CI",
+            "IN_CI",
+            "NOTIN_CI",
+            "IN_CIDR",
+            "NOT_IN_CIDR",
+            "IN_LIST",
+            "NOT_IN_LIST"
+          ]
+        },
+        "property": {
+          "type": "string",
+          "minLength": 1
+        },
+        "value": {
+          "type": "string",
+          "minLength": 1
+        },
+        "valueType": {
+          "description": "the type of the plain value that flagr builds the expression from, e.g. the value CA with STRING, or CA, NY with STRING_LIST. Without it, the value is a literal of the expression, e.g. \"CA\" or [\"CA\", \"NY\"]. The operators other than EQ to NOTCONTAINS take plain values whatever the type",
+          "type": "string",
+          "enum": [
+            "STRING",
+            "NUMBER",
+            "BOOL",
+            "STRING_LIST",
+            "NUMBER_LIST"
+          ]
+        }
+      }
+    },
+    "constraintGroup": {
+      "description": "a boolean group of the constraints of a segment. a leaf references a constraint of the segment by constraintID, AND and OR join their children and NOT negates its only child",
+      "type": "object",
+      "properties": {
+        "children": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/constraintGroup"
+          }
+        },
+        "constraintID": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0
+        },
+        "operator": {
+          "type": "string",
+          "enum": [
+            "AND",
+            "OR",
+            "NOT"
+          ]
+        }
+      }
+    },
+    "conversionEvent": {
+      "type": "object",
+      "required": [
+        "entityID",
+        "flagKey",
+        "metric",
+        "value"
+      ],
+      "properties": {
+        "entityID": {
+          "type": "string",
+          "minLength": 1
+        },
+        "flagKey": {
+          "type": "string",
+          "minLength": 1
+        },
+        "metric": {
+          "description": "name of the metric, e.g. checkout",
+          "type": "string",
+          "minLength": 1
+        },
+        "value": {
+          "description": "value of the conversion, e.g. 1 for a binary metric or the revenue",
+          "type": "number",
+          "format": "double"
+        }
+      }
+    },
+    "createAudienceRequest": {
+      "type": "object",
+      "required": [
+        "key"
+      ],
+      "properties": {
+        "constraints": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/createConstraintRequest"
+          }
+        },
+        "description": {
+          "type": "string"
+        },
+        "key": {
+          "description": "unique key representation of the audience",
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "createConstraintRequest": {
+      "type": "object",
+      "required": [
+        "property",
+        "operator",
+        "value"
+      ],
+      "properties": {
+        "operator": {
+          "type": "string",
+          "minLength": 1
+        },
+        "property": {
+          "type": "string",
+          "minLength": 1
+        },
+        "value": {
+          "type": "string",
+          "minLength": 1
+        },
+        "valueType": {
+          "description": "the type of the plain value that flagr builds the expression from, e.g. the value CA with STRING, or CA, NY with STRING_LIST. Without it, or with an empty one, the value is a literal of the expression, e.g. \"CA\" or [\"CA\", \"NY\"]. The operators other than EQ to NOTCONTAINS take plain values whatever the type. Updating a constraint without it keeps its stored type",
+          "type": "string",
+          "enum": [
+            "",
+            "STRING",
+            "NUMBER",
+            "BOOL",
+            "STRING_LIST",
+            "NUMBER_LIST"
+          ],
+          "x-nullable": true
+        }
+      }
+    },
+    "createFlagRequest": {
+      "type": "object",
+      "required": [
+        "description"
+      ],
+      "properties": {
+        "description": {
+          "type": "string",
+          "minLength": 1
+        },
+        "key": {
+          "description": "unique key representation of the flag",
+          "type": "string"
+        }
+      }
     },
-    "/flags/{flagID}/segments": {
-      "get": {
-        "tags": [
-          "segment"
-        ],
-        "operationId": "findSegments",
-        "parameters": [
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the flag to get",
-            "name": "flagID",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "segments ordered by rank of the flag",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/segment"
-              }
-            }
-          },
-          "default": {
-            "description": "generic error response",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      },
-      "post": {
-        "tags": [
-          "segment"
-        ],
-        "operationId": "createSegment",
-        "parameters": [
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the flag to get",
-            "name": "flagID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "create a segment under a flag",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/createSegmentRequest"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "segment created",
-            "schema": {
-              "$ref": "#/definitions/segment"
-            }
-          },
-          "default": {
-            "description": "generic error response",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+    "createIDListRequest": {
+      "type": "object",
+      "required": [
+        "key"
+      ],
+      "properties": {
+        "description": {
+          "type": "string"
+        },
+        "ids": {
+          "description": "the IDs separated by newlines or commas, e.g. the content of a CSV file",
+          "type": "string"
+        },
+        "key": {
+          "description": "unique key representation of the ID list, which the IN_LIST constraints reference",
+          "type": "string",
+          "minLength": 1
         }
       }
     },
-    "/flags/{flagID}/segments/reorder": {
-      "put": {
-        "tags": [
-          "segment"
-        ],
-        "operationId": "putSegmentsReorder",
-        "parameters": [
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the flag",
-            "name": "flagID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "reorder segments",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/putSegmentReorderRequest"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "segments reordered"
-          },
-          "default": {
-            "description": "generic error response",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+    "createLayerRequest": {
+      "type": "object",
+      "required": [
+        "key"
+      ],
+      "properties": {
+        "description": {
+          "type": "string"
+        },
+        "key": {
+          "description": "unique key representation of the layer",
+          "type": "string",
+          "minLength": 1
         }
       }
     },
-    "/flags/{flagID}/segments/{segmentID}": {
-      "put": {
-        "tags": [
-          "segment"
-        ],
-        "operationId": "putSegment",
-        "parameters": [
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the flag",
-            "name": "flagID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the segment",
-            "name": "segmentID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "update a segment",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/putSegmentRequest"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "segment updated",
-            "schema": {
-              "$ref": "#/definitions/segment"
-            }
-          },
-          "default": {
-            "description": "generic error response",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+    "createOverrideRequest": {
+      "type": "object",
+      "required": [
+        "entityID",
+        "variantKey"
+      ],
+      "properties": {
+        "entityID": {
+          "type": "string",
+          "minLength": 1
+        },
+        "entityType": {
+          "description": "the override only applies to the entities of the type, any type if empty",
+          "type": "string"
+        },
+        "expiresAt": {
+          "description": "the override is ignored after it expires, it never expires if empty",
+          "type": "string",
+          "format": "date-time"
+        },
+        "variantKey": {
+          "type": "string",
+          "minLength": 1
         }
-      },
-      "delete": {
-        "tags": [
-          "segment"
-        ],
-        "operationId": "deleteSegment",
-        "parameters": [
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the flag",
-            "name": "flagID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the segment",
-            "name": "segmentID",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "deleted"
-          },
-          "default": {
-            "description": "generic error response",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+      }
+    },
+    "createSegmentRequest": {
+      "type": "object",
+      "required": [
+        "description",
+        "rolloutPercent"
+      ],
+      "properties": {
+        "audienceID": {
+          "description": "the audience whose constraints the entities must match besides the constraints of the segment, 0 means none",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0
+        },
+        "description": {
+          "type": "string",
+          "minLength": 1
+        },
+        "rolloutBasisPoints": {
+          "description": "the rollout in basis points (0.01%), it overrides rolloutPercent if it's not 0",
+          "type": "integer",
+          "format": "int64",
+          "maximum": 10000,
+          "minimum": 0
+        },
+        "rolloutPercent": {
+          "type": "integer",
+          "format": "int64",
+          "maximum": 100,
+          "minimum": 0
         }
       }
     },
-    "/flags/{flagID}/segments/{segmentID}/constraints": {
-      "get": {
-        "tags": [
-          "constraint"
-        ],
-        "operationId": "findConstraints",
-        "parameters": [
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the flag",
-            "name": "flagID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the segment",
-            "name": "segmentID",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "constraints under the segment",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/constraint"
-              }
-            }
-          },
-          "default": {
-            "description": "generic error response",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      },
-      "post": {
-        "tags": [
-          "constraint"
-        ],
-        "operationId": "createConstraint",
-        "parameters": [
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the flag",
-            "name": "flagID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the segment",
-            "name": "segmentID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "create a constraint",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/createConstraintRequest"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "the constraint created",
-            "schema": {
-              "$ref": "#/definitions/constraint"
-            }
-          },
-          "default": {
-            "description": "generic error response",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+    "createVariantRequest": {
+      "type": "object",
+      "required": [
+        "key"
+      ],
+      "properties": {
+        "attachment": {
+          "type": "object"
+        },
+        "key": {
+          "type": "string",
+          "minLength": 1
         }
       }
     },
-    "/flags/{flagID}/segments/{segmentID}/constraints/{constraintID}": {
-      "put": {
-        "tags": [
-          "constraint"
-        ],
-        "operationId": "putConstraint",
-        "parameters": [
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the flag",
-            "name": "flagID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the segment",
-            "name": "segmentID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the constraint",
-            "name": "constraintID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "create a constraint",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/createConstraintRequest"
-            }
+    "createWebhookRequest": {
+      "type": "object",
+      "required": [
+        "url"
+      ],
+      "properties": {
+        "enabled": {
+          "type": "boolean"
+        },
+        "events": {
+          "description": "the events to subscribe, empty means all the events. flag.created, flag.updated, flag.enabled, flag.disabled or flag.deleted",
+          "type": "array",
+          "items": {
+            "type": "string"
           }
-        ],
-        "responses": {
-          "200": {
-            "description": "constraint just updated",
-            "schema": {
-              "$ref": "#/definitions/constraint"
-            }
-          },
-          "default": {
-            "description": "generic error response",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+        },
+        "flagKeys": {
+          "description": "the flags to subscribe, empty means all the flags",
+          "type": "array",
+          "items": {
+            "type": "string"
           }
+        },
+        "format": {
+          "description": "json posts the flag event, slack posts a human-readable message to a chat incoming webhook. json by default",
+          "type": "string",
+          "enum": [
+            "json",
+            "slack"
+          ]
+        },
+        "secret": {
+          "description": "the secret to sign the payloads with HMAC-SHA256",
+          "type": "string"
+        },
+        "url": {
+          "type": "string",
+          "minLength": 1
         }
-      },
-      "delete": {
-        "tags": [
-          "constraint"
-        ],
-        "operationId": "deleteConstraint",
-        "parameters": [
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the flag",
-            "name": "flagID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the segment",
-            "name": "segmentID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the constraint",
-            "name": "constraintID",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "deleted"
-          },
-          "default": {
-            "description": "generic error response",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+      }
+    },
+    "distribution": {
+      "type": "object",
+      "required": [
+        "percent",
+        "variantID",
+        "variantKey"
+      ],
+      "properties": {
+        "bitmap": {
+          "type": "string",
+          "minLength": 1
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1,
+          "readOnly": true
+        },
+        "percent": {
+          "type": "integer",
+          "format": "int64",
+          "maximum": 100,
+          "minimum": 0
+        },
+        "variantID": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "variantKey": {
+          "type": "string",
+          "minLength": 1
         }
       }
     },
-    "/flags/{flagID}/segments/{segmentID}/distributions": {
-      "get": {
-        "tags": [
-          "distribution"
-        ],
-        "operationId": "findDistributions",
-        "parameters": [
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the flag",
-            "name": "flagID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the segment",
-            "name": "segmentID",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "distribution under the segment",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/distribution"
-              }
-            }
-          },
-          "default": {
-            "description": "generic error response",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+    "error": {
+      "type": "object",
+      "required": [
+        "message"
+      ],
+      "properties": {
+        "message": {
+          "type": "string",
+          "minLength": 1
         }
-      },
-      "put": {
-        "description": "replace the distribution with the new setting",
-        "tags": [
-          "distribution"
-        ],
-        "operationId": "putDistributions",
-        "parameters": [
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the flag",
-            "name": "flagID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the segment",
-            "name": "segmentID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "array of distributions",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/putDistributionsRequest"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "distribution under the segment",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/distribution"
-              }
-            }
-          },
-          "default": {
-            "description": "generic error response",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+      }
+    },
+    "evalContext": {
+      "type": "object",
+      "required": [
+        "entityType"
+      ],
+      "properties": {
+        "enableDebug": {
+          "type": "boolean"
+        },
+        "entityContext": {
+          "type": "object"
+        },
+        "entityID": {
+          "description": "entityID is used to deterministically at random to evaluate the flag result. If it's empty, flagr will randomly generate one.",
+          "type": "string"
+        },
+        "entityType": {
+          "type": "string",
+          "minLength": 1
+        },
+        "flagID": {
+          "description": "flagID",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "flagKey": {
+          "description": "flagKey. flagID or flagKey will resolve to the same flag. Either works.",
+          "type": "string"
         }
       }
     },
-    "/flags/{flagID}/snapshots": {
-      "get": {
-        "tags": [
-          "flag"
-        ],
-        "operationId": "getFlagSnapshots",
-        "parameters": [
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the flag to get",
-            "name": "flagID",
-            "in": "path",
-            "required": true
+    "evalDebugLog": {
+      "type": "object",
+      "properties": {
+        "msg": {
+          "type": "string"
+        },
+        "segmentDebugLogs": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/segmentDebugLog"
           }
-        ],
-        "responses": {
-          "200": {
-            "description": "returns the flag snapshots",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/flagSnapshot"
-              }
-            }
-          },
-          "default": {
-            "description": "generic error response",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+        }
+      }
+    },
+    "evalResult": {
+      "type": "object",
+      "required": [
+        "flagID",
+        "flagKey",
+        "segmentID",
+        "variantID",
+        "variantKey",
+        "variantAttachment",
+        "evalContext",
+        "timestamp"
+      ],
+      "properties": {
+        "evalContext": {
+          "$ref": "#/definitions/evalContext"
+        },
+        "evalDebugLog": {
+          "$ref": "#/definitions/evalDebugLog"
+        },
+        "flagID": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "flagKey": {
+          "type": "string"
+        },
+        "flagSnapshotID": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "segmentID": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "timestamp": {
+          "type": "string",
+          "minLength": 1
+        },
+        "variantAttachment": {
+          "type": "object"
+        },
+        "variantID": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "variantKey": {
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "evaluationBatchCompactResponse": {
+      "type": "object",
+      "required": [
+        "evaluationResults"
+      ],
+      "properties": {
+        "evaluationResults": {
+          "description": "compact evaluation results of each entity, in the order of the entities",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/evaluationCompactResponse"
           }
         }
       }
     },
-    "/flags/{flagID}/variants": {
-      "get": {
-        "tags": [
-          "variant"
-        ],
-        "operationId": "findVariants",
-        "parameters": [
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the flag",
-            "name": "flagID",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "variant ordered by variantID",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/variant"
-              }
-            }
-          },
-          "default": {
-            "description": "generic error response",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+    "evaluationBatchRequest": {
+      "type": "object",
+      "required": [
+        "entities"
+      ],
+      "properties": {
+        "enableDebug": {
+          "type": "boolean"
+        },
+        "entities": {
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "$ref": "#/definitions/evaluationEntity"
           }
-        }
-      },
-      "post": {
-        "tags": [
-          "variant"
-        ],
-        "operationId": "createVariant",
-        "parameters": [
-          {
-            "minimum": 1,
+        },
+        "flagIDs": {
+          "description": "flagIDs",
+          "type": "array",
+          "minItems": 1,
+          "items": {
             "type": "integer",
             "format": "int64",
-            "description": "numeric ID of the flag",
-            "name": "flagID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "create a variant",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/createVariantRequest"
-            }
+            "minimum": 1
           }
-        ],
-        "responses": {
-          "200": {
-            "description": "variant just created",
-            "schema": {
-              "$ref": "#/definitions/variant"
-            }
-          },
-          "default": {
-            "description": "generic error response",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+        },
+        "flagKeys": {
+          "description": "flagKeys. Either flagIDs or flagKeys works. If pass in both, Flagr may return duplicate results.",
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "type": "string",
+            "minLength": 1
           }
         }
       }
     },
-    "/flags/{flagID}/variants/{variantID}": {
-      "put": {
-        "tags": [
-          "variant"
-        ],
-        "operationId": "putVariant",
-        "parameters": [
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the flag",
-            "name": "flagID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the variant",
-            "name": "variantID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "update a variant",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/putVariantRequest"
-            }
+    "evaluationBatchResponse": {
+      "type": "object",
+      "required": [
+        "evaluationResults"
+      ],
+      "properties": {
+        "evaluationResults": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/evalResult"
           }
-        ],
-        "responses": {
-          "200": {
-            "description": "variant just updated",
-            "schema": {
-              "$ref": "#/definitions/variant"
-            }
-          },
-          "default": {
-            "description": "generic error response",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+        }
+      }
+    },
+    "evaluationCompactResponse": {
+      "description": "compact evaluation results keyed by flagKey",
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/compactEvalResult"
+      }
+    },
+    "evaluationConfig": {
+      "type": "object",
+      "required": [
+        "flags"
+      ],
+      "properties": {
+        "flags": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/evaluationConfigFlag"
           }
         }
-      },
-      "delete": {
-        "tags": [
-          "variant"
-        ],
-        "operationId": "deleteVariant",
-        "parameters": [
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the flag",
-            "name": "flagID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the variant",
-            "name": "variantID",
-            "in": "path",
-            "required": true
+      }
+    },
+    "evaluationConfigDistribution": {
+      "type": "object",
+      "required": [
+        "variantID",
+        "percent"
+      ],
+      "properties": {
+        "percent": {
+          "type": "integer",
+          "format": "int64",
+          "maximum": 100,
+          "minimum": 0
+        },
+        "variantID": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        }
+      }
+    },
+    "evaluationConfigFlag": {
+      "type": "object",
+      "required": [
+        "id",
+        "key",
+        "enabled",
+        "salt",
+        "segments",
+        "variants"
+      ],
+      "properties": {
+        "bucketBy": {
+          "description": "the entityContext property whose value is bucketed instead of the entityID if it's present",
+          "type": "string"
+        },
+        "bucketNum": {
+          "description": "the number of buckets of the hash, the distributions are accumulated in bucketNum/100 buckets per percent",
+          "type": "integer",
+          "format": "int64"
+        },
+        "enabled": {
+          "type": "boolean"
+        },
+        "hash": {
+          "description": "crc32 is crc32(salt+entityID) % 1000, sha1 is the first 8 bytes of sha1(salt+entityID) as a big-endian uint64 % 1000000",
+          "type": "string",
+          "enum": [
+            "crc32",
+            "sha1"
+          ]
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "key": {
+          "type": "string",
+          "minLength": 1
+        },
+        "layerBucketEnd": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "layerBucketStart": {
+          "description": "the entity gets a variant only if its layer bucket is in [layerBucketStart, layerBucketEnd)",
+          "type": "integer",
+          "format": "int64"
+        },
+        "layerID": {
+          "description": "the layer the flag is in, 0 means none",
+          "type": "integer",
+          "format": "int64"
+        },
+        "layerSalt": {
+          "description": "prefix of the entityID when computing the crc32 bucket in the layer",
+          "type": "string"
+        },
+        "overrides": {
+          "description": "the overrides of the entities, they are checked before the segments and the expired ones are skipped",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/override"
+          }
+        },
+        "salt": {
+          "description": "prefix of the entityID when computing the crc32 bucket",
+          "type": "string"
+        },
+        "segments": {
+          "description": "segments in the order of evaluation",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/evaluationConfigSegment"
           }
-        ],
-        "responses": {
-          "200": {
-            "description": "deleted"
-          },
-          "default": {
-            "description": "generic error response",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+        },
+        "snapshotID": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "stickyAssignments": {
+          "description": "the stored assignments are only honored by the server side evaluation",
+          "type": "boolean"
+        },
+        "variants": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/variant"
           }
         }
       }
     },
-    "/health": {
-      "get": {
-        "description": "Check if Flagr is healthy",
-        "tags": [
-          "health"
-        ],
-        "operationId": "getHealth",
-        "responses": {
-          "200": {
-            "description": "OK"
-          },
-          "default": {
-            "description": "generic error response",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      }
-    }
-  },
-  "definitions": {
-    "constraint": {
+    "evaluationConfigSegment": {
       "type": "object",
       "required": [
-        "property",
-        "operator",
-        "value"
+        "id",
+        "rolloutPercent",
+        "constraints",
+        "distributions"
       ],
       "properties": {
-        "id": {
+        "audienceID": {
+          "description": "the audience of the segment, its constraints are included in the constraints",
           "type": "integer",
           "format": "int64",
-          "minimum": 1,
-          "readOnly": true
+          "minimum": 0
         },
-        "operator": {
-          "type": "string",
-          "minLength": 1,
-          "enum": [
-            "EQ",
-            "NEQ",
-            "LT",
-            "LTE",
-            "GT",
-            "GTE",
-            "EREG",
-            "NEREG",
-            "IN",
-            "NOTIN",
-            "CONTAINS",
-            "NOTCONTAINS"
-          ]
+        "constraintGroup": {
+          "description": "the constraints not referenced by the group are joined by AND with it",
+          "$ref": "#/definitions/constraintGroup"
         },
-        "property": {
-          "type": "string",
-          "minLength": 1
+        "constraints": {
+          "description": "constraints joined by AND, the constraints of the audience first",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/constraint"
+          }
         },
-        "value": {
-          "type": "string",
-          "minLength": 1
+        "distributions": {
+          "description": "distributions ordered by variantID, in which the buckets are accumulated",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/evaluationConfigDistribution"
+          }
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "rolloutBasisPoints": {
+          "description": "the effective rollout in basis points (0.01%)",
+          "type": "integer",
+          "format": "int64",
+          "maximum": 10000,
+          "minimum": 0
+        },
+        "rolloutPercent": {
+          "type": "integer",
+          "format": "int64",
+          "maximum": 100,
+          "minimum": 0
         }
       }
     },
-    "createConstraintRequest": {
+    "evaluationEntity": {
       "type": "object",
       "required": [
-        "property",
-        "operator",
-        "value"
+        "entityType"
       ],
       "properties": {
-        "operator": {
-          "type": "string",
-          "minLength": 1
+        "entityContext": {
+          "type": "object"
         },
-        "property": {
-          "type": "string",
-          "minLength": 1
+        "entityID": {
+          "type": "string"
         },
-        "value": {
+        "entityType": {
           "type": "string",
           "minLength": 1
         }
       }
     },
-    "createFlagRequest": {
+    "flag": {
       "type": "object",
       "required": [
-        "description"
+        "description",
+        "enabled",
+        "dataRecordsEnabled"
       ],
       "properties": {
+        "attachmentSchema": {
+          "description": "JSON Schema (draft 4) that the variant attachments of the flag are validated against",
+          "type": "object"
+        },
+        "bucketBy": {
+          "description": "the entityContext property to bucket the entities by instead of the entityID, e.g. company_id. The entityID is used if it's missing",
+          "type": "string"
+        },
+        "createdBy": {
+          "type": "string"
+        },
+        "dataRecordsEnabled": {
+          "description": "enabled data records will get data logging in the metrics pipeline, for example, kafka.",
+          "type": "boolean"
+        },
         "description": {
           "type": "string",
           "minLength": 1
         },
+        "enabled": {
+          "type": "boolean"
+        },
+        "hash": {
+          "description": "the hash of bucketing the entities. crc32 into 1000 buckets by default, or sha1 into 1000000 buckets for rollouts in basis points",
+          "type": "string",
+          "enum": [
+            "crc32",
+            "sha1"
+          ]
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1,
+          "readOnly": true
+        },
         "key": {
           "description": "unique key representation of the flag",
+          "type": "string",
+          "minLength": 1
+        },
+        "layerBucketEnd": {
+          "description": "the bucket after the last bucket of the layer allocated to the flag",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0,
+          "readOnly": true
+        },
+        "layerBucketStart": {
+          "description": "the first bucket of the layer allocated to the flag",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0,
+          "readOnly": true
+        },
+        "layerID": {
+          "description": "the layer the flag is in, 0 means none",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0,
+          "readOnly": true
+        },
+        "salt": {
+          "description": "the salt of bucketing the entities, the flagID if empty. Flags with the same salt and hash bucket an entity the same way",
+          "type": "string"
+        },
+        "segments": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/segment"
+          }
+        },
+        "stickyAssignments": {
+          "description": "the first assignment of every entity is stored and reused as long as the entity stays in the same segment, even if the distributions change",
+          "type": "boolean"
+        },
+        "updatedAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "updatedBy": {
           "type": "string"
+        },
+        "variants": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/variant"
+          }
         }
       }
     },
-    "createSegmentRequest": {
+    "flagAnalysis": {
       "type": "object",
       "required": [
-        "description",
-        "rolloutPercent"
+        "flagID",
+        "metric",
+        "confidenceLevel",
+        "variants"
       ],
       "properties": {
-        "description": {
-          "type": "string",
-          "minLength": 1
+        "confidenceLevel": {
+          "type": "number",
+          "format": "double"
         },
-        "rolloutPercent": {
+        "controlVariantKey": {
+          "type": "string"
+        },
+        "flagID": {
           "type": "integer",
           "format": "int64",
-          "maximum": 100,
-          "minimum": 0
+          "minimum": 1
+        },
+        "flagKey": {
+          "type": "string"
+        },
+        "metric": {
+          "type": "string",
+          "minLength": 1
+        },
+        "variants": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/variantAnalysis"
+          }
         }
       }
     },
-    "createVariantRequest": {
+    "flagSnapshot": {
       "type": "object",
       "required": [
-        "key"
+        "id",
+        "flag",
+        "updatedAt"
       ],
       "properties": {
-        "attachment": {
-          "type": "object"
+        "flag": {
+          "$ref": "#/definitions/flag"
         },
-        "key": {
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "updatedAt": {
           "type": "string",
           "minLength": 1
+        },
+        "updatedBy": {
+          "type": "string"
         }
       }
     },
-    "distribution": {
+    "flagStats": {
+      "type": "object",
+      "required": [
+        "flagID",
+        "srmDetected",
+        "segments"
+      ],
+      "properties": {
+        "flagID": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "flagKey": {
+          "type": "string"
+        },
+        "segments": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/segmentStats"
+          }
+        },
+        "srmDetected": {
+          "description": "true if any of the segments has a sample ratio mismatch",
+          "type": "boolean"
+        }
+      }
+    },
+    "idList": {
       "type": "object",
       "required": [
-        "percent",
-        "variantID",
-        "variantKey"
+        "id",
+        "key",
+        "size"
       ],
       "properties": {
-        "bitmap": {
-          "type": "string",
-          "minLength": 1
+        "description": {
+          "type": "string"
         },
         "id": {
           "type": "integer",
@@ -2939,123 +7751,109 @@ func init() {
           "minimum": 1,
           "readOnly": true
         },
-        "percent": {
-          "type": "integer",
-          "format": "int64",
-          "maximum": 100,
-          "minimum": 0
+        "key": {
+          "description": "unique key representation of the ID list, which the IN_LIST constraints reference",
+          "type": "string",
+          "minLength": 1
         },
-        "variantID": {
+        "size": {
+          "description": "number of IDs in the list",
           "type": "integer",
           "format": "int64",
-          "minimum": 1
+          "minimum": 0
         },
-        "variantKey": {
+        "updatedAt": {
           "type": "string",
-          "minLength": 1
+          "format": "date-time"
         }
       }
     },
-    "error": {
+    "layer": {
       "type": "object",
       "required": [
-        "message"
+        "id",
+        "key",
+        "flags"
       ],
       "properties": {
-        "message": {
+        "description": {
+          "type": "string"
+        },
+        "flags": {
+          "description": "the flags in the layer ordered by their buckets",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/layerFlag"
+          }
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1,
+          "readOnly": true
+        },
+        "key": {
+          "description": "unique key representation of the layer",
           "type": "string",
           "minLength": 1
         }
       }
     },
-    "evalContext": {
+    "layerFlag": {
       "type": "object",
       "required": [
-        "entityType"
+        "flagID",
+        "bucketStart",
+        "bucketEnd"
       ],
       "properties": {
-        "enableDebug": {
-          "type": "boolean"
-        },
-        "entityContext": {
-          "type": "object"
-        },
-        "entityID": {
-          "description": "entityID is used to deterministically at random to evaluate the flag result. If it's empty, flagr will randomly generate one.",
-          "type": "string"
+        "bucketEnd": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0
         },
-        "entityType": {
-          "type": "string",
-          "minLength": 1
+        "bucketStart": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0
         },
         "flagID": {
-          "description": "flagID",
           "type": "integer",
           "format": "int64",
           "minimum": 1
         },
         "flagKey": {
-          "description": "flagKey. flagID or flagKey will resolve to the same flag. Either works.",
-          "type": "string"
-        }
-      }
-    },
-    "evalDebugLog": {
-      "type": "object",
-      "properties": {
-        "msg": {
           "type": "string"
-        },
-        "segmentDebugLogs": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/segmentDebugLog"
-          }
         }
       }
     },
-    "evalResult": {
+    "override": {
       "type": "object",
       "required": [
-        "flagID",
-        "flagKey",
-        "segmentID",
+        "entityType",
+        "entityID",
         "variantID",
-        "variantKey",
-        "variantAttachment",
-        "evalContext",
-        "timestamp"
+        "variantKey"
       ],
       "properties": {
-        "evalContext": {
-          "$ref": "#/definitions/evalContext"
-        },
-        "evalDebugLog": {
-          "$ref": "#/definitions/evalDebugLog"
-        },
-        "flagID": {
-          "type": "integer",
-          "format": "int64",
-          "minimum": 1
+        "entityID": {
+          "type": "string",
+          "minLength": 1
         },
-        "flagKey": {
+        "entityType": {
+          "description": "the override only applies to the entities of the type, any type if empty",
           "type": "string"
         },
-        "flagSnapshotID": {
-          "type": "integer",
-          "format": "int64"
+        "expiresAt": {
+          "description": "the override is ignored after it expires, it never expires if empty",
+          "type": "string",
+          "format": "date-time"
         },
-        "segmentID": {
+        "id": {
           "type": "integer",
           "format": "int64",
-          "minimum": 1
-        },
-        "timestamp": {
-          "type": "string",
-          "minLength": 1
-        },
-        "variantAttachment": {
-          "type": "object"
+          "minimum": 1,
+          "readOnly": true
         },
         "variantID": {
           "type": "integer",
@@ -3068,186 +7866,144 @@ func init() {
         }
       }
     },
-    "evaluationBatchRequest": {
+    "postConversionEventsRequest": {
       "type": "object",
       "required": [
-        "entities"
+        "events"
       ],
       "properties": {
-        "enableDebug": {
-          "type": "boolean"
-        },
-        "entities": {
+        "events": {
           "type": "array",
           "minItems": 1,
           "items": {
-            "$ref": "#/definitions/evaluationEntity"
+            "$ref": "#/definitions/conversionEvent"
           }
-        },
-        "flagIDs": {
-          "description": "flagIDs",
+        }
+      }
+    },
+    "putAudienceRequest": {
+      "type": "object",
+      "properties": {
+        "constraints": {
+          "description": "replaces all the constraints of the audience if present",
           "type": "array",
-          "minItems": 1,
           "items": {
-            "type": "integer",
-            "format": "int64",
-            "minimum": 1
+            "$ref": "#/definitions/createConstraintRequest"
           }
         },
-        "flagKeys": {
-          "description": "flagKeys. Either flagIDs or flagKeys works. If pass in both, Flagr may return duplicate results.",
-          "type": "array",
-          "minItems": 1,
-          "items": {
-            "type": "string",
-            "minLength": 1
-          }
+        "description": {
+          "type": "string",
+          "x-nullable": true
         }
       }
     },
-    "evaluationBatchResponse": {
+    "putDistributionsRequest": {
       "type": "object",
       "required": [
-        "evaluationResults"
+        "distributions"
       ],
       "properties": {
-        "evaluationResults": {
+        "distributions": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/evalResult"
+            "$ref": "#/definitions/distribution"
           }
         }
       }
     },
-    "evaluationEntity": {
+    "putFlagRequest": {
       "type": "object",
       "required": [
-        "entityType"
+        "description"
       ],
       "properties": {
-        "entityContext": {
+        "attachmentSchema": {
+          "description": "JSON Schema (draft 4) that the variant attachments of the flag are validated against, an empty object removes it",
           "type": "object"
         },
-        "entityID": {
-          "type": "string"
-        },
-        "entityType": {
+        "bucketBy": {
+          "description": "the entityContext property to bucket the entities by instead of the entityID, an empty string resets it to the entityID",
           "type": "string",
-          "minLength": 1
-        }
-      }
-    },
-    "flag": {
-      "type": "object",
-      "required": [
-        "description",
-        "enabled",
-        "dataRecordsEnabled"
-      ],
-      "properties": {
-        "createdBy": {
-          "type": "string"
+          "x-nullable": true
         },
         "dataRecordsEnabled": {
           "description": "enabled data records will get data logging in the metrics pipeline, for example, kafka.",
-          "type": "boolean"
+          "type": "boolean",
+          "x-nullable": true
         },
         "description": {
           "type": "string",
           "minLength": 1
         },
-        "enabled": {
-          "type": "boolean"
-        },
-        "id": {
-          "type": "integer",
-          "format": "int64",
-          "minimum": 1,
-          "readOnly": true
+        "hash": {
+          "description": "the hash of bucketing the entities, crc32 or sha1. Changing it re-buckets all the entities",
+          "type": "string",
+          "enum": [
+            "crc32",
+            "sha1"
+          ],
+          "x-nullable": true
         },
         "key": {
-          "description": "unique key representation of the flag",
           "type": "string",
-          "minLength": 1
-        },
-        "segments": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/segment"
-          }
+          "x-nullable": true
         },
-        "updatedAt": {
+        "salt": {
+          "description": "the salt of bucketing the entities, an empty string resets it to the flagID. Changing it re-buckets all the entities",
           "type": "string",
-          "format": "date-time"
-        },
-        "updatedBy": {
-          "type": "string"
+          "x-nullable": true
         },
-        "variants": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/variant"
-          }
+        "stickyAssignments": {
+          "description": "the first assignment of every entity is stored and reused as long as the entity stays in the same segment, even if the distributions change",
+          "type": "boolean",
+          "x-nullable": true
         }
       }
     },
-    "flagSnapshot": {
+    "putIDListIDsRequest": {
       "type": "object",
       "required": [
-        "id",
-        "flag",
-        "updatedAt"
+        "ids"
       ],
       "properties": {
-        "flag": {
-          "$ref": "#/definitions/flag"
-        },
-        "id": {
-          "type": "integer",
-          "format": "int64",
-          "minimum": 1
-        },
-        "updatedAt": {
-          "type": "string",
-          "minLength": 1
-        },
-        "updatedBy": {
+        "ids": {
+          "description": "the IDs separated by newlines or commas, e.g. the content of a CSV file. They replace all the IDs of the list",
           "type": "string"
         }
       }
     },
-    "putDistributionsRequest": {
+    "putIDListRequest": {
       "type": "object",
-      "required": [
-        "distributions"
-      ],
       "properties": {
-        "distributions": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/distribution"
-          }
+        "description": {
+          "type": "string",
+          "x-nullable": true
+        }
+      }
+    },
+    "putLayerRequest": {
+      "type": "object",
+      "properties": {
+        "description": {
+          "type": "string",
+          "x-nullable": true
         }
       }
     },
-    "putFlagRequest": {
+    "putOverrideRequest": {
       "type": "object",
       "required": [
-        "description"
+        "variantKey"
       ],
       "properties": {
-        "dataRecordsEnabled": {
-          "description": "enabled data records will get data logging in the metrics pipeline, for example, kafka.",
-          "type": "boolean",
-          "x-nullable": true
-        },
-        "description": {
+        "expiresAt": {
+          "description": "the override is ignored after it expires, it never expires if empty",
           "type": "string",
-          "minLength": 1
+          "format": "date-time"
         },
-        "key": {
+        "variantKey": {
           "type": "string",
-          "x-nullable": true
+          "minLength": 1
         }
       }
     },
@@ -3275,10 +8031,29 @@ func init() {
         "rolloutPercent"
       ],
       "properties": {
+        "audienceID": {
+          "description": "the audience whose constraints the entities must match besides the constraints of the segment, 0 means none. It's kept if absent",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0,
+          "x-nullable": true
+        },
+        "constraintGroup": {
+          "description": "the boolean group of the constraints, it's kept if absent and removed if empty",
+          "$ref": "#/definitions/constraintGroup"
+        },
         "description": {
           "type": "string",
           "minLength": 1
         },
+        "rolloutBasisPoints": {
+          "description": "the rollout in basis points (0.01%), it overrides rolloutPercent if it's not 0. It's kept if absent, unless rolloutPercent is changed",
+          "type": "integer",
+          "format": "int64",
+          "maximum": 10000,
+          "minimum": 0,
+          "x-nullable": true
+        },
         "rolloutPercent": {
           "type": "integer",
           "format": "int64",
@@ -3303,6 +8078,46 @@ func init() {
         }
       }
     },
+    "putWebhookRequest": {
+      "type": "object",
+      "properties": {
+        "enabled": {
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "events": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "flagKeys": {
+          "description": "the flags to subscribe, empty means all the flags",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "format": {
+          "description": "json posts the flag event, slack posts a human-readable message to a chat incoming webhook. json by default",
+          "type": "string",
+          "enum": [
+            "json",
+            "slack"
+          ],
+          "x-nullable": true
+        },
+        "secret": {
+          "type": "string",
+          "x-nullable": true
+        },
+        "url": {
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": true
+        }
+      }
+    },
     "segment": {
       "type": "object",
       "required": [
@@ -3311,6 +8126,15 @@ func init() {
         "rolloutPercent"
       ],
       "properties": {
+        "audienceID": {
+          "description": "the audience whose constraints the entities must match besides the constraints of the segment, 0 means none",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0
+        },
+        "constraintGroup": {
+          "$ref": "#/definitions/constraintGroup"
+        },
         "constraints": {
           "type": "array",
           "items": {
@@ -3338,6 +8162,13 @@ func init() {
           "format": "int64",
           "minimum": 0
         },
+        "rolloutBasisPoints": {
+          "description": "the rollout in basis points (0.01%), it overrides rolloutPercent if it's not 0",
+          "type": "integer",
+          "format": "int64",
+          "maximum": 10000,
+          "minimum": 0
+        },
         "rolloutPercent": {
           "type": "integer",
           "format": "int64",
@@ -3359,6 +8190,48 @@ func init() {
         }
       }
     },
+    "segmentStats": {
+      "type": "object",
+      "required": [
+        "segmentID",
+        "totalCount",
+        "pValue",
+        "srmDetected",
+        "variants"
+      ],
+      "properties": {
+        "chiSquared": {
+          "description": "chi-squared statistic of the observed counts against the distribution percents",
+          "type": "number",
+          "format": "double"
+        },
+        "pValue": {
+          "type": "number",
+          "format": "double"
+        },
+        "segmentID": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "srmDetected": {
+          "description": "true if pValue is below the SRM threshold and totalCount reaches the minimum sample size",
+          "type": "boolean"
+        },
+        "totalCount": {
+          "description": "number of evaluations in the segment that got assigned to a variant",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0
+        },
+        "variants": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/variantStats"
+          }
+        }
+      }
+    },
     "setFlagEnabledRequest": {
       "type": "object",
       "required": [
@@ -3370,6 +8243,27 @@ func init() {
         }
       }
     },
+    "setFlagLayerRequest": {
+      "type": "object",
+      "required": [
+        "layerID"
+      ],
+      "properties": {
+        "layerID": {
+          "description": "the layer to join, 0 leaves the current layer",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0
+        },
+        "percent": {
+          "description": "percent of the layer's buckets allocated to the flag, the entities bucketed out of them get no variant of the flag",
+          "type": "integer",
+          "format": "int64",
+          "maximum": 100,
+          "minimum": 1
+        }
+      }
+    },
     "variant": {
       "type": "object",
       "required": [
@@ -3390,6 +8284,214 @@ func init() {
           "minLength": 1
         }
       }
+    },
+    "variantAnalysis": {
+      "type": "object",
+      "required": [
+        "variantID",
+        "variantKey",
+        "assignedCount",
+        "convertedCount",
+        "significant"
+      ],
+      "properties": {
+        "assignedCount": {
+          "description": "number of entities assigned to the variant",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0
+        },
+        "conversionRate": {
+          "type": "number",
+          "format": "double"
+        },
+        "conversionRateLower": {
+          "description": "lower bound of the Wilson score interval of conversionRate",
+          "type": "number",
+          "format": "double"
+        },
+        "conversionRateUpper": {
+          "description": "upper bound of the Wilson score interval of conversionRate",
+          "type": "number",
+          "format": "double"
+        },
+        "convertedCount": {
+          "description": "number of assigned entities having the metric after the assignment",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0
+        },
+        "lift": {
+          "description": "relative difference of conversionRate against the control variant",
+          "type": "number",
+          "format": "double"
+        },
+        "pValue": {
+          "description": "two-sided p-value of the two-proportion z-test against the control variant",
+          "type": "number",
+          "format": "double"
+        },
+        "significant": {
+          "description": "true if pValue is below 1 - confidenceLevel",
+          "type": "boolean"
+        },
+        "valueMean": {
+          "description": "valueSum divided by assignedCount",
+          "type": "number",
+          "format": "double"
+        },
+        "valueSum": {
+          "type": "number",
+          "format": "double"
+        },
+        "variantID": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "variantKey": {
+          "type": "string"
+        }
+      }
+    },
+    "variantStats": {
+      "type": "object",
+      "required": [
+        "variantID",
+        "variantKey",
+        "expectedPercent",
+        "observedCount"
+      ],
+      "properties": {
+        "expectedCount": {
+          "type": "number",
+          "format": "double"
+        },
+        "expectedPercent": {
+          "type": "integer",
+          "format": "int64",
+          "maximum": 100,
+          "minimum": 0
+        },
+        "observedCount": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0
+        },
+        "variantID": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "variantKey": {
+          "type": "string"
+        }
+      }
+    },
+    "webhook": {
+      "type": "object",
+      "required": [
+        "id",
+        "url",
+        "events",
+        "enabled"
+      ],
+      "properties": {
+        "enabled": {
+          "type": "boolean"
+        },
+        "events": {
+          "description": "the events to subscribe, empty means all the events. flag.created, flag.updated, flag.enabled, flag.disabled or flag.deleted",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "flagKeys": {
+          "description": "the flags to subscribe, empty means all the flags",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "format": {
+          "description": "json posts the flag event, slack posts a human-readable message to a chat incoming webhook. json by default",
+          "type": "string",
+          "enum": [
+            "json",
+            "slack"
+          ]
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1,
+          "readOnly": true
+        },
+        "secretConfigured": {
+          "description": "true if the payloads are signed with a secret",
+          "type": "boolean"
+        },
+        "url": {
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "webhookDelivery": {
+      "type": "object",
+      "required": [
+        "id",
+        "webhookID",
+        "event",
+        "status",
+        "attempts"
+      ],
+      "properties": {
+        "attempts": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "event": {
+          "type": "string"
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "lastError": {
+          "type": "string"
+        },
+        "nextAttemptAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "payload": {
+          "type": "string"
+        },
+        "responseCode": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "pending",
+            "succeeded",
+            "failed"
+          ]
+        },
+        "webhookID": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        }
+      }
     }
   },
   "tags": [
@@ -3420,6 +8522,30 @@ func init() {
     {
       "description": "Check if Flagr is healthy",
       "name": "health"
+    },
+    {
+      "description": "Analysis compares the conversion events between the variants of a flag",
+      "name": "analysis"
+    },
+    {
+      "description": "Webhook notifies the external systems of the flag changes",
+      "name": "webhook"
+    },
+    {
+      "description": "Override forces the variant of an entity, e.g. for QA",
+      "name": "override"
+    },
+    {
+      "description": "Audience is a reusable set of constraints shared by the segments of many flags",
+      "name": "audience"
+    },
+    {
+      "description": "ID list is a named list of IDs, e.g. account IDs, that the IN_LIST constraints match against",
+      "name": "idlist"
+    },
+    {
+      "description": "Layer is a mutual exclusion group of flags, an entity gets in at most one flag of a layer",
+      "name": "layer"
     }
   ],
   "x-tagGroups": [
@@ -3430,7 +8556,11 @@ func init() {
         "segment",
         "constraint",
         "distribution",
-        "variant"
+        "variant",
+        "override",
+        "audience",
+        "idlist",
+        "layer"
       ]
     },
     {
@@ -3439,6 +8569,18 @@ func init() {
         "evaluation"
       ]
     },
+    {
+      "name": "Experiment Analysis",
+      "tags": [
+        "analysis"
+      ]
+    },
+    {
+      "name": "Webhook",
+      "tags": [
+        "webhook"
+      ]
+    },
     {
       "name": "Health Check",
       "tags": [
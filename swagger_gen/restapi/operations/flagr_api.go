@@ -19,14 +19,20 @@ import (
 	strfmt "github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 
+	"github.com/checkr/flagr/swagger_gen/restapi/operations/analysis"
+	"github.com/checkr/flagr/swagger_gen/restapi/operations/audience"
 	"github.com/checkr/flagr/swagger_gen/restapi/operations/constraint"
 	"github.com/checkr/flagr/swagger_gen/restapi/operations/distribution"
 	"github.com/checkr/flagr/swagger_gen/restapi/operations/evaluation"
 	"github.com/checkr/flagr/swagger_gen/restapi/operations/export"
 	"github.com/checkr/flagr/swagger_gen/restapi/operations/flag"
 	"github.com/checkr/flagr/swagger_gen/restapi/operations/health"
+	"github.com/checkr/flagr/swagger_gen/restapi/operations/idlist"
+	"github.com/checkr/flagr/swagger_gen/restapi/operations/layer"
+	"github.com/checkr/flagr/swagger_gen/restapi/operations/override"
 	"github.com/checkr/flagr/swagger_gen/restapi/operations/segment"
 	"github.com/checkr/flagr/swagger_gen/restapi/operations/variant"
+	"github.com/checkr/flagr/swagger_gen/restapi/operations/webhook"
 )
 
 // NewFlagrAPI creates a new Flagr instance
@@ -47,30 +53,66 @@ func NewFlagrAPI(spec *loads.Document) *FlagrAPI {
 		JSONConsumer:        runtime.JSONConsumer(),
 		JSONProducer:        runtime.JSONProducer(),
 		BinProducer:         runtime.ByteStreamProducer(),
+		AudienceCreateAudienceHandler: audience.CreateAudienceHandlerFunc(func(params audience.CreateAudienceParams) middleware.Responder {
+			return middleware.NotImplemented("operation AudienceCreateAudience has not yet been implemented")
+		}),
 		ConstraintCreateConstraintHandler: constraint.CreateConstraintHandlerFunc(func(params constraint.CreateConstraintParams) middleware.Responder {
 			return middleware.NotImplemented("operation ConstraintCreateConstraint has not yet been implemented")
 		}),
 		FlagCreateFlagHandler: flag.CreateFlagHandlerFunc(func(params flag.CreateFlagParams) middleware.Responder {
 			return middleware.NotImplemented("operation FlagCreateFlag has not yet been implemented")
 		}),
+		IdlistCreateIDListHandler: idlist.CreateIDListHandlerFunc(func(params idlist.CreateIDListParams) middleware.Responder {
+			return middleware.NotImplemented("operation IdlistCreateIDList has not yet been implemented")
+		}),
+		LayerCreateLayerHandler: layer.CreateLayerHandlerFunc(func(params layer.CreateLayerParams) middleware.Responder {
+			return middleware.NotImplemented("operation LayerCreateLayer has not yet been implemented")
+		}),
+		OverrideCreateOverrideHandler: override.CreateOverrideHandlerFunc(func(params override.CreateOverrideParams) middleware.Responder {
+			return middleware.NotImplemented("operation OverrideCreateOverride has not yet been implemented")
+		}),
 		SegmentCreateSegmentHandler: segment.CreateSegmentHandlerFunc(func(params segment.CreateSegmentParams) middleware.Responder {
 			return middleware.NotImplemented("operation SegmentCreateSegment has not yet been implemented")
 		}),
 		VariantCreateVariantHandler: variant.CreateVariantHandlerFunc(func(params variant.CreateVariantParams) middleware.Responder {
 			return middleware.NotImplemented("operation VariantCreateVariant has not yet been implemented")
 		}),
+		WebhookCreateWebhookHandler: webhook.CreateWebhookHandlerFunc(func(params webhook.CreateWebhookParams) middleware.Responder {
+			return middleware.NotImplemented("operation WebhookCreateWebhook has not yet been implemented")
+		}),
+		AudienceDeleteAudienceHandler: audience.DeleteAudienceHandlerFunc(func(params audience.DeleteAudienceParams) middleware.Responder {
+			return middleware.NotImplemented("operation AudienceDeleteAudience has not yet been implemented")
+		}),
 		ConstraintDeleteConstraintHandler: constraint.DeleteConstraintHandlerFunc(func(params constraint.DeleteConstraintParams) middleware.Responder {
 			return middleware.NotImplemented("operation ConstraintDeleteConstraint has not yet been implemented")
 		}),
 		FlagDeleteFlagHandler: flag.DeleteFlagHandlerFunc(func(params flag.DeleteFlagParams) middleware.Responder {
 			return middleware.NotImplemented("operation FlagDeleteFlag has not yet been implemented")
 		}),
+		FlagDeleteFlagAssignmentsHandler: flag.DeleteFlagAssignmentsHandlerFunc(func(params flag.DeleteFlagAssignmentsParams) middleware.Responder {
+			return middleware.NotImplemented("operation FlagDeleteFlagAssignments has not yet been implemented")
+		}),
+		IdlistDeleteIDListHandler: idlist.DeleteIDListHandlerFunc(func(params idlist.DeleteIDListParams) middleware.Responder {
+			return middleware.NotImplemented("operation IdlistDeleteIDList has not yet been implemented")
+		}),
+		LayerDeleteLayerHandler: layer.DeleteLayerHandlerFunc(func(params layer.DeleteLayerParams) middleware.Responder {
+			return middleware.NotImplemented("operation LayerDeleteLayer has not yet been implemented")
+		}),
+		OverrideDeleteOverrideHandler: override.DeleteOverrideHandlerFunc(func(params override.DeleteOverrideParams) middleware.Responder {
+			return middleware.NotImplemented("operation OverrideDeleteOverride has not yet been implemented")
+		}),
 		SegmentDeleteSegmentHandler: segment.DeleteSegmentHandlerFunc(func(params segment.DeleteSegmentParams) middleware.Responder {
 			return middleware.NotImplemented("operation SegmentDeleteSegment has not yet been implemented")
 		}),
 		VariantDeleteVariantHandler: variant.DeleteVariantHandlerFunc(func(params variant.DeleteVariantParams) middleware.Responder {
 			return middleware.NotImplemented("operation VariantDeleteVariant has not yet been implemented")
 		}),
+		WebhookDeleteWebhookHandler: webhook.DeleteWebhookHandlerFunc(func(params webhook.DeleteWebhookParams) middleware.Responder {
+			return middleware.NotImplemented("operation WebhookDeleteWebhook has not yet been implemented")
+		}),
+		AudienceFindAudiencesHandler: audience.FindAudiencesHandlerFunc(func(params audience.FindAudiencesParams) middleware.Responder {
+			return middleware.NotImplemented("operation AudienceFindAudiences has not yet been implemented")
+		}),
 		ConstraintFindConstraintsHandler: constraint.FindConstraintsHandlerFunc(func(params constraint.FindConstraintsParams) middleware.Responder {
 			return middleware.NotImplemented("operation ConstraintFindConstraints has not yet been implemented")
 		}),
@@ -80,30 +122,87 @@ func NewFlagrAPI(spec *loads.Document) *FlagrAPI {
 		FlagFindFlagsHandler: flag.FindFlagsHandlerFunc(func(params flag.FindFlagsParams) middleware.Responder {
 			return middleware.NotImplemented("operation FlagFindFlags has not yet been implemented")
 		}),
+		IdlistFindIDListsHandler: idlist.FindIDListsHandlerFunc(func(params idlist.FindIDListsParams) middleware.Responder {
+			return middleware.NotImplemented("operation IdlistFindIDLists has not yet been implemented")
+		}),
+		LayerFindLayersHandler: layer.FindLayersHandlerFunc(func(params layer.FindLayersParams) middleware.Responder {
+			return middleware.NotImplemented("operation LayerFindLayers has not yet been implemented")
+		}),
+		OverrideFindOverridesHandler: override.FindOverridesHandlerFunc(func(params override.FindOverridesParams) middleware.Responder {
+			return middleware.NotImplemented("operation OverrideFindOverrides has not yet been implemented")
+		}),
 		SegmentFindSegmentsHandler: segment.FindSegmentsHandlerFunc(func(params segment.FindSegmentsParams) middleware.Responder {
 			return middleware.NotImplemented("operation SegmentFindSegments has not yet been implemented")
 		}),
 		VariantFindVariantsHandler: variant.FindVariantsHandlerFunc(func(params variant.FindVariantsParams) middleware.Responder {
 			return middleware.NotImplemented("operation VariantFindVariants has not yet been implemented")
 		}),
+		WebhookFindWebhookDeliveriesHandler: webhook.FindWebhookDeliveriesHandlerFunc(func(params webhook.FindWebhookDeliveriesParams) middleware.Responder {
+			return middleware.NotImplemented("operation WebhookFindWebhookDeliveries has not yet been implemented")
+		}),
+		WebhookFindWebhooksHandler: webhook.FindWebhooksHandlerFunc(func(params webhook.FindWebhooksParams) middleware.Responder {
+			return middleware.NotImplemented("operation WebhookFindWebhooks has not yet been implemented")
+		}),
+		AudienceGetAudienceHandler: audience.GetAudienceHandlerFunc(func(params audience.GetAudienceParams) middleware.Responder {
+			return middleware.NotImplemented("operation AudienceGetAudience has not yet been implemented")
+		}),
+		EvaluationGetEvaluationHandler: evaluation.GetEvaluationHandlerFunc(func(params evaluation.GetEvaluationParams) middleware.Responder {
+			return middleware.NotImplemented("operation EvaluationGetEvaluation has not yet been implemented")
+		}),
+		EvaluationGetEvaluationConfigHandler: evaluation.GetEvaluationConfigHandlerFunc(func(params evaluation.GetEvaluationConfigParams) middleware.Responder {
+			return middleware.NotImplemented("operation EvaluationGetEvaluationConfig has not yet been implemented")
+		}),
 		ExportGetExportSqliteHandler: export.GetExportSqliteHandlerFunc(func(params export.GetExportSqliteParams) middleware.Responder {
 			return middleware.NotImplemented("operation ExportGetExportSqlite has not yet been implemented")
 		}),
 		FlagGetFlagHandler: flag.GetFlagHandlerFunc(func(params flag.GetFlagParams) middleware.Responder {
 			return middleware.NotImplemented("operation FlagGetFlag has not yet been implemented")
 		}),
+		AnalysisGetFlagAnalysisHandler: analysis.GetFlagAnalysisHandlerFunc(func(params analysis.GetFlagAnalysisParams) middleware.Responder {
+			return middleware.NotImplemented("operation AnalysisGetFlagAnalysis has not yet been implemented")
+		}),
 		FlagGetFlagSnapshotsHandler: flag.GetFlagSnapshotsHandlerFunc(func(params flag.GetFlagSnapshotsParams) middleware.Responder {
 			return middleware.NotImplemented("operation FlagGetFlagSnapshots has not yet been implemented")
 		}),
+		FlagGetFlagStatsHandler: flag.GetFlagStatsHandlerFunc(func(params flag.GetFlagStatsParams) middleware.Responder {
+			return middleware.NotImplemented("operation FlagGetFlagStats has not yet been implemented")
+		}),
+		FlagGetFlagsStreamHandler: flag.GetFlagsStreamHandlerFunc(func(params flag.GetFlagsStreamParams) middleware.Responder {
+			return middleware.NotImplemented("operation FlagGetFlagsStream has not yet been implemented")
+		}),
 		HealthGetHealthHandler: health.GetHealthHandlerFunc(func(params health.GetHealthParams) middleware.Responder {
 			return middleware.NotImplemented("operation HealthGetHealth has not yet been implemented")
 		}),
+		IdlistGetIDListHandler: idlist.GetIDListHandlerFunc(func(params idlist.GetIDListParams) middleware.Responder {
+			return middleware.NotImplemented("operation IdlistGetIDList has not yet been implemented")
+		}),
+		IdlistGetIDListIdsHandler: idlist.GetIDListIdsHandlerFunc(func(params idlist.GetIDListIdsParams) middleware.Responder {
+			return middleware.NotImplemented("operation IdlistGetIDListIds has not yet been implemented")
+		}),
+		LayerGetLayerHandler: layer.GetLayerHandlerFunc(func(params layer.GetLayerParams) middleware.Responder {
+			return middleware.NotImplemented("operation LayerGetLayer has not yet been implemented")
+		}),
+		WebhookGetWebhookHandler: webhook.GetWebhookHandlerFunc(func(params webhook.GetWebhookParams) middleware.Responder {
+			return middleware.NotImplemented("operation WebhookGetWebhook has not yet been implemented")
+		}),
+		AnalysisPostConversionEventsHandler: analysis.PostConversionEventsHandlerFunc(func(params analysis.PostConversionEventsParams) middleware.Responder {
+			return middleware.NotImplemented("operation AnalysisPostConversionEvents has not yet been implemented")
+		}),
 		EvaluationPostEvaluationHandler: evaluation.PostEvaluationHandlerFunc(func(params evaluation.PostEvaluationParams) middleware.Responder {
 			return middleware.NotImplemented("operation EvaluationPostEvaluation has not yet been implemented")
 		}),
 		EvaluationPostEvaluationBatchHandler: evaluation.PostEvaluationBatchHandlerFunc(func(params evaluation.PostEvaluationBatchParams) middleware.Responder {
 			return middleware.NotImplemented("operation EvaluationPostEvaluationBatch has not yet been implemented")
 		}),
+		EvaluationPostEvaluationBatchCompactHandler: evaluation.PostEvaluationBatchCompactHandlerFunc(func(params evaluation.PostEvaluationBatchCompactParams) middleware.Responder {
+			return middleware.NotImplemented("operation EvaluationPostEvaluationBatchCompact has not yet been implemented")
+		}),
+		EvaluationPostEvaluationCompactHandler: evaluation.PostEvaluationCompactHandlerFunc(func(params evaluation.PostEvaluationCompactParams) middleware.Responder {
+			return middleware.NotImplemented("operation EvaluationPostEvaluationCompact has not yet been implemented")
+		}),
+		AudiencePutAudienceHandler: audience.PutAudienceHandlerFunc(func(params audience.PutAudienceParams) middleware.Responder {
+			return middleware.NotImplemented("operation AudiencePutAudience has not yet been implemented")
+		}),
 		ConstraintPutConstraintHandler: constraint.PutConstraintHandlerFunc(func(params constraint.PutConstraintParams) middleware.Responder {
 			return middleware.NotImplemented("operation ConstraintPutConstraint has not yet been implemented")
 		}),
@@ -113,6 +212,18 @@ func NewFlagrAPI(spec *loads.Document) *FlagrAPI {
 		FlagPutFlagHandler: flag.PutFlagHandlerFunc(func(params flag.PutFlagParams) middleware.Responder {
 			return middleware.NotImplemented("operation FlagPutFlag has not yet been implemented")
 		}),
+		IdlistPutIDListHandler: idlist.PutIDListHandlerFunc(func(params idlist.PutIDListParams) middleware.Responder {
+			return middleware.NotImplemented("operation IdlistPutIDList has not yet been implemented")
+		}),
+		IdlistPutIDListIdsHandler: idlist.PutIDListIdsHandlerFunc(func(params idlist.PutIDListIdsParams) middleware.Responder {
+			return middleware.NotImplemented("operation IdlistPutIDListIds has not yet been implemented")
+		}),
+		LayerPutLayerHandler: layer.PutLayerHandlerFunc(func(params layer.PutLayerParams) middleware.Responder {
+			return middleware.NotImplemented("operation LayerPutLayer has not yet been implemented")
+		}),
+		OverridePutOverrideHandler: override.PutOverrideHandlerFunc(func(params override.PutOverrideParams) middleware.Responder {
+			return middleware.NotImplemented("operation OverridePutOverride has not yet been implemented")
+		}),
 		SegmentPutSegmentHandler: segment.PutSegmentHandlerFunc(func(params segment.PutSegmentParams) middleware.Responder {
 			return middleware.NotImplemented("operation SegmentPutSegment has not yet been implemented")
 		}),
@@ -122,9 +233,15 @@ func NewFlagrAPI(spec *loads.Document) *FlagrAPI {
 		VariantPutVariantHandler: variant.PutVariantHandlerFunc(func(params variant.PutVariantParams) middleware.Responder {
 			return middleware.NotImplemented("operation VariantPutVariant has not yet been implemented")
 		}),
+		WebhookPutWebhookHandler: webhook.PutWebhookHandlerFunc(func(params webhook.PutWebhookParams) middleware.Responder {
+			return middleware.NotImplemented("operation WebhookPutWebhook has not yet been implemented")
+		}),
 		FlagSetFlagEnabledHandler: flag.SetFlagEnabledHandlerFunc(func(params flag.SetFlagEnabledParams) middleware.Responder {
 			return middleware.NotImplemented("operation FlagSetFlagEnabled has not yet been implemented")
 		}),
+		LayerSetFlagLayerHandler: layer.SetFlagLayerHandlerFunc(func(params layer.SetFlagLayerParams) middleware.Responder {
+			return middleware.NotImplemented("operation LayerSetFlagLayer has not yet been implemented")
+		}),
 	}
 }
 
@@ -158,58 +275,132 @@ type FlagrAPI struct {
 	// BinProducer registers a producer for a "application/octet-stream" mime type
 	BinProducer runtime.Producer
 
+	// AudienceCreateAudienceHandler sets the operation handler for the create audience operation
+	AudienceCreateAudienceHandler audience.CreateAudienceHandler
 	// ConstraintCreateConstraintHandler sets the operation handler for the create constraint operation
 	ConstraintCreateConstraintHandler constraint.CreateConstraintHandler
 	// FlagCreateFlagHandler sets the operation handler for the create flag operation
 	FlagCreateFlagHandler flag.CreateFlagHandler
+	// IdlistCreateIDListHandler sets the operation handler for the create i d list operation
+	IdlistCreateIDListHandler idlist.CreateIDListHandler
+	// LayerCreateLayerHandler sets the operation handler for the create layer operation
+	LayerCreateLayerHandler layer.CreateLayerHandler
+	// OverrideCreateOverrideHandler sets the operation handler for the create override operation
+	OverrideCreateOverrideHandler override.CreateOverrideHandler
 	// SegmentCreateSegmentHandler sets the operation handler for the create segment operation
 	SegmentCreateSegmentHandler segment.CreateSegmentHandler
 	// VariantCreateVariantHandler sets the operation handler for the create variant operation
 	VariantCreateVariantHandler variant.CreateVariantHandler
+	// WebhookCreateWebhookHandler sets the operation handler for the create webhook operation
+	WebhookCreateWebhookHandler webhook.CreateWebhookHandler
+	// AudienceDeleteAudienceHandler sets the operation handler for the delete audience operation
+	AudienceDeleteAudienceHandler audience.DeleteAudienceHandler
 	// ConstraintDeleteConstraintHandler sets the operation handler for the delete constraint operation
 	ConstraintDeleteConstraintHandler constraint.DeleteConstraintHandler
 	// FlagDeleteFlagHandler sets the operation handler for the delete flag operation
 	FlagDeleteFlagHandler flag.DeleteFlagHandler
+	// FlagDeleteFlagAssignmentsHandler sets the operation handler for the delete flag assignments operation
+	FlagDeleteFlagAssignmentsHandler flag.DeleteFlagAssignmentsHandler
+	// IdlistDeleteIDListHandler sets the operation handler for the delete i d list operation
+	IdlistDeleteIDListHandler idlist.DeleteIDListHandler
+	// LayerDeleteLayerHandler sets the operation handler for the delete layer operation
+	LayerDeleteLayerHandler layer.DeleteLayerHandler
+	// OverrideDeleteOverrideHandler sets the operation handler for the delete override operation
+	OverrideDeleteOverrideHandler override.DeleteOverrideHandler
 	// SegmentDeleteSegmentHandler sets the operation handler for the delete segment operation
 	SegmentDeleteSegmentHandler segment.DeleteSegmentHandler
 	// VariantDeleteVariantHandler sets the operation handler for the delete variant operation
 	VariantDeleteVariantHandler variant.DeleteVariantHandler
+	// WebhookDeleteWebhookHandler sets the operation handler for the delete webhook operation
+	WebhookDeleteWebhookHandler webhook.DeleteWebhookHandler
+	// AudienceFindAudiencesHandler sets the operation handler for the find audiences operation
+	AudienceFindAudiencesHandler audience.FindAudiencesHandler
 	// ConstraintFindConstraintsHandler sets the operation handler for the find constraints operation
 	ConstraintFindConstraintsHandler constraint.FindConstraintsHandler
 	// DistributionFindDistributionsHandler sets the operation handler for the find distributions operation
 	DistributionFindDistributionsHandler distribution.FindDistributionsHandler
 	// FlagFindFlagsHandler sets the operation handler for the find flags operation
 	FlagFindFlagsHandler flag.FindFlagsHandler
+	// IdlistFindIDListsHandler sets the operation handler for the find i d lists operation
+	IdlistFindIDListsHandler idlist.FindIDListsHandler
+	// LayerFindLayersHandler sets the operation handler for the find layers operation
+	LayerFindLayersHandler layer.FindLayersHandler
+	// OverrideFindOverridesHandler sets the operation handler for the find overrides operation
+	OverrideFindOverridesHandler override.FindOverridesHandler
 	// SegmentFindSegmentsHandler sets the operation handler for the find segments operation
 	SegmentFindSegmentsHandler segment.FindSegmentsHandler
 	// VariantFindVariantsHandler sets the operation handler for the find variants operation
 	VariantFindVariantsHandler variant.FindVariantsHandler
+	// WebhookFindWebhookDeliveriesHandler sets the operation handler for the find webhook deliveries operation
+	WebhookFindWebhookDeliveriesHandler webhook.FindWebhookDeliveriesHandler
+	// WebhookFindWebhooksHandler sets the operation handler for the find webhooks operation
+	WebhookFindWebhooksHandler webhook.FindWebhooksHandler
+	// AudienceGetAudienceHandler sets the operation handler for the get audience operation
+	AudienceGetAudienceHandler audience.GetAudienceHandler
+	// EvaluationGetEvaluationHandler sets the operation handler for the get evaluation operation
+	EvaluationGetEvaluationHandler evaluation.GetEvaluationHandler
+	// EvaluationGetEvaluationConfigHandler sets the operation handler for the get evaluation config operation
+	EvaluationGetEvaluationConfigHandler evaluation.GetEvaluationConfigHandler
 	// ExportGetExportSqliteHandler sets the operation handler for the get export sqlite operation
 	ExportGetExportSqliteHandler export.GetExportSqliteHandler
 	// FlagGetFlagHandler sets the operation handler for the get flag operation
 	FlagGetFlagHandler flag.GetFlagHandler
+	// AnalysisGetFlagAnalysisHandler sets the operation handler for the get flag analysis operation
+	AnalysisGetFlagAnalysisHandler analysis.GetFlagAnalysisHandler
 	// FlagGetFlagSnapshotsHandler sets the operation handler for the get flag snapshots operation
 	FlagGetFlagSnapshotsHandler flag.GetFlagSnapshotsHandler
+	// FlagGetFlagStatsHandler sets the operation handler for the get flag stats operation
+	FlagGetFlagStatsHandler flag.GetFlagStatsHandler
+	// FlagGetFlagsStreamHandler sets the operation handler for the get flags stream operation
+	FlagGetFlagsStreamHandler flag.GetFlagsStreamHandler
 	// HealthGetHealthHandler sets the operation handler for the get health operation
 	HealthGetHealthHandler health.GetHealthHandler
+	// IdlistGetIDListHandler sets the operation handler for the get i d list operation
+	IdlistGetIDListHandler idlist.GetIDListHandler
+	// IdlistGetIDListIdsHandler sets the operation handler for the get i d list ids operation
+	IdlistGetIDListIdsHandler idlist.GetIDListIdsHandler
+	// LayerGetLayerHandler sets the operation handler for the get layer operation
+	LayerGetLayerHandler layer.GetLayerHandler
+	// WebhookGetWebhookHandler sets the operation handler for the get webhook operation
+	WebhookGetWebhookHandler webhook.GetWebhookHandler
+	// AnalysisPostConversionEventsHandler sets the operation handler for the post conversion events operation
+	AnalysisPostConversionEventsHandler analysis.PostConversionEventsHandler
 	// EvaluationPostEvaluationHandler sets the operation handler for the post evaluation operation
 	EvaluationPostEvaluationHandler evaluation.PostEvaluationHandler
 	// EvaluationPostEvaluationBatchHandler sets the operation handler for the post evaluation batch operation
 	EvaluationPostEvaluationBatchHandler evaluation.PostEvaluationBatchHandler
+	// EvaluationPostEvaluationBatchCompactHandler sets the operation handler for the post evaluation batch compact operation
+	EvaluationPostEvaluationBatchCompactHandler evaluation.PostEvaluationBatchCompactHandler
+	// EvaluationPostEvaluationCompactHandler sets the operation handler for the post evaluation compact operation
+	EvaluationPostEvaluationCompactHandler evaluation.PostEvaluationCompactHandler
+	// AudiencePutAudienceHandler sets the operation handler for the put audience operation
+	AudiencePutAudienceHandler audience.PutAudienceHandler
 	// ConstraintPutConstraintHandler sets the operation handler for the put constraint operation
 	ConstraintPutConstraintHandler constraint.PutConstraintHandler
 	// DistributionPutDistributionsHandler sets the operation handler for the put distributions operation
 	DistributionPutDistributionsHandler distribution.PutDistributionsHandler
 	// FlagPutFlagHandler sets the operation handler for the put flag operation
 	FlagPutFlagHandler flag.PutFlagHandler
+	// IdlistPutIDListHandler sets the operation handler for the put i d list operation
+	IdlistPutIDListHandler idlist.PutIDListHandler
+	// IdlistPutIDListIdsHandler sets the operation handler for the put i d list ids operation
+	IdlistPutIDListIdsHandler idlist.PutIDListIdsHandler
+	// LayerPutLayerHandler sets the operation handler for the put layer operation
+	LayerPutLayerHandler layer.PutLayerHandler
+	// OverridePutOverrideHandler sets the operation handler for the put override operation
+	OverridePutOverrideHandler override.PutOverrideHandler
 	// SegmentPutSegmentHandler sets the operation handler for the put segment operation
 	SegmentPutSegmentHandler segment.PutSegmentHandler
 	// SegmentPutSegmentsReorderHandler sets the operation handler for the put segments reorder operation
 	SegmentPutSegmentsReorderHandler segment.PutSegmentsReorderHandler
 	// VariantPutVariantHandler sets the operation handler for the put variant operation
 	VariantPutVariantHandler variant.PutVariantHandler
+	// WebhookPutWebhookHandler sets the operation handler for the put webhook operation
+	WebhookPutWebhookHandler webhook.PutWebhookHandler
 	// FlagSetFlagEnabledHandler sets the operation handler for the set flag enabled operation
 	FlagSetFlagEnabledHandler flag.SetFlagEnabledHandler
+	// LayerSetFlagLayerHandler sets the operation handler for the set flag layer operation
+	LayerSetFlagLayerHandler layer.SetFlagLayerHandler
 
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
@@ -277,6 +468,10 @@ func (o *FlagrAPI) Validate() error {
 		unregistered = append(unregistered, "BinProducer")
 	}
 
+	if o.AudienceCreateAudienceHandler == nil {
+		unregistered = append(unregistered, "audience.CreateAudienceHandler")
+	}
+
 	if o.ConstraintCreateConstraintHandler == nil {
 		unregistered = append(unregistered, "constraint.CreateConstraintHandler")
 	}
@@ -285,6 +480,18 @@ func (o *FlagrAPI) Validate() error {
 		unregistered = append(unregistered, "flag.CreateFlagHandler")
 	}
 
+	if o.IdlistCreateIDListHandler == nil {
+		unregistered = append(unregistered, "idlist.CreateIDListHandler")
+	}
+
+	if o.LayerCreateLayerHandler == nil {
+		unregistered = append(unregistered, "layer.CreateLayerHandler")
+	}
+
+	if o.OverrideCreateOverrideHandler == nil {
+		unregistered = append(unregistered, "override.CreateOverrideHandler")
+	}
+
 	if o.SegmentCreateSegmentHandler == nil {
 		unregistered = append(unregistered, "segment.CreateSegmentHandler")
 	}
@@ -293,6 +500,14 @@ func (o *FlagrAPI) Validate() error {
 		unregistered = append(unregistered, "variant.CreateVariantHandler")
 	}
 
+	if o.WebhookCreateWebhookHandler == nil {
+		unregistered = append(unregistered, "webhook.CreateWebhookHandler")
+	}
+
+	if o.AudienceDeleteAudienceHandler == nil {
+		unregistered = append(unregistered, "audience.DeleteAudienceHandler")
+	}
+
 	if o.ConstraintDeleteConstraintHandler == nil {
 		unregistered = append(unregistered, "constraint.DeleteConstraintHandler")
 	}
@@ -301,6 +516,22 @@ func (o *FlagrAPI) Validate() error {
 		unregistered = append(unregistered, "flag.DeleteFlagHandler")
 	}
 
+	if o.FlagDeleteFlagAssignmentsHandler == nil {
+		unregistered = append(unregistered, "flag.DeleteFlagAssignmentsHandler")
+	}
+
+	if o.IdlistDeleteIDListHandler == nil {
+		unregistered = append(unregistered, "idlist.DeleteIDListHandler")
+	}
+
+	if o.LayerDeleteLayerHandler == nil {
+		unregistered = append(unregistered, "layer.DeleteLayerHandler")
+	}
+
+	if o.OverrideDeleteOverrideHandler == nil {
+		unregistered = append(unregistered, "override.DeleteOverrideHandler")
+	}
+
 	if o.SegmentDeleteSegmentHandler == nil {
 		unregistered = append(unregistered, "segment.DeleteSegmentHandler")
 	}
@@ -309,6 +540,14 @@ func (o *FlagrAPI) Validate() error {
 		unregistered = append(unregistered, "variant.DeleteVariantHandler")
 	}
 
+	if o.WebhookDeleteWebhookHandler == nil {
+		unregistered = append(unregistered, "webhook.DeleteWebhookHandler")
+	}
+
+	if o.AudienceFindAudiencesHandler == nil {
+		unregistered = append(unregistered, "audience.FindAudiencesHandler")
+	}
+
 	if o.ConstraintFindConstraintsHandler == nil {
 		unregistered = append(unregistered, "constraint.FindConstraintsHandler")
 	}
@@ -321,6 +560,18 @@ func (o *FlagrAPI) Validate() error {
 		unregistered = append(unregistered, "flag.FindFlagsHandler")
 	}
 
+	if o.IdlistFindIDListsHandler == nil {
+		unregistered = append(unregistered, "idlist.FindIDListsHandler")
+	}
+
+	if o.LayerFindLayersHandler == nil {
+		unregistered = append(unregistered, "layer.FindLayersHandler")
+	}
+
+	if o.OverrideFindOverridesHandler == nil {
+		unregistered = append(unregistered, "override.FindOverridesHandler")
+	}
+
 	if o.SegmentFindSegmentsHandler == nil {
 		unregistered = append(unregistered, "segment.FindSegmentsHandler")
 	}
@@ -329,6 +580,26 @@ func (o *FlagrAPI) Validate() error {
 		unregistered = append(unregistered, "variant.FindVariantsHandler")
 	}
 
+	if o.WebhookFindWebhookDeliveriesHandler == nil {
+		unregistered = append(unregistered, "webhook.FindWebhookDeliveriesHandler")
+	}
+
+	if o.WebhookFindWebhooksHandler == nil {
+		unregistered = append(unregistered, "webhook.FindWebhooksHandler")
+	}
+
+	if o.AudienceGetAudienceHandler == nil {
+		unregistered = append(unregistered, "audience.GetAudienceHandler")
+	}
+
+	if o.EvaluationGetEvaluationHandler == nil {
+		unregistered = append(unregistered, "evaluation.GetEvaluationHandler")
+	}
+
+	if o.EvaluationGetEvaluationConfigHandler == nil {
+		unregistered = append(unregistered, "evaluation.GetEvaluationConfigHandler")
+	}
+
 	if o.ExportGetExportSqliteHandler == nil {
 		unregistered = append(unregistered, "export.GetExportSqliteHandler")
 	}
@@ -337,14 +608,46 @@ func (o *FlagrAPI) Validate() error {
 		unregistered = append(unregistered, "flag.GetFlagHandler")
 	}
 
+	if o.AnalysisGetFlagAnalysisHandler == nil {
+		unregistered = append(unregistered, "analysis.GetFlagAnalysisHandler")
+	}
+
 	if o.FlagGetFlagSnapshotsHandler == nil {
 		unregistered = append(unregistered, "flag.GetFlagSnapshotsHandler")
 	}
 
+	if o.FlagGetFlagStatsHandler == nil {
+		unregistered = append(unregistered, "flag.GetFlagStatsHandler")
+	}
+
+	if o.FlagGetFlagsStreamHandler == nil {
+		unregistered = append(unregistered, "flag.GetFlagsStreamHandler")
+	}
+
 	if o.HealthGetHealthHandler == nil {
 		unregistered = append(unregistered, "health.GetHealthHandler")
 	}
 
+	if o.IdlistGetIDListHandler == nil {
+		unregistered = append(unregistered, "idlist.GetIDListHandler")
+	}
+
+	if o.IdlistGetIDListIdsHandler == nil {
+		unregistered = append(unregistered, "idlist.GetIDListIdsHandler")
+	}
+
+	if o.LayerGetLayerHandler == nil {
+		unregistered = append(unregistered, "layer.GetLayerHandler")
+	}
+
+	if o.WebhookGetWebhookHandler == nil {
+		unregistered = append(unregistered, "webhook.GetWebhookHandler")
+	}
+
+	if o.AnalysisPostConversionEventsHandler == nil {
+		unregistered = append(unregistered, "analysis.PostConversionEventsHandler")
+	}
+
 	if o.EvaluationPostEvaluationHandler == nil {
 		unregistered = append(unregistered, "evaluation.PostEvaluationHandler")
 	}
@@ -353,6 +656,18 @@ func (o *FlagrAPI) Validate() error {
 		unregistered = append(unregistered, "evaluation.PostEvaluationBatchHandler")
 	}
 
+	if o.EvaluationPostEvaluationBatchCompactHandler == nil {
+		unregistered = append(unregistered, "evaluation.PostEvaluationBatchCompactHandler")
+	}
+
+	if o.EvaluationPostEvaluationCompactHandler == nil {
+		unregistered = append(unregistered, "evaluation.PostEvaluationCompactHandler")
+	}
+
+	if o.AudiencePutAudienceHandler == nil {
+		unregistered = append(unregistered, "audience.PutAudienceHandler")
+	}
+
 	if o.ConstraintPutConstraintHandler == nil {
 		unregistered = append(unregistered, "constraint.PutConstraintHandler")
 	}
@@ -365,6 +680,22 @@ func (o *FlagrAPI) Validate() error {
 		unregistered = append(unregistered, "flag.PutFlagHandler")
 	}
 
+	if o.IdlistPutIDListHandler == nil {
+		unregistered = append(unregistered, "idlist.PutIDListHandler")
+	}
+
+	if o.IdlistPutIDListIdsHandler == nil {
+		unregistered = append(unregistered, "idlist.PutIDListIdsHandler")
+	}
+
+	if o.LayerPutLayerHandler == nil {
+		unregistered = append(unregistered, "layer.PutLayerHandler")
+	}
+
+	if o.OverridePutOverrideHandler == nil {
+		unregistered = append(unregistered, "override.PutOverrideHandler")
+	}
+
 	if o.SegmentPutSegmentHandler == nil {
 		unregistered = append(unregistered, "segment.PutSegmentHandler")
 	}
@@ -377,10 +708,18 @@ func (o *FlagrAPI) Validate() error {
 		unregistered = append(unregistered, "variant.PutVariantHandler")
 	}
 
+	if o.WebhookPutWebhookHandler == nil {
+		unregistered = append(unregistered, "webhook.PutWebhookHandler")
+	}
+
 	if o.FlagSetFlagEnabledHandler == nil {
 		unregistered = append(unregistered, "flag.SetFlagEnabledHandler")
 	}
 
+	if o.LayerSetFlagLayerHandler == nil {
+		unregistered = append(unregistered, "layer.SetFlagLayerHandler")
+	}
+
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
 	}
@@ -482,6 +821,11 @@ func (o *FlagrAPI) initHandlerCache() {
 		o.handlers = make(map[string]map[string]http.Handler)
 	}
 
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/audiences"] = audience.NewCreateAudience(o.context, o.AudienceCreateAudienceHandler)
+
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -492,6 +836,21 @@ func (o *FlagrAPI) initHandlerCache() {
 	}
 	o.handlers["POST"]["/flags"] = flag.NewCreateFlag(o.context, o.FlagCreateFlagHandler)
 
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/id_lists"] = idlist.NewCreateIDList(o.context, o.IdlistCreateIDListHandler)
+
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/layers"] = layer.NewCreateLayer(o.context, o.LayerCreateLayerHandler)
+
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/flags/{flagID}/overrides"] = override.NewCreateOverride(o.context, o.OverrideCreateOverrideHandler)
+
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -502,6 +861,16 @@ func (o *FlagrAPI) initHandlerCache() {
 	}
 	o.handlers["POST"]["/flags/{flagID}/variants"] = variant.NewCreateVariant(o.context, o.VariantCreateVariantHandler)
 
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/webhooks"] = webhook.NewCreateWebhook(o.context, o.WebhookCreateWebhookHandler)
+
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/audiences/{audienceID}"] = audience.NewDeleteAudience(o.context, o.AudienceDeleteAudienceHandler)
+
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
@@ -512,6 +881,26 @@ func (o *FlagrAPI) initHandlerCache() {
 	}
 	o.handlers["DELETE"]["/flags/{flagID}"] = flag.NewDeleteFlag(o.context, o.FlagDeleteFlagHandler)
 
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/flags/{flagID}/assignments"] = flag.NewDeleteFlagAssignments(o.context, o.FlagDeleteFlagAssignmentsHandler)
+
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/id_lists/{idListID}"] = idlist.NewDeleteIDList(o.context, o.IdlistDeleteIDListHandler)
+
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/layers/{layerID}"] = layer.NewDeleteLayer(o.context, o.LayerDeleteLayerHandler)
+
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/flags/{flagID}/overrides/{overrideID}"] = override.NewDeleteOverride(o.context, o.OverrideDeleteOverrideHandler)
+
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
@@ -522,6 +911,16 @@ func (o *FlagrAPI) initHandlerCache() {
 	}
 	o.handlers["DELETE"]["/flags/{flagID}/variants/{variantID}"] = variant.NewDeleteVariant(o.context, o.VariantDeleteVariantHandler)
 
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/webhooks/{webhookID}"] = webhook.NewDeleteWebhook(o.context, o.WebhookDeleteWebhookHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/audiences"] = audience.NewFindAudiences(o.context, o.AudienceFindAudiencesHandler)
+
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -537,6 +936,21 @@ func (o *FlagrAPI) initHandlerCache() {
 	}
 	o.handlers["GET"]["/flags"] = flag.NewFindFlags(o.context, o.FlagFindFlagsHandler)
 
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/id_lists"] = idlist.NewFindIDLists(o.context, o.IdlistFindIDListsHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/layers"] = layer.NewFindLayers(o.context, o.LayerFindLayersHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/flags/{flagID}/overrides"] = override.NewFindOverrides(o.context, o.OverrideFindOverridesHandler)
+
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -547,6 +961,31 @@ func (o *FlagrAPI) initHandlerCache() {
 	}
 	o.handlers["GET"]["/flags/{flagID}/variants"] = variant.NewFindVariants(o.context, o.VariantFindVariantsHandler)
 
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/webhooks/{webhookID}/deliveries"] = webhook.NewFindWebhookDeliveries(o.context, o.WebhookFindWebhookDeliveriesHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/webhooks"] = webhook.NewFindWebhooks(o.context, o.WebhookFindWebhooksHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/audiences/{audienceID}"] = audience.NewGetAudience(o.context, o.AudienceGetAudienceHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/evaluation/flags/{flagKey}"] = evaluation.NewGetEvaluation(o.context, o.EvaluationGetEvaluationHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/evaluation/config"] = evaluation.NewGetEvaluationConfig(o.context, o.EvaluationGetEvaluationConfigHandler)
+
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -557,16 +996,56 @@ func (o *FlagrAPI) initHandlerCache() {
 	}
 	o.handlers["GET"]["/flags/{flagID}"] = flag.NewGetFlag(o.context, o.FlagGetFlagHandler)
 
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/flags/{flagID}/analysis"] = analysis.NewGetFlagAnalysis(o.context, o.AnalysisGetFlagAnalysisHandler)
+
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/flags/{flagID}/snapshots"] = flag.NewGetFlagSnapshots(o.context, o.FlagGetFlagSnapshotsHandler)
 
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/flags/{flagID}/stats"] = flag.NewGetFlagStats(o.context, o.FlagGetFlagStatsHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/flags/stream"] = flag.NewGetFlagsStream(o.context, o.FlagGetFlagsStreamHandler)
+
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/health"] = health.NewGetHealth(o.context, o.HealthGetHealthHandler)
 
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/id_lists/{idListID}"] = idlist.NewGetIDList(o.context, o.IdlistGetIDListHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/id_lists/{idListID}/ids"] = idlist.NewGetIDListIds(o.context, o.IdlistGetIDListIdsHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/layers/{layerID}"] = layer.NewGetLayer(o.context, o.LayerGetLayerHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/webhooks/{webhookID}"] = webhook.NewGetWebhook(o.context, o.WebhookGetWebhookHandler)
+
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/conversions"] = analysis.NewPostConversionEvents(o.context, o.AnalysisPostConversionEventsHandler)
+
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -577,6 +1056,21 @@ func (o *FlagrAPI) initHandlerCache() {
 	}
 	o.handlers["POST"]["/evaluation/batch"] = evaluation.NewPostEvaluationBatch(o.context, o.EvaluationPostEvaluationBatchHandler)
 
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/evaluation/batch/compact"] = evaluation.NewPostEvaluationBatchCompact(o.context, o.EvaluationPostEvaluationBatchCompactHandler)
+
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/evaluation/compact"] = evaluation.NewPostEvaluationCompact(o.context, o.EvaluationPostEvaluationCompactHandler)
+
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/audiences/{audienceID}"] = audience.NewPutAudience(o.context, o.AudiencePutAudienceHandler)
+
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
@@ -592,6 +1086,26 @@ func (o *FlagrAPI) initHandlerCache() {
 	}
 	o.handlers["PUT"]["/flags/{flagID}"] = flag.NewPutFlag(o.context, o.FlagPutFlagHandler)
 
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/id_lists/{idListID}"] = idlist.NewPutIDList(o.context, o.IdlistPutIDListHandler)
+
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/id_lists/{idListID}/ids"] = idlist.NewPutIDListIds(o.context, o.IdlistPutIDListIdsHandler)
+
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/layers/{layerID}"] = layer.NewPutLayer(o.context, o.LayerPutLayerHandler)
+
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/flags/{flagID}/overrides/{overrideID}"] = override.NewPutOverride(o.context, o.OverridePutOverrideHandler)
+
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
@@ -607,11 +1121,21 @@ func (o *FlagrAPI) initHandlerCache() {
 	}
 	o.handlers["PUT"]["/flags/{flagID}/variants/{variantID}"] = variant.NewPutVariant(o.context, o.VariantPutVariantHandler)
 
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/webhooks/{webhookID}"] = webhook.NewPutWebhook(o.context, o.WebhookPutWebhookHandler)
+
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
 	o.handlers["PUT"]["/flags/{flagID}/enabled"] = flag.NewSetFlagEnabled(o.context, o.FlagSetFlagEnabledHandler)
 
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/flags/{flagID}/layer"] = layer.NewSetFlagLayer(o.context, o.LayerSetFlagLayerHandler)
+
 }
 
 // Serve creates a http handler to serve the API over HTTP